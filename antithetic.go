@@ -0,0 +1,97 @@
+package grand
+
+// AntitheticSource wraps a 32-bit Source and returns the complement of its values: Uint32() returns
+// ^src.Uint32() and Bool() returns !src.Bool(). A uniform U derived from the source becomes 1-U (but for
+// its last bit, e.g. 1-U-2^-53 for Float64()), so that a simulation run on the source and on its
+// antithetic wrapper, from the same seed, gives negatively correlated estimates.
+//
+// It has no Uint64(), so that Rand builds its values from Uint32() as it does for the wrapped source:
+// a Source64 must be wrapped by AntitheticSource64 instead, or its values would not be paired.
+type AntitheticSource struct {
+	src Source
+}
+
+// NewAntitheticSource returns the antithetic source of src, which shares its state: both must not be used
+// at the same time, the antithetic run is made after a Restart() (or a Seed()) of the same stream.
+func NewAntitheticSource(src Source) *AntitheticSource {
+	return &AntitheticSource{src: src}
+}
+
+func (a *AntitheticSource) Uint32() uint32 {
+	return ^a.src.Uint32()
+}
+
+func (a *AntitheticSource) Bool() bool {
+	return !a.src.Bool()
+}
+
+func (a *AntitheticSource) Seed(seed int64) {
+	a.src.Seed(seed)
+}
+
+func (a *AntitheticSource) Restart() {
+	a.src.Restart()
+}
+
+// AntitheticSource64 is the AntitheticSource of a Source64, whose Uint64() returns ^src.Uint64().
+type AntitheticSource64 struct {
+	AntitheticSource
+	s64 Source64
+}
+
+// NewAntitheticSource64 returns the antithetic source of src (see NewAntitheticSource).
+func NewAntitheticSource64(src Source64) *AntitheticSource64 {
+	ans := new(AntitheticSource64)
+	ans.src = src
+	ans.s64 = src
+	return ans
+}
+
+func (a *AntitheticSource64) Uint64() uint64 {
+	return ^a.s64.Uint64()
+}
+
+// AntitheticJumpableSource is the AntitheticSource of a 32-bit JumpableSource: the antithetic run of each
+// substream is made after RestartSubstream().
+type AntitheticJumpableSource struct {
+	AntitheticSource
+}
+
+// NewAntitheticJumpableSource returns the antithetic source of src (see NewAntitheticSource).
+func NewAntitheticJumpableSource(src JumpableSource) *AntitheticJumpableSource {
+	ans := new(AntitheticJumpableSource)
+	ans.src = src
+	return ans
+}
+
+func (a *AntitheticJumpableSource) RestartSubstream() {
+	a.src.(JumpableSource).RestartSubstream()
+}
+
+func (a *AntitheticJumpableSource) Jump() {
+	a.src.(JumpableSource).Jump()
+}
+
+// AntitheticJumpableSource64 is the AntitheticSource64 of a 64-bit JumpableSource (see AntitheticJumpableSource).
+type AntitheticJumpableSource64 struct {
+	AntitheticSource64
+}
+
+// NewAntitheticJumpableSource64 returns the antithetic source of src (see NewAntitheticSource).
+func NewAntitheticJumpableSource64(src interface {
+	JumpableSource
+	Source64
+}) *AntitheticJumpableSource64 {
+	ans := new(AntitheticJumpableSource64)
+	ans.src = src
+	ans.s64 = src
+	return ans
+}
+
+func (a *AntitheticJumpableSource64) RestartSubstream() {
+	a.src.(JumpableSource).RestartSubstream()
+}
+
+func (a *AntitheticJumpableSource64) Jump() {
+	a.src.(JumpableSource).Jump()
+}
@@ -0,0 +1,123 @@
+package grand_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestAntitheticSource(t *testing.T) {
+	src := source64.NewSplitMix64(1)
+	anti := grand.NewAntitheticSource64(src)
+	for i := 0; i < 100; i++ {
+		src.Restart()
+		for j := 0; j < i; j++ {
+			src.Uint64()
+		}
+		want := src.Uint64()
+		src.Restart()
+		for j := 0; j < i; j++ {
+			src.Uint64()
+		}
+		if got := anti.Uint64(); got != ^want {
+			t.Fatalf("Mismatch. want: %v, got: %v", ^want, got)
+		}
+	}
+
+	t.Run("Float64", func(t *testing.T) {
+		// U + (1-U-2^-53) = 1-2^-53
+		src32, src64 := source32.NewXoShiRo128StarStar(1), source64.NewSplitMix64(1)
+		cases := []struct {
+			src, anti grand.Source
+		}{
+			{src32, grand.NewAntitheticSource(src32)},
+			{src64, grand.NewAntitheticSource64(src64)},
+		}
+
+		for _, c := range cases {
+			src := c.src
+			r, anti := grand.New(src), grand.New(c.anti)
+			u := make([]float64, 100)
+			for i := range u {
+				u[i] = r.Float64()
+			}
+			src.Restart()
+			for i := range u {
+				if got := anti.Float64(); u[i]+got != 1-0x1p-53 {
+					t.Fatalf("Mismatch. want: %v, got: %v", 1-0x1p-53, u[i]+got)
+				}
+			}
+		}
+	})
+
+	t.Run("Source64", func(t *testing.T) {
+		// Rand must build the values of a 32-bit source and of its wrapper alike.
+		if _, ok := interface{}(grand.NewAntitheticSource(source32.NewXoShiRo128StarStar(1))).(grand.Source64); ok {
+			t.Errorf("AntitheticSource should not implement Source64")
+		}
+		if _, ok := interface{}(grand.NewAntitheticJumpableSource(source32.NewMRG32k3A(1))).(grand.Source64); ok {
+			t.Errorf("AntitheticJumpableSource should not implement Source64")
+		}
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		src := source32.NewXoShiRo128StarStar(1)
+		want := make([]bool, 100)
+		for i := range want {
+			want[i] = src.Bool()
+		}
+
+		src.Restart()
+		anti := grand.NewAntitheticSource(src)
+		for i := range want {
+			if got := anti.Bool(); got == want[i] {
+				t.Fatalf("Mismatch. want: %v, got: %v", !want[i], got)
+			}
+		}
+	})
+
+	t.Run("Variance", func(t *testing.T) {
+		// E[exp(U)] = e-1: the pairs of a run and of its antithetic run have a much lower variance than
+		// the pairs of two independent runs.
+		const n = 10000
+		src := source64.NewXoShiRo256StarStar(1)
+		r, anti := grand.New(src), grand.New(grand.NewAntitheticJumpableSource64(src))
+		u := make([]float64, 2*n)
+		for i := range u {
+			u[i] = math.Exp(r.Float64())
+		}
+
+		src.Restart()
+		var sum, sumSq, sumInd, sumSqInd float64
+		for i := 0; i < n; i++ {
+			p := (u[i] + math.Exp(anti.Float64())) / 2
+			q := (u[i] + u[n+i]) / 2
+			sum += p
+			sumSq += p * p
+			sumInd += q
+			sumSqInd += q * q
+		}
+
+		varAnti := sumSq/n - (sum/n)*(sum/n)
+		varInd := sumSqInd/n - (sumInd/n)*(sumInd/n)
+		if varAnti > varInd/10 {
+			t.Errorf("antithetic variance %v, independent variance %v", varAnti, varInd)
+		}
+	})
+
+	t.Run("Conformance", func(t *testing.T) {
+		grandtest.RunSourceTests(t, func() grand.Source {
+			return grand.NewAntitheticSource64(source64.NewSplitMix64(1))
+		})
+		grandtest.RunSourceTests(t, func() grand.Source {
+			return grand.NewAntitheticSource(source32.NewXoShiRo128StarStar(1))
+		})
+		grandtest.RunSourceTests(t, func() grand.Source {
+			return grand.NewAntitheticJumpableSource(source32.NewMRG32k3A(1))
+		})
+	})
+}
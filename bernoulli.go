@@ -0,0 +1,55 @@
+package grand
+
+// Bernoulli returns true with probability p, exactly: a uniform U in [0,1) is drawn bit by bit, with
+// Bool(), and compared with the binary expansion of p until they differ, so that it consumes 2 bits on
+// average (and a single one for p = 1/2), against the 53 bits of Float64() < p, which is inexact below 2^-53.
+// It panics if p is not in [0,1].
+func (r *Rand) Bernoulli(p float64) bool {
+	if !(p >= 0 && p <= 1) {
+		panic("invalid argument to Bernoulli")
+	}
+	if p == 1 {
+		return true
+	}
+
+	// the bits of p are shifted out by doublings, which are exact in float64, as are the subtractions of 1
+	// from [1,2). U = p (and U > p) once the remaining bits of p are 0.
+	for p != 0 {
+		p *= 2
+		pbit := p >= 1
+		if pbit {
+			p--
+		}
+		if ubit := r.Bool(); ubit != pbit {
+			return pbit
+		}
+	}
+
+	return false
+}
+
+// BernoulliRational returns true with probability num/den, exactly, by the bitwise comparison of Bernoulli.
+// It panics if den == 0 or num > den.
+func (r *Rand) BernoulliRational(num, den uint64) bool {
+	if den == 0 || num > den {
+		panic("invalid argument to BernoulliRational")
+	}
+	if num == den {
+		return true
+	}
+
+	// num/den is doubled as num < den, without overflowing 2*num: the bit is 1 if 2*num >= den.
+	for num != 0 {
+		pbit := num >= den-num
+		if pbit {
+			num -= den - num
+		} else {
+			num *= 2
+		}
+		if ubit := r.Bool(); ubit != pbit {
+			return pbit
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package grand_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+	"github.com/jtejido/grand/source64"
+)
+
+// checkFrequency fails the test if the frequency of true in samples draws of f is not p.
+func checkFrequency(t *testing.T, f func() bool, p float64) {
+	t.Helper()
+
+	n := 0
+	for i := 0; i < samples; i++ {
+		if f() {
+			n++
+		}
+	}
+	sd := math.Sqrt(samples * p * (1 - p))
+	if z := math.Abs(float64(n)-samples*p) / sd; z > 5.2 || (sd == 0 && float64(n) != samples*p) {
+		t.Errorf("Mismatch. want: %v, got: %v", p, float64(n)/samples)
+	}
+}
+
+func TestBernoulli(t *testing.T) {
+	for name, newRand := range sources() {
+		t.Run(name, func(t *testing.T) {
+			r := newRand()
+			for _, p := range []float64{0, 1, 0.5, 0.1, 1.0 / 3, 0.999, 1e-3, math.Nextafter(1, 0)} {
+				p := p
+				checkFrequency(t, func() bool { return r.Bernoulli(p) }, p)
+			}
+			for _, c := range [][2]uint64{{0, 1}, {1, 1}, {1, 3}, {2, 7}, {math.MaxUint64 - 1, math.MaxUint64}, {1 << 62, 3 << 62}} {
+				c := c
+				checkFrequency(t, func() bool { return r.BernoulliRational(c[0], c[1]) }, float64(c[0])/float64(c[1]))
+			}
+		})
+	}
+
+	t.Run("Panics", func(t *testing.T) {
+		r := grand.New(source64.NewSplitMix64(1))
+		cases := map[string]func(){
+			"Bernoulli(-0.1)":         func() { r.Bernoulli(-0.1) },
+			"Bernoulli(1.5)":          func() { r.Bernoulli(1.5) },
+			"Bernoulli(NaN)":          func() { r.Bernoulli(math.NaN()) },
+			"BernoulliRational(1, 0)": func() { r.BernoulliRational(1, 0) },
+			"BernoulliRational(3, 2)": func() { r.BernoulliRational(3, 2) },
+		}
+
+		for name, f := range cases {
+			t.Run(name, func(t *testing.T) {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("%s should panic", name)
+					}
+				}()
+				f()
+			})
+		}
+	})
+}
+
+// The bits of U are compared with those of p until they differ: 2 bits on average.
+func TestBernoulliEntropy(t *testing.T) {
+	src := grand.NewCountingSource(source32.NewXoShiRo128StarStar(1))
+	r := grand.New(src)
+
+	cases := []struct {
+		name string
+		f    func() bool
+		want float64
+	}{
+		{"Bernoulli(0.5)", func() bool { return r.Bernoulli(0.5) }, 1},
+		{"Bernoulli(0.75)", func() bool { return r.Bernoulli(0.75) }, 1.5},
+		{"Bernoulli(0.1)", func() bool { return r.Bernoulli(0.1) }, 2},
+		{"Bernoulli(1)", func() bool { return r.Bernoulli(1) }, 0},
+		{"BernoulliRational(1, 3)", func() bool { return r.BernoulliRational(1, 3) }, 2},
+	}
+
+	for _, c := range cases {
+		src.ResetBits()
+		for i := 0; i < samples; i++ {
+			c.f()
+		}
+		if got := float64(src.Bits()) / samples; math.Abs(got-c.want) > 0.02 {
+			t.Errorf("%s: Mismatch. want: %v bits, got: %v", c.name, c.want, got)
+		}
+	}
+
+	src.ResetBits()
+	r.Float64()
+	r.Uint32()
+	if got := src.Bits(); got != 96 {
+		t.Errorf("Mismatch. want: %v, got: %v", 96, got)
+	}
+}
+
+func TestCountingSource(t *testing.T) {
+	src := source32.NewMRG32k3A(12345)
+	cs := grand.NewCountingJumpableSource(source32.NewMRG32k3A(12345))
+	r := grand.NewJumpable(cs)
+	for i := 0; i < 10; i++ {
+		if want, got := src.Uint32(), r.Uint32(); want != got {
+			t.Errorf("Mismatch. want: %v, got: %v", want, got)
+		}
+	}
+
+	src.Jump()
+	r.Jump()
+	if want, got := src.Uint32(), r.Uint32(); want != got {
+		t.Errorf("Jump: Mismatch. want: %v, got: %v", want, got)
+	}
+	if got := cs.Bits(); got != 11*32 {
+		t.Errorf("Mismatch. want: %v, got: %v", 11*32, got)
+	}
+}
@@ -0,0 +1,70 @@
+package grand
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"unicode/utf8"
+)
+
+// Read fills p with pseudo-random bytes, from the values of Uint64() (least significant byte first),
+// and always returns len(p) and a nil error. Each call starts with a new value: the bytes of a single
+// Read(p) differ from those of several calls that fill the same length.
+func (r *Rand) Read(p []byte) (n int, err error) {
+	for i := 0; i < len(p); i += 8 {
+		v := r.Uint64()
+		for j := i; j < len(p) && j < i+8; j++ {
+			p[j] = byte(v)
+			v >>= 8
+		}
+	}
+
+	return len(p), nil
+}
+
+// String returns a string of n characters drawn uniformly from alphabet, with Uint32n (which has no
+// modulo bias). The alphabet is a string of runes, e.g. "0123456789abcdef" or "αβγ", which may repeat
+// runes to weight them.
+// It panics if n < 0 or if the alphabet is empty or not valid UTF-8.
+func (r *Rand) String(n int, alphabet string) string {
+	if n < 0 || alphabet == "" || !utf8.ValidString(alphabet) {
+		panic("invalid argument to String")
+	}
+
+	runes := []rune(alphabet)
+	ans := make([]rune, n)
+	for i := range ans {
+		ans[i] = runes[r.Uint32n(uint32(len(runes)))]
+	}
+
+	return string(ans)
+}
+
+// Returns n random bytes, n >= 0.
+func (r *Rand) bytes(n int, method string) []byte {
+	if n < 0 {
+		panic("invalid argument to " + method)
+	}
+
+	ans := make([]byte, n)
+	r.Read(ans)
+	return ans
+}
+
+// HexToken returns n random bytes in hexadecimal (2*n lowercase characters).
+// It panics if n < 0.
+func (r *Rand) HexToken(n int) string {
+	return hex.EncodeToString(r.bytes(n, "HexToken"))
+}
+
+// Base32Token returns n random bytes in base 32 (RFC 4648 alphabet, without padding).
+// It panics if n < 0.
+func (r *Rand) Base32Token(n int) string {
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(r.bytes(n, "Base32Token"))
+}
+
+// Base64Token returns n random bytes in the URL-safe base 64 of RFC 4648, without padding.
+// It panics if n < 0.
+func (r *Rand) Base64Token(n int) string {
+	return base64.RawURLEncoding.EncodeToString(r.bytes(n, "Base64Token"))
+}
@@ -0,0 +1,108 @@
+package grand_test
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestRead(t *testing.T) {
+	src := source64.NewSplitMix64(1)
+	r := grand.New(src)
+	p := make([]byte, 13)
+	if n, err := r.Read(p); n != 13 || err != nil {
+		t.Fatalf("Mismatch. want: %v, got: %v, %v", 13, n, err)
+	}
+
+	src.Restart()
+	v, w := src.Uint64(), src.Uint64()
+	for i := 0; i < 13; i++ {
+		want := byte(v >> (8 * i))
+		if i >= 8 {
+			want = byte(w >> (8 * (i - 8)))
+		}
+		if p[i] != want {
+			t.Errorf("Mismatch. want: %v, got: %v", want, p[i])
+		}
+	}
+
+	// a 32-bit source, with the bytes checked for bias.
+	r = grand.New(source32.NewXoShiRo128StarStar(1))
+	buf := make([]byte, samples)
+	r.Read(buf)
+	i := 0
+	grandtest.CheckUniform(t, func() uint64 { i++; return uint64(buf[i-1]) }, 256, 256, samples)
+}
+
+func TestString(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	const alphabet = "abcdefghijk"
+	s := r.String(samples, alphabet)
+	if len(s) != samples {
+		t.Fatalf("Mismatch. want: %v, got: %v", samples, len(s))
+	}
+
+	i := 0
+	grandtest.CheckUniform(t, func() uint64 {
+		i++
+		return uint64(strings.IndexByte(alphabet, s[i-1]))
+	}, uint64(len(alphabet)), len(alphabet), samples)
+
+	// runes, not bytes.
+	u := r.String(100, "αβγ")
+	if utf8.RuneCountInString(u) != 100 || strings.Trim(u, "αβγ") != "" {
+		t.Errorf("invalid string: %q", u)
+	}
+
+	// the same seed gives the same string.
+	if a, b := grand.New(source64.NewSplitMix64(7)).String(20, alphabet), grand.New(source64.NewSplitMix64(7)).String(20, alphabet); a != b {
+		t.Errorf("Mismatch. want: %v, got: %v", a, b)
+	}
+
+	for _, c := range []struct {
+		n        int
+		alphabet string
+	}{{-1, "ab"}, {1, ""}, {1, "\xff"}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("String(%d, %q) should panic", c.n, c.alphabet)
+				}
+			}()
+			r.String(c.n, c.alphabet)
+		}()
+	}
+}
+
+func TestTokens(t *testing.T) {
+	src := source64.NewSplitMix64(1)
+	r := grand.New(src)
+	want := make([]byte, 20)
+
+	tok := r.HexToken(20)
+	src.Restart()
+	r.Read(want)
+	if got, err := hex.DecodeString(tok); err != nil || string(got) != string(want) || len(tok) != 40 {
+		t.Errorf("HexToken: Mismatch. want: %x, got: %v", want, tok)
+	}
+
+	src.Restart()
+	tok = r.Base32Token(20)
+	if got, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(tok); err != nil || string(got) != string(want) {
+		t.Errorf("Base32Token: Mismatch. want: %x, got: %v", want, tok)
+	}
+
+	src.Restart()
+	tok = r.Base64Token(20)
+	if got, err := base64.RawURLEncoding.DecodeString(tok); err != nil || string(got) != string(want) {
+		t.Errorf("Base64Token: Mismatch. want: %x, got: %v", want, tok)
+	}
+}
@@ -0,0 +1,248 @@
+// Command grandbench prints a throughput comparison of every source in grand.
+//
+// Each row shows the size of the working state, the period of the generator and the
+// time taken per call of the most common methods, which should help in picking
+// a reasonable tradeoff among space, time and quality.
+//
+// Usage:
+//
+//	grandbench [-run regexp] [-benchtime duration]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+	"github.com/jtejido/grand/source64"
+)
+
+type entry struct {
+	name string
+	// bits is the native output width of the source.
+	bits int
+	// state is the size of the working state in bytes (i.e. excluding the copy kept for Restart()).
+	state int
+	// period as described by the authors.
+	period string
+	src    func() grand.Source
+}
+
+var entries = []entry{
+	{"CMWC4096", 32, 16388, "~2^131086", func() grand.Source { return source32.NewCMWC4096(1) }},
+	{"DSFMT19937", 32, 3072, "2^19937-1 (mult.)", func() grand.Source { return source32.NewDSFMT19937(1) }},
+	{"GlibcRandom", 32, 124, "~2^35", func() grand.Source { return source32.NewGlibcRandom(1) }},
+	{"JavaRandom", 32, 8, "2^48", func() grand.Source { return source32.NewJavaRandom(1) }},
+	{"JSF", 32, 16, "~2^94 (min. expected)", func() grand.Source { return source32.NewJSF(1) }},
+	{"KISS", 32, 16, "~2^123", func() grand.Source { return source32.NewKISS(1) }},
+	{"LFSR113", 32, 16, "2^113", func() grand.Source { return source32.NewLFSR113(1) }},
+	{"LFSR88", 32, 12, "2^88", func() grand.Source { return source32.NewLFSR88(1) }},
+	{"MinStdRand", 32, 8, "2^31-2", func() grand.Source { return source32.NewMinStdRand(1) }},
+	{"MinStdRand0", 32, 8, "2^31-2", func() grand.Source { return source32.NewMinStdRand0(1) }},
+	{"MRG32k3A", 32, 24, "2^191", func() grand.Source { return source32.NewMRG32k3A(1) }},
+	{"MRG32k3P", 32, 24, "2^185", func() grand.Source { return source32.NewMRG32k3P(1) }},
+	{"MT19937", 32, 2496, "2^19937-1", func() grand.Source { return source32.NewMT19937(1) }},
+	{"MultiplyWithCarry256", 32, 1028, "~2^8222", func() grand.Source { return source32.NewMultiplyWithCarry256(1) }},
+	{"NumPyRandomState", 32, 2496, "2^19937-1", func() grand.Source { return source32.NewNumPyRandomState(1) }},
+	{"PcgMcgXshRr32", 32, 8, "2^62", func() grand.Source { return source32.NewPcgMcgXshRr32(1) }},
+	{"PcgMcgXshRs32", 32, 8, "2^62", func() grand.Source { return source32.NewPcgMcgXshRs32(1) }},
+	{"PcgXshRr32", 32, 16, "2^64", func() grand.Source { return source32.NewPcgXshRr32(1) }},
+	{"PcgXshRs32", 32, 16, "2^64", func() grand.Source { return source32.NewPcgXshRs32(1) }},
+	{"RANLUX24 (p = 223)", 32, 100, "~2^570", func() grand.Source {
+		src, _ := source32.NewRANLUX24(source32.RANLUX24Luxury[3], 1)
+		return src
+	}},
+	{"RANLUX24 (p = 389)", 32, 100, "~2^570", func() grand.Source {
+		src, _ := source32.NewRANLUX24(source32.RANLUX24Luxury[4], 1)
+		return src
+	}},
+	{"RMersenneTwister", 32, 2496, "2^19937-1", func() grand.Source { return source32.NewRMersenneTwister(1) }},
+	{"RomuQuad32", 32, 16, "2^62 B (capacity)", func() grand.Source { return source32.NewRomuQuad32(1) }},
+	{"RomuTrio32", 32, 12, "2^53 B (capacity)", func() grand.Source { return source32.NewRomuTrio32(1) }},
+	{"SFC", 32, 16, "2^32 (min.)", func() grand.Source { return source32.NewSFC(1) }},
+	{"SFMT607", 32, 80, "2^607-1 (mult.)", func() grand.Source {
+		src, _ := source32.NewSFMT(source32.SFMT607Params, 1)
+		return src
+	}},
+	{"SFMT19937", 32, 2496, "2^19937-1 (mult.)", func() grand.Source {
+		src, _ := source32.NewSFMT(source32.SFMT19937Params, 1)
+		return src
+	}},
+	{"SFMT216091", 32, 27024, "2^216091-1 (mult.)", func() grand.Source {
+		src, _ := source32.NewSFMT(source32.SFMT216091Params, 1)
+		return src
+	}},
+	{"TinyMT32", 32, 16, "2^127-1", func() grand.Source {
+		src, _ := source32.NewTinyMT32(source32.TinyMT32DefaultParams, 1)
+		return src
+	}},
+	{"Tyche", 32, 16, "~2^127 (avg.)", func() grand.Source { return source32.NewTyche(1) }},
+	{"TycheI", 32, 16, "~2^127 (avg.)", func() grand.Source { return source32.NewTycheI(1) }},
+	{"WELL512A", 32, 64, "2^512-1", func() grand.Source { return source32.NewWELL512A(1) }},
+	{"WELL1024A", 32, 128, "2^1024-1", func() grand.Source { return source32.NewWELL1024A(1) }},
+	{"WELL19937A", 32, 2496, "2^19937-1", func() grand.Source { return source32.NewWELL19937A(1) }},
+	{"WELL19937C", 32, 2496, "2^19937-1", func() grand.Source { return source32.NewWELL19937C(1) }},
+	{"WELL44497A", 32, 5564, "2^44497-1", func() grand.Source { return source32.NewWELL44497A(1) }},
+	{"WELL44497B", 32, 5564, "2^44497-1", func() grand.Source { return source32.NewWELL44497B(1) }},
+	{"XoRoShiRo64Star", 32, 8, "2^64-1", func() grand.Source { return source32.NewXoRoShiRo64Star(1) }},
+	{"XoRoShiRo64StarStar", 32, 8, "2^64-1", func() grand.Source { return source32.NewXoRoShiRo64StarStar(1) }},
+	{"XORWOW", 32, 24, "2^32*(2^160-1)", func() grand.Source { return source32.NewXORWOW(1) }},
+	{"XoShiRo128Plus", 32, 16, "2^128-1", func() grand.Source { return source32.NewXoShiRo128Plus(1) }},
+	{"XoShiRo128PlusPlus", 32, 16, "2^128-1", func() grand.Source { return source32.NewXoShiRo128PlusPlus(1) }},
+	{"XoShiRo128StarStar", 32, 16, "2^128-1", func() grand.Source { return source32.NewXoShiRo128StarStar(1) }},
+
+	{"CombinedMRG (MRG32k3A)", 64, 48, "2^191", func() grand.Source {
+		src, _ := source64.NewCombinedMRG(source64.MRG32k3AParams, 1)
+		return src
+	}},
+	{"GoALFG", 64, 4856, "~2^670", func() grand.Source { return source64.NewGoALFG(1) }},
+	{"JSF", 64, 32, "~2^255 (avg.)", func() grand.Source { return source64.NewJSF(1) }},
+	{"L128X1024MixRandom", 64, 160, "~2^1152", func() grand.Source { return source64.NewL128X1024MixRandom(1) }},
+	{"L64X128MixRandom", 64, 32, "~2^192", func() grand.Source { return source64.NewL64X128MixRandom(1) }},
+	{"L64X256MixRandom", 64, 48, "~2^320", func() grand.Source { return source64.NewL64X256MixRandom(1) }},
+	{"LFSR258", 64, 40, "2^258", func() grand.Source { return source64.NewLFSR258(1) }},
+	{"MIXMAX17", 64, 144, "~2^1037", func() grand.Source {
+		src, _ := source64.NewMIXMAX(source64.MIXMAX17Params, 1)
+		return src
+	}},
+	{"MIXMAX240", 64, 1928, "~2^14640", func() grand.Source {
+		src, _ := source64.NewMIXMAX(source64.MIXMAX240Params, 1)
+		return src
+	}},
+	{"MIXMAX256", 64, 2056, "~2^15616", func() grand.Source {
+		src, _ := source64.NewMIXMAX(source64.MIXMAX256Params, 1)
+		return src
+	}},
+	{"MRG63k3A", 64, 48, "2^377", func() grand.Source { return source64.NewMRG63k3A(1) }},
+	{"MT19937", 64, 2496, "2^19937-1", func() grand.Source { return source64.NewMT19937(1) }},
+	{"MWC128", 64, 16, "~2^127", func() grand.Source { return source64.NewMWC128(1) }},
+	{"MWC192", 64, 24, "~2^191", func() grand.Source { return source64.NewMWC192(1) }},
+	{"MWC256", 64, 32, "~2^255", func() grand.Source { return source64.NewMWC256(1) }},
+	{"RANLUX48 (p = 389)", 64, 104, "~2^570", func() grand.Source {
+		src, _ := source64.NewRANLUX48(source64.RANLUX48Luxury[4], 1)
+		return src
+	}},
+	{"RANLUX++ (p = 2048)", 64, 72, "~2^570", func() grand.Source {
+		src, _ := source64.NewRANLUXPlusPlus(source64.RANLUXPlusPlusDefaultLuxury, 1)
+		return src
+	}},
+	{"RomuDuo", 64, 16, "2^61 B (capacity)", func() grand.Source { return source64.NewRomuDuo(1) }},
+	{"RomuDuoJr", 64, 16, "2^51 B (capacity)", func() grand.Source { return source64.NewRomuDuoJr(1) }},
+	{"RomuQuad", 64, 32, "2^90 B (capacity)", func() grand.Source { return source64.NewRomuQuad(1) }},
+	{"RomuTrio", 64, 24, "2^75 B (capacity)", func() grand.Source { return source64.NewRomuTrio(1) }},
+	{"SFC", 64, 32, "2^64 (min.)", func() grand.Source { return source64.NewSFC(1) }},
+	{"Squares", 64, 16, "2^64", func() grand.Source { return source64.NewSquares(1) }},
+	{"SplitMix64", 64, 8, "2^64", func() grand.Source { return source64.NewSplitMix64(1) }},
+	{"TinyMT64", 64, 16, "2^127-1", func() grand.Source {
+		src, _ := source64.NewTinyMT64(source64.TinyMT64DefaultParams, 1)
+		return src
+	}},
+	{"WyRand", 64, 8, "2^64", func() grand.Source { return source64.NewWyRand(1) }},
+	{"XorShift1024Star", 64, 128, "2^1024-1", func() grand.Source { return source64.NewXorShift1024Star(1) }},
+	{"XorShift1024StarPhi", 64, 128, "2^1024-1", func() grand.Source { return source64.NewXorShift1024StarPhi(1) }},
+	{"XoRoShiRo1024PlusPlus", 64, 128, "2^1024-1", func() grand.Source { return source64.NewXoRoShiRo1024PlusPlus(1) }},
+	{"XoRoShiRo1024Star", 64, 128, "2^1024-1", func() grand.Source { return source64.NewXoRoShiRo1024Star(1) }},
+	{"XoRoShiRo1024StarStar", 64, 128, "2^1024-1", func() grand.Source { return source64.NewXoRoShiRo1024StarStar(1) }},
+	{"XoRoShiRo128Plus", 64, 16, "2^128-1", func() grand.Source { return source64.NewXoRoShiRo128Plus(1) }},
+	{"XoRoShiRo128PlusPlus", 64, 16, "2^128-1", func() grand.Source { return source64.NewXoRoShiRo128PlusPlus(1) }},
+	{"XoRoShiRo128StarStar", 64, 16, "2^128-1", func() grand.Source { return source64.NewXoRoShiRo128StarStar(1) }},
+	{"XoShiRo256Plus", 64, 32, "2^256-1", func() grand.Source { return source64.NewXoShiRo256Plus(1) }},
+	{"XoShiRo256PlusPlus", 64, 32, "2^256-1", func() grand.Source { return source64.NewXoShiRo256PlusPlus(1) }},
+	{"XoShiRo256StarStar", 64, 32, "2^256-1", func() grand.Source { return source64.NewXoShiRo256StarStar(1) }},
+	{"XoShiRo512Plus", 64, 64, "2^512-1", func() grand.Source { return source64.NewXoShiRo512Plus(1) }},
+	{"XoShiRo512PlusPlus", 64, 64, "2^512-1", func() grand.Source { return source64.NewXoShiRo512PlusPlus(1) }},
+	{"XoShiRo512StarStar", 64, 64, "2^512-1", func() grand.Source { return source64.NewXoShiRo512StarStar(1) }},
+}
+
+// The results are accumulated here so the compiler can't optimize the calls away.
+var (
+	sink32  uint32
+	sink64  uint64
+	sinkF64 float64
+)
+
+var (
+	run       = flag.String("run", "", "only benchmark sources whose name matches this regular expression")
+	benchtime = flag.Duration("benchtime", 200*time.Millisecond, "approximate run time of each measurement")
+)
+
+func main() {
+	flag.Parse()
+
+	re, err := regexp.Compile(*run)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "grandbench:", err)
+		os.Exit(2)
+	}
+
+	// testing.Benchmark reads the -test.benchtime flag.
+	testing.Init()
+	flag.Set("test.benchtime", benchtime.String())
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Source\tBits\tState (bytes)\tPeriod\tUint32 (ns/op)\tUint64 (ns/op)\tFloat64 (ns/op)\tJump (ns/op)\t")
+	for _, e := range entries {
+		if !re.MatchString(e.name) {
+			continue
+		}
+
+		jump := "-"
+		if _, ok := e.src().(grand.JumpableSource); ok {
+			jump = nsPerOp(benchJump(e.src))
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t\n", e.name, e.bits, e.state, e.period,
+			nsPerOp(benchUint32(e.src)), nsPerOp(benchUint64(e.src)), nsPerOp(benchFloat64(e.src)), jump)
+	}
+	w.Flush()
+}
+
+func nsPerOp(r testing.BenchmarkResult) string {
+	if r.N == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.2f", float64(r.T.Nanoseconds())/float64(r.N))
+}
+
+func benchUint32(src func() grand.Source) testing.BenchmarkResult {
+	return testing.Benchmark(func(b *testing.B) {
+		r := grand.New(src())
+		for i := 0; i < b.N; i++ {
+			sink32 = r.Uint32()
+		}
+	})
+}
+
+func benchUint64(src func() grand.Source) testing.BenchmarkResult {
+	return testing.Benchmark(func(b *testing.B) {
+		r := grand.New(src())
+		for i := 0; i < b.N; i++ {
+			sink64 = r.Uint64()
+		}
+	})
+}
+
+func benchFloat64(src func() grand.Source) testing.BenchmarkResult {
+	return testing.Benchmark(func(b *testing.B) {
+		r := grand.New(src())
+		for i := 0; i < b.N; i++ {
+			sinkF64 = r.Float64()
+		}
+	})
+}
+
+func benchJump(src func() grand.Source) testing.BenchmarkResult {
+	return testing.Benchmark(func(b *testing.B) {
+		r := grand.NewJumpable(src().(grand.JumpableSource))
+		for i := 0; i < b.N; i++ {
+			r.Jump()
+		}
+	})
+}
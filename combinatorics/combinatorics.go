@@ -0,0 +1,40 @@
+// Package combinatorics implements random graphs and combinatorial structures over grand.Rand: the
+// Erdős–Rényi G(n,p) and G(n,m) graphs, the Barabási–Albert, Watts–Strogatz and random regular graphs,
+// uniform spanning trees, set partitions and derangements.
+//
+// The vertices of a graph of n vertices are the integers [0,n), and a graph is returned as a list of
+// edges, in the order they were drawn, which Adjacency turns into adjacency lists. All of the structures
+// are reproducible from the state of the source.
+package combinatorics
+
+// Edge is an undirected edge between the vertices U < V.
+type Edge struct {
+	U, V int
+}
+
+// Returns the edge between u and v.
+func edge(u, v int) Edge {
+	if u > v {
+		u, v = v, u
+	}
+
+	return Edge{u, v}
+}
+
+// Adjacency returns the adjacency lists of a graph of n vertices: adj[u] lists the neighbours of u, in the
+// order of edges. It panics if a vertex is not in [0,n).
+func Adjacency(n int, edges []Edge) [][]int {
+	adj := make([][]int, n)
+	for _, e := range edges {
+		if e.U < 0 || e.V < 0 || e.U >= n || e.V >= n {
+			panic("invalid argument to Adjacency")
+		}
+
+		adj[e.U] = append(adj[e.U], e.V)
+		if e.U != e.V {
+			adj[e.V] = append(adj[e.V], e.U)
+		}
+	}
+
+	return adj
+}
@@ -0,0 +1,122 @@
+package combinatorics_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/combinatorics"
+	"github.com/jtejido/grand/source64"
+)
+
+const samples = 100000
+
+func newRand() *grand.Rand {
+	return grand.New(source64.NewSplitMix64(1))
+}
+
+// checkSimple fails the test if the edges are not those of a simple graph of n vertices.
+func checkSimple(t *testing.T, n int, edges []combinatorics.Edge) {
+	t.Helper()
+
+	seen := make(map[combinatorics.Edge]bool)
+	for _, e := range edges {
+		if e.U < 0 || e.U >= e.V || e.V >= n {
+			t.Fatalf("invalid edge %v of a graph of %d vertices", e, n)
+		}
+		if seen[e] {
+			t.Fatalf("multiple edge %v", e)
+		}
+		seen[e] = true
+	}
+}
+
+// checkCount fails the test if the count of an event of probability p in samples trials is farther than 5.2
+// standard deviations from its mean.
+func checkCount(t *testing.T, what string, count, samples int, p float64) {
+	t.Helper()
+
+	sd := math.Sqrt(float64(samples) * p * (1 - p))
+	if z := math.Abs(float64(count)-float64(samples)*p) / sd; z > 5.2 {
+		t.Errorf("%s: Mismatch. want: %v, got: %v", what, p, float64(count)/float64(samples))
+	}
+}
+
+// indexer numbers the structures in the order they are first seen, so that their uniformity can be checked.
+type indexer map[string]uint64
+
+func (ix indexer) index(v interface{}) uint64 {
+	key := fmt.Sprint(v)
+	i, ok := ix[key]
+	if !ok {
+		i = uint64(len(ix))
+		ix[key] = i
+	}
+
+	return i
+}
+
+func TestAdjacency(t *testing.T) {
+	adj := combinatorics.Adjacency(4, []combinatorics.Edge{{0, 1}, {1, 2}, {0, 2}})
+	want := [][]int{{1, 2}, {0, 2}, {1, 0}, nil}
+	if fmt.Sprint(adj) != fmt.Sprint(want) {
+		t.Errorf("Mismatch. want: %v, got: %v", want, adj)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Adjacency should panic")
+		}
+	}()
+	combinatorics.Adjacency(2, []combinatorics.Edge{{0, 2}})
+}
+
+func TestReproducible(t *testing.T) {
+	cases := map[string]func(r *grand.Rand) interface{}{
+		"GNP":            func(r *grand.Rand) interface{} { return combinatorics.GNP(r, 50, 0.1) },
+		"GNM":            func(r *grand.Rand) interface{} { return combinatorics.GNM(r, 50, 100) },
+		"BarabasiAlbert": func(r *grand.Rand) interface{} { return combinatorics.BarabasiAlbert(r, 50, 3) },
+		"WattsStrogatz":  func(r *grand.Rand) interface{} { return combinatorics.WattsStrogatz(r, 50, 4, 0.2) },
+		"Regular":        func(r *grand.Rand) interface{} { return combinatorics.Regular(r, 50, 3) },
+		"SetPartition":   func(r *grand.Rand) interface{} { return combinatorics.SetPartition(r, 50) },
+		"Derangement":    func(r *grand.Rand) interface{} { return combinatorics.Derangement(r, 50) },
+	}
+
+	for name, f := range cases {
+		r := newRand()
+		a := fmt.Sprint(f(r))
+		r.Restart()
+		if b := fmt.Sprint(f(r)); a != b {
+			t.Errorf("%s: Mismatch. want: %v, got: %v", name, a, b)
+		}
+	}
+}
+
+func TestPanics(t *testing.T) {
+	r := newRand()
+	cases := map[string]func(){
+		"GNP(-1)":              func() { combinatorics.GNP(r, -1, 0.5) },
+		"GNP(p)":               func() { combinatorics.GNP(r, 5, 1.5) },
+		"GNM":                  func() { combinatorics.GNM(r, 4, 7) },
+		"BarabasiAlbert":       func() { combinatorics.BarabasiAlbert(r, 3, 3) },
+		"WattsStrogatz(k odd)": func() { combinatorics.WattsStrogatz(r, 10, 3, 0.5) },
+		"WattsStrogatz(k>=n)":  func() { combinatorics.WattsStrogatz(r, 4, 4, 0.5) },
+		"Regular(nd odd)":      func() { combinatorics.Regular(r, 5, 3) },
+		"Regular(d>=n)":        func() { combinatorics.Regular(r, 4, 4) },
+		"SpanningTree":         func() { combinatorics.SpanningTree(r, [][]int{{1}, {0}, {}}) },
+		"SetPartition":         func() { combinatorics.SetPartition(r, -1) },
+		"Derangement":          func() { combinatorics.Derangement(r, 1) },
+	}
+
+	for name, f := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s should panic", name)
+				}
+			}()
+			f()
+		})
+	}
+}
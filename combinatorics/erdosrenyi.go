@@ -0,0 +1,98 @@
+package combinatorics
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// GNP returns the edges of an Erdős–Rényi G(n,p) graph, where each of the n(n-1)/2 edges is present with
+// probability p, independently. The gaps between the present edges, taken in the order (0,1), (0,2),
+// (1,2), (0,3), ..., are geometric, so that it takes O(n + edges) time rather than O(n^2).
+// It panics if n < 0 or p is not in [0,1].
+//
+// Vladimir Batagelj, Ulrik Brandes, Efficient generation of large random networks.
+// Physical Review E, 2005, 71(3), 036113.
+func GNP(r *grand.Rand, n int, p float64) []Edge {
+	if n < 0 || !(p >= 0 && p <= 1) {
+		panic("invalid argument to GNP")
+	}
+
+	var edges []Edge
+	if p == 0 || n < 2 {
+		return edges
+	}
+	if p == 1 {
+		for v := 1; v < n; v++ {
+			for u := 0; u < v; u++ {
+				edges = append(edges, Edge{u, v})
+			}
+		}
+		return edges
+	}
+
+	// a gap beyond the number of pairs ends the graph (and would overflow an int).
+	lp := math.Log1p(-p)
+	pairs := float64(n) * float64(n-1) / 2
+	v, w := 1, -1
+	for v < n {
+		skip := math.Floor(math.Log1p(-r.Float64()) / lp)
+		if skip >= pairs {
+			break
+		}
+
+		w += 1 + int(skip)
+		for w >= v && v < n {
+			w -= v
+			v++
+		}
+		if v < n {
+			edges = append(edges, Edge{w, v})
+		}
+	}
+
+	return edges
+}
+
+// GNM returns the edges of an Erdős–Rényi G(n,m) graph, a uniform choice of m of the n(n-1)/2 edges.
+// The edges are drawn with Floyd's sampling algorithm, in O(m) time and memory.
+// It panics if n < 0, m < 0 or m > n(n-1)/2.
+func GNM(r *grand.Rand, n, m int) []Edge {
+	if n < 0 || m < 0 {
+		panic("invalid argument to GNM")
+	}
+	pairs := uint64(n) * uint64(n-1) / 2
+	if n < 2 {
+		pairs = 0
+	}
+	if uint64(m) > pairs {
+		panic("invalid argument to GNM")
+	}
+
+	// the pair (u, v), u < v, has index v(v-1)/2 + u.
+	chosen := make(map[uint64]bool, m)
+	edges := make([]Edge, 0, m)
+	for j := pairs - uint64(m); j < pairs; j++ {
+		k := r.Uint64n(j + 1)
+		if chosen[k] {
+			k = j
+		}
+		chosen[k] = true
+		edges = append(edges, pair(k))
+	}
+
+	return edges
+}
+
+// Returns the pair of index k (see GNM).
+func pair(k uint64) Edge {
+	v := uint64((1 + math.Sqrt(1+8*float64(k))) / 2)
+	for v*(v-1)/2 > k {
+		v--
+	}
+	for (v+1)*v/2 <= k {
+		v++
+	}
+
+	return Edge{int(k - v*(v-1)/2), int(v)}
+}
@@ -0,0 +1,69 @@
+package combinatorics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jtejido/grand/combinatorics"
+	"github.com/jtejido/grand/grandtest"
+)
+
+func TestGNP(t *testing.T) {
+	r := newRand()
+
+	// each of the 10 edges of G(5, 0.3) is present with probability 0.3.
+	const trials = samples / 10
+	counts := make(map[combinatorics.Edge]int)
+	for i := 0; i < trials; i++ {
+		edges := combinatorics.GNP(r, 5, 0.3)
+		checkSimple(t, 5, edges)
+		for _, e := range edges {
+			counts[e]++
+		}
+	}
+	if len(counts) != 10 {
+		t.Fatalf("Mismatch. want: %v, got: %v", 10, len(counts))
+	}
+	for e, c := range counts {
+		checkCount(t, fmt.Sprint("edge ", e), c, trials, 0.3)
+	}
+
+	// a sparse graph.
+	edges := combinatorics.GNP(r, 50000, 2e-5)
+	checkSimple(t, 50000, edges)
+	checkCount(t, "edges of G(5e4, 2e-5)", len(edges), 50000*49999/2, 2e-5)
+
+	if got := len(combinatorics.GNP(r, 10, 1)); got != 45 {
+		t.Errorf("Mismatch. want: %v, got: %v", 45, got)
+	}
+	if got := len(combinatorics.GNP(r, 10, 0)); got != 0 {
+		t.Errorf("Mismatch. want: %v, got: %v", 0, got)
+	}
+	if got := len(combinatorics.GNP(r, 10, 1e-300)); got != 0 {
+		t.Errorf("Mismatch. want: %v, got: %v", 0, got)
+	}
+}
+
+func TestGNM(t *testing.T) {
+	r := newRand()
+
+	// the 10 choose 3 = 120 graphs of G(5, 3) are equally likely.
+	ix := make(indexer)
+	grandtest.CheckUniform(t, func() uint64 {
+		edges := combinatorics.GNM(r, 5, 3)
+		checkSimple(t, 5, edges)
+		set := make([]bool, 25)
+		for _, e := range edges {
+			set[e.U*5+e.V] = true
+		}
+		return ix.index(set)
+	}, 120, 120, samples)
+
+	for _, m := range []int{0, 1, 44, 45} {
+		edges := combinatorics.GNM(r, 10, m)
+		checkSimple(t, 10, edges)
+		if len(edges) != m {
+			t.Errorf("Mismatch. want: %v, got: %v", m, len(edges))
+		}
+	}
+}
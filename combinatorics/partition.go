@@ -0,0 +1,103 @@
+package combinatorics
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// SetPartition returns a uniform partition of the set [0,n), as the block of each element: the blocks are
+// numbered in the order of their smallest elements, so that each partition has a single representation
+// (a restricted growth string, block[0] = 0 and block[i] <= 1 + max(block[:i])).
+// It uses Stam's method: a number of urns K is drawn with P(K = k) = k^n / (e k! B_n), where B_n is the
+// Bell number, and the elements are thrown into the K urns uniformly, the empty ones being dropped.
+// It panics if n < 0.
+//
+// A. J. Stam, Generation of a random partition of a finite set by an urn model.
+// Journal of Combinatorial Theory, Series A, 1983, 35(2), 231--240.
+func SetPartition(r *grand.Rand, n int) []int {
+	if n < 0 {
+		panic("invalid argument to SetPartition")
+	}
+
+	block := make([]int, n)
+	if n == 0 {
+		return block
+	}
+
+	// log(k^n/k!) is concave in k: the terms are summed past their mode until they are negligible.
+	var logs []float64
+	max := math.Inf(-1)
+	for k := 1; ; k++ {
+		lg, _ := math.Lgamma(float64(k + 1))
+		t := float64(n)*math.Log(float64(k)) - lg
+		logs = append(logs, t)
+		if t > max {
+			max = t
+		} else if t < max-60 {
+			break
+		}
+	}
+
+	sum := 0.0
+	for i, t := range logs {
+		logs[i] = math.Exp(t - max)
+		sum += logs[i]
+	}
+
+	k := len(logs)
+	u := r.Float64() * sum
+	for i, w := range logs {
+		if u < w {
+			k = i + 1
+			break
+		}
+		u -= w
+	}
+
+	label := make([]int, k)
+	for i := range label {
+		label[i] = -1
+	}
+	blocks := 0
+	for i := range block {
+		urn := r.Intn(k)
+		if label[urn] < 0 {
+			label[urn] = blocks
+			blocks++
+		}
+		block[i] = label[urn]
+	}
+
+	return block
+}
+
+// Derangement returns a uniform derangement of [0,n), a permutation p with p[i] != i for all i. The
+// permutations of a Fisher–Yates shuffle are drawn again as soon as a fixed point shows up, e times on average.
+// It panics if n < 0 or n == 1.
+func Derangement(r *grand.Rand, n int) []int {
+	if n < 0 || n == 1 {
+		panic("invalid argument to Derangement")
+	}
+
+	p := make([]int, n)
+	for {
+		for i := range p {
+			p[i] = i
+		}
+
+		// p[i] is final once swapped, from the last position down.
+		ok := true
+		for i := n - 1; i > 0; i-- {
+			j := r.Intn(i + 1)
+			p[i], p[j] = p[j], p[i]
+			if p[i] == i {
+				ok = false
+				break
+			}
+		}
+		if ok && (n == 0 || p[0] != 0) {
+			return p
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package combinatorics_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/combinatorics"
+	"github.com/jtejido/grand/grandtest"
+)
+
+func TestSetPartition(t *testing.T) {
+	r := newRand()
+
+	// the B_5 = 52 partitions of 5 elements are equally likely.
+	ix := make(indexer)
+	grandtest.CheckUniform(t, func() uint64 {
+		return ix.index(combinatorics.SetPartition(r, 5))
+	}, 52, 52, samples)
+
+	// restricted growth strings.
+	for _, n := range []int{0, 1, 10, 1000} {
+		block := combinatorics.SetPartition(r, n)
+		if len(block) != n {
+			t.Fatalf("Mismatch. want: %v, got: %v", n, len(block))
+		}
+		max := -1
+		for _, b := range block {
+			if b < 0 || b > max+1 {
+				t.Fatalf("invalid block numbering: %v", block)
+			}
+			if b > max {
+				max = b
+			}
+		}
+	}
+}
+
+func TestDerangement(t *testing.T) {
+	r := newRand()
+
+	// the 44 derangements of 5 elements are equally likely.
+	ix := make(indexer)
+	grandtest.CheckUniform(t, func() uint64 {
+		p := combinatorics.Derangement(r, 5)
+		seen := make([]bool, 5)
+		for i, v := range p {
+			if v == i || seen[v] {
+				t.Fatalf("invalid derangement: %v", p)
+			}
+			seen[v] = true
+		}
+		return ix.index(p)
+	}, 44, 44, samples)
+
+	if got := combinatorics.Derangement(r, 0); len(got) != 0 {
+		t.Errorf("Mismatch. want: %v, got: %v", 0, len(got))
+	}
+}
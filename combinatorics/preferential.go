@@ -0,0 +1,54 @@
+package combinatorics
+
+import (
+	"github.com/jtejido/grand"
+)
+
+// BarabasiAlbert returns the edges of a Barabási–Albert graph of n vertices: starting from a star of the
+// vertices [0,m], each new vertex is joined to m distinct vertices, drawn with probabilities proportional
+// to their degrees (preferential attachment). The graph has m(n-m) edges.
+// It panics if m < 1 or m >= n.
+//
+// Albert-László Barabási, Réka Albert, Emergence of Scaling in Random Networks.
+// Science, 1999, 286(5439), 509--512.
+func BarabasiAlbert(r *grand.Rand, n, m int) []Edge {
+	if m < 1 || m >= n {
+		panic("invalid argument to BarabasiAlbert")
+	}
+
+	// ends lists both vertices of each edge, so that a uniform element of it is drawn by degree.
+	edges := make([]Edge, 0, m*(n-m))
+	ends := make([]int, 0, 2*m*(n-m))
+	for v := 1; v <= m; v++ {
+		edges = append(edges, Edge{0, v})
+		ends = append(ends, 0, v)
+	}
+
+	targets := make([]int, 0, m)
+	for v := m + 1; v < n; v++ {
+		targets = targets[:0]
+		for len(targets) < m {
+			t := ends[r.Intn(len(ends))]
+			if !contains(targets, t) {
+				targets = append(targets, t)
+			}
+		}
+
+		for _, t := range targets {
+			edges = append(edges, Edge{t, v})
+			ends = append(ends, t, v)
+		}
+	}
+
+	return edges
+}
+
+func contains(s []int, x int) bool {
+	for _, y := range s {
+		if y == x {
+			return true
+		}
+	}
+
+	return false
+}
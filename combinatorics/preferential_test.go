@@ -0,0 +1,44 @@
+package combinatorics_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/combinatorics"
+)
+
+func TestBarabasiAlbert(t *testing.T) {
+	r := newRand()
+	const n, m = 2000, 3
+	edges := combinatorics.BarabasiAlbert(r, n, m)
+	checkSimple(t, n, edges)
+	if len(edges) != m*(n-m) {
+		t.Fatalf("Mismatch. want: %v, got: %v", m*(n-m), len(edges))
+	}
+
+	// each vertex joins m earlier ones.
+	adj := combinatorics.Adjacency(n, edges)
+	earlier := make([]int, n)
+	for _, e := range edges {
+		earlier[e.V]++
+	}
+	for v := m + 1; v < n; v++ {
+		if earlier[v] != m {
+			t.Fatalf("vertex %d: Mismatch. want: %v, got: %v", v, m, earlier[v])
+		}
+	}
+
+	// preferential attachment: the degree of a vertex born at time s grows as m*sqrt(n/s), so that the
+	// first vertices are hubs and the maximum degree is far above that of G(n,m) graphs (about 15 here).
+	max := 0
+	for _, a := range adj {
+		if len(a) > max {
+			max = len(a)
+		}
+	}
+	if max < 50 {
+		t.Errorf("Mismatch. want: a maximum degree above %v, got: %v", 50, max)
+	}
+	if early, late := len(adj[m+1]), len(adj[n-1]); early <= late {
+		t.Errorf("an early vertex should have a larger degree than a late one: %v <= %v", early, late)
+	}
+}
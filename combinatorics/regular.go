@@ -0,0 +1,91 @@
+package combinatorics
+
+import (
+	"github.com/jtejido/grand"
+)
+
+// Regular returns the edges of a random d-regular graph of n vertices, with the algorithm of Steger and
+// Wormald: the nd points of the configuration model (d per vertex) are paired at random, a pair being
+// drawn again if it would make a loop or a multiple edge, and the whole graph if no valid pair is left.
+// The graph is asymptotically uniform for d = o(n^(1/28)) and, in practice, close to uniform beyond.
+// It panics if d < 0, d >= n (unless n = d = 0) or nd is odd.
+//
+// Angelika Steger, Nicholas C. Wormald, Generating random regular graphs quickly.
+// Combinatorics, Probability and Computing, 1999, 8(4), 377--396.
+func Regular(r *grand.Rand, n, d int) []Edge {
+	if d < 0 || (d >= n && n > 0) || n < 0 || n*d%2 != 0 {
+		panic("invalid argument to Regular")
+	}
+
+	for {
+		if edges, ok := tryRegular(r, n, d); ok {
+			return edges
+		}
+	}
+}
+
+// Pairs the points of a d-regular graph, or fails if no valid pair is left.
+func tryRegular(r *grand.Rand, n, d int) ([]Edge, bool) {
+	points := make([]int, n*d)
+	for i := range points {
+		points[i] = i / d
+	}
+
+	edges := make([]Edge, 0, n*d/2)
+	present := make(map[Edge]bool, n*d/2)
+	rem, failures := len(points), 0
+	for rem > 0 {
+		i := r.Intn(rem)
+		j := r.Intn(rem - 1)
+		if j >= i {
+			j++
+		}
+
+		u, v := points[i], points[j]
+		if u != v && !present[edge(u, v)] {
+			e := edge(u, v)
+			present[e] = true
+			edges = append(edges, e)
+
+			// the points are removed by swapping with the last ones, the larger index first.
+			if i < j {
+				i, j = j, i
+			}
+			points[i] = points[rem-1]
+			points[j] = points[rem-2]
+			rem -= 2
+			failures = 0
+			continue
+		}
+
+		// the pairs left are checked once draws fail as often as there are points.
+		if failures++; failures > rem {
+			if !suitable(points[:rem], present) {
+				return nil, false
+			}
+			failures = 0
+		}
+	}
+
+	return edges, true
+}
+
+// Reports whether two of the points belong to distinct vertices that are not joined yet.
+func suitable(points []int, present map[Edge]bool) bool {
+	var vertices []int
+	for _, p := range points {
+		if !contains(vertices, p) {
+			vertices = append(vertices, p)
+		}
+	}
+
+	for a, u := range vertices {
+		for _, v := range vertices[a+1:] {
+			if !present[edge(u, v)] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,32 @@
+package combinatorics_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/combinatorics"
+	"github.com/jtejido/grand/grandtest"
+)
+
+func TestRegular(t *testing.T) {
+	r := newRand()
+	for _, c := range [][2]int{{10, 3}, {100, 4}, {1000, 7}, {8, 7}, {0, 0}, {5, 0}} {
+		n, d := c[0], c[1]
+		edges := combinatorics.Regular(r, n, d)
+		checkSimple(t, n, edges)
+		for u, a := range combinatorics.Adjacency(n, edges) {
+			if len(a) != d {
+				t.Fatalf("vertex %d of Regular(%d, %d): Mismatch. want: %v, got: %v", u, n, d, d, len(a))
+			}
+		}
+	}
+
+	// the three 4-cycles of 4 vertices are equally likely.
+	ix := make(indexer)
+	grandtest.CheckUniform(t, func() uint64 {
+		set := make([]bool, 16)
+		for _, e := range combinatorics.Regular(r, 4, 2) {
+			set[e.U*4+e.V] = true
+		}
+		return ix.index(set)
+	}, 3, 3, samples)
+}
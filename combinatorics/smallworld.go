@@ -0,0 +1,61 @@
+package combinatorics
+
+import (
+	"github.com/jtejido/grand"
+)
+
+// WattsStrogatz returns the edges of a Watts–Strogatz small-world graph of n vertices: each vertex of a
+// ring is joined to its k/2 neighbours on either side, then each edge (u, u+j) is rewired with probability
+// beta to (u, w), w uniform among the vertices that are neither u nor a neighbour of u. The graph has nk/2
+// edges, and is the ring for beta = 0.
+// It panics if k is odd, k < 0, k >= n or beta is not in [0,1].
+//
+// Duncan J. Watts, Steven H. Strogatz, Collective dynamics of 'small-world' networks.
+// Nature, 1998, 393(6684), 440--442.
+func WattsStrogatz(r *grand.Rand, n, k int, beta float64) []Edge {
+	if k < 0 || k%2 != 0 || k >= n || !(beta >= 0 && beta <= 1) {
+		panic("invalid argument to WattsStrogatz")
+	}
+
+	edges := make([]Edge, 0, n*k/2)
+	index := make(map[Edge]int, n*k/2)
+	degree := make([]int, n)
+	for j := 1; j <= k/2; j++ {
+		for u := 0; u < n; u++ {
+			e := edge(u, (u+j)%n)
+			index[e] = len(edges)
+			edges = append(edges, e)
+			degree[u]++
+			degree[(u+j)%n]++
+		}
+	}
+
+	// the edges are rewired ring by ring, as in the paper.
+	for j := 1; j <= k/2; j++ {
+		for u := 0; u < n; u++ {
+			v := (u + j) % n
+			i, ok := index[edge(u, v)]
+			if !ok || degree[u] == n-1 || !r.Bernoulli(beta) {
+				continue
+			}
+
+			w := r.Intn(n)
+			for w == u || joined(index, u, w) {
+				w = r.Intn(n)
+			}
+
+			delete(index, edges[i])
+			degree[v]--
+			edges[i] = edge(u, w)
+			index[edges[i]] = i
+			degree[w]++
+		}
+	}
+
+	return edges
+}
+
+func joined(index map[Edge]int, u, v int) bool {
+	_, ok := index[edge(u, v)]
+	return ok
+}
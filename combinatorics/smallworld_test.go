@@ -0,0 +1,42 @@
+package combinatorics_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/combinatorics"
+)
+
+func TestWattsStrogatz(t *testing.T) {
+	r := newRand()
+	const n, k = 1000, 6
+
+	ring := combinatorics.WattsStrogatz(r, n, k, 0)
+	checkSimple(t, n, ring)
+	for _, e := range ring {
+		if d := e.V - e.U; d > k/2 && n-d > k/2 {
+			t.Fatalf("the ring should not have the edge %v", e)
+		}
+	}
+
+	// a fraction beta of the edges is rewired: a rewired edge is a ring edge with probability about k/n.
+	for _, beta := range []float64{0.1, 0.5, 1} {
+		edges := combinatorics.WattsStrogatz(r, n, k, beta)
+		checkSimple(t, n, edges)
+		if len(edges) != n*k/2 {
+			t.Fatalf("Mismatch. want: %v, got: %v", n*k/2, len(edges))
+		}
+
+		long := 0
+		for _, e := range edges {
+			if d := e.V - e.U; d > k/2 && n-d > k/2 {
+				long++
+			}
+		}
+		checkCount(t, "rewired edges", long, len(edges), beta*(1-float64(k)/n))
+	}
+
+	// the complete graph cannot be rewired.
+	if edges := combinatorics.WattsStrogatz(r, 5, 4, 1); len(edges) != 10 {
+		t.Errorf("Mismatch. want: %v, got: %v", 10, len(edges))
+	}
+}
@@ -0,0 +1,66 @@
+package combinatorics
+
+import (
+	"github.com/jtejido/grand"
+)
+
+// SpanningTree returns the n-1 edges of a uniform spanning tree of the connected graph of adjacency lists adj
+// (adj[u] lists the neighbours of u, and v is in adj[u] if and only if u is in adj[v]), with Wilson's
+// algorithm: loop-erased random walks from each vertex to the tree grown so far. A neighbour listed twice
+// counts as two parallel edges. It panics if the graph is not connected.
+//
+// David Bruce Wilson, Generating random spanning trees more quickly than the cover time.
+// Proceedings of the 28th ACM Symposium on Theory of Computing, 1996, 296--303.
+func SpanningTree(r *grand.Rand, adj [][]int) []Edge {
+	n := len(adj)
+	if !connected(adj) {
+		panic("invalid argument to SpanningTree")
+	}
+	if n == 0 {
+		return nil
+	}
+
+	// next holds the last exit of the walk from each vertex, which erases its loops.
+	edges := make([]Edge, 0, n-1)
+	inTree := make([]bool, n)
+	next := make([]int, n)
+	inTree[0] = true
+	for i := 1; i < n; i++ {
+		for u := i; !inTree[u]; u = next[u] {
+			next[u] = adj[u][r.Intn(len(adj[u]))]
+		}
+		for u := i; !inTree[u]; u = next[u] {
+			inTree[u] = true
+			edges = append(edges, edge(u, next[u]))
+		}
+	}
+
+	return edges
+}
+
+// Reports whether all the vertices are reached from vertex 0.
+func connected(adj [][]int) bool {
+	if len(adj) == 0 {
+		return true
+	}
+
+	seen := make([]bool, len(adj))
+	seen[0] = true
+	stack, count := []int{0}, 1
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, v := range adj[u] {
+			if v < 0 || v >= len(adj) {
+				return false
+			}
+			if !seen[v] {
+				seen[v] = true
+				count++
+				stack = append(stack, v)
+			}
+		}
+	}
+
+	return count == len(adj)
+}
@@ -0,0 +1,58 @@
+package combinatorics_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/combinatorics"
+	"github.com/jtejido/grand/grandtest"
+)
+
+func TestSpanningTree(t *testing.T) {
+	r := newRand()
+
+	// the 16 spanning trees of K4 (Cayley's formula) are equally likely.
+	k4 := [][]int{{1, 2, 3}, {0, 2, 3}, {0, 1, 3}, {0, 1, 2}}
+	ix := make(indexer)
+	grandtest.CheckUniform(t, func() uint64 {
+		set := make([]bool, 16)
+		for _, e := range combinatorics.SpanningTree(r, k4) {
+			set[e.U*4+e.V] = true
+		}
+		return ix.index(set)
+	}, 16, 16, samples)
+
+	// the 4-cycle with a chord (0, 2) has 8 spanning trees.
+	g := combinatorics.Adjacency(4, []combinatorics.Edge{{0, 1}, {1, 2}, {2, 3}, {0, 3}, {0, 2}})
+	ix = make(indexer)
+	grandtest.CheckUniform(t, func() uint64 {
+		set := make([]bool, 16)
+		for _, e := range combinatorics.SpanningTree(r, g) {
+			set[e.U*4+e.V] = true
+		}
+		return ix.index(set)
+	}, 8, 8, samples)
+
+	// a tree spans the graph: n-1 edges of the graph, connected.
+	const n = 500
+	edges := combinatorics.GNP(r, n, 0.05)
+	adj := combinatorics.Adjacency(n, edges)
+	present := make(map[combinatorics.Edge]bool)
+	for _, e := range edges {
+		present[e] = true
+	}
+	tree := combinatorics.SpanningTree(r, adj)
+	checkSimple(t, n, tree)
+	if len(tree) != n-1 {
+		t.Fatalf("Mismatch. want: %v, got: %v", n-1, len(tree))
+	}
+	for _, e := range tree {
+		if !present[e] {
+			t.Fatalf("the edge %v is not in the graph", e)
+		}
+	}
+	combinatorics.SpanningTree(r, combinatorics.Adjacency(n, tree))
+
+	if got := combinatorics.SpanningTree(r, [][]int{nil}); len(got) != 0 {
+		t.Errorf("Mismatch. want: %v, got: %v", 0, len(got))
+	}
+}
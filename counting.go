@@ -0,0 +1,75 @@
+package grand
+
+// CountingSource wraps a Source and counts the random bits drawn from it: 32 for Uint32(), 64 for
+// Uint64() and 1 for Bool(), so that the entropy consumed per sample by a method of Rand can be measured.
+// The bits of a word that a source caches for Bool() are counted as they are used, not when the word is drawn.
+type CountingSource struct {
+	src  Source
+	s64  Source64
+	bits uint64
+}
+
+// NewCountingSource returns a CountingSource of src, whose count starts at 0.
+func NewCountingSource(src Source) *CountingSource {
+	s64, _ := src.(Source64)
+	return &CountingSource{src: src, s64: s64}
+}
+
+// Bits returns the number of bits drawn since the CountingSource was made, or since ResetBits().
+func (c *CountingSource) Bits() uint64 {
+	return c.bits
+}
+
+// ResetBits sets the count to 0. Seed() and Restart() leave it unchanged.
+func (c *CountingSource) ResetBits() {
+	c.bits = 0
+}
+
+func (c *CountingSource) Uint32() uint32 {
+	c.bits += 32
+	return c.src.Uint32()
+}
+
+// Uint64 returns the Uint64() of the source, or two values of Uint32() (high bits first).
+func (c *CountingSource) Uint64() uint64 {
+	c.bits += 64
+	if c.s64 != nil {
+		return c.s64.Uint64()
+	}
+
+	return uint64(c.src.Uint32())<<32 | uint64(c.src.Uint32())
+}
+
+func (c *CountingSource) Bool() bool {
+	c.bits++
+	return c.src.Bool()
+}
+
+func (c *CountingSource) Seed(seed int64) {
+	c.src.Seed(seed)
+}
+
+func (c *CountingSource) Restart() {
+	c.src.Restart()
+}
+
+// CountingJumpableSource is the CountingSource of a JumpableSource.
+type CountingJumpableSource struct {
+	CountingSource
+}
+
+// NewCountingJumpableSource returns a CountingJumpableSource of src, whose count starts at 0.
+func NewCountingJumpableSource(src JumpableSource) *CountingJumpableSource {
+	cs := new(CountingJumpableSource)
+	cs.src = src
+	cs.s64, _ = src.(Source64)
+	return cs
+}
+
+func (c *CountingJumpableSource) RestartSubstream() {
+	c.src.(JumpableSource).RestartSubstream()
+}
+
+func (c *CountingJumpableSource) Jump() {
+	c.src.(JumpableSource).Jump()
+}
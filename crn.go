@@ -0,0 +1,83 @@
+package grand
+
+// CommonRandomNumbers maps the named components of a model (e.g. arrivals, service times, failures) to
+// fixed substreams, as in the workflows of L'Ecuyer's RngStreams: each component draws from its own
+// generator, so that the scenarios of a comparison see the same random numbers in each component
+// (common random numbers), whatever the number of values the other components draw.
+//
+// With n components, the component k of replication r uses the substream r*n + k of the source, i.e. the
+// stream of newSource() jumped r*n + k times. Reset() restarts the current replication for the next
+// scenario, and NextReplication() moves all the components to their substreams of the next replication.
+type CommonRandomNumbers struct {
+	index       map[string]int
+	names       []string
+	streams     []*JumpableRand
+	replication int
+}
+
+// NewCommonRandomNumbers returns the common random numbers of the given components, in that order: the
+// mapping of the names to the substreams only depends on it. newSource must return a new, independent
+// source on every call, all starting at the same stream (e.g. the same seed).
+func NewCommonRandomNumbers(newSource func() JumpableSource, names ...string) *CommonRandomNumbers {
+	ans := &CommonRandomNumbers{
+		index:   make(map[string]int, len(names)),
+		names:   append([]string{}, names...),
+		streams: make([]*JumpableRand, len(names)),
+	}
+
+	for k, name := range names {
+		if _, ok := ans.index[name]; ok {
+			panic("duplicate component " + name)
+		}
+		ans.index[name] = k
+
+		src := newSource()
+		for i := 0; i < k; i++ {
+			src.Jump()
+		}
+		ans.streams[k] = NewJumpable(src)
+	}
+
+	return ans
+}
+
+// Stream returns the generator of the named component. Its substreams must be left to Reset() and
+// NextReplication(), but it may be restarted with RestartSubstream().
+// It panics if the component is unknown.
+func (crn *CommonRandomNumbers) Stream(name string) *JumpableRand {
+	k, ok := crn.index[name]
+	if !ok {
+		panic("unknown component " + name)
+	}
+
+	return crn.streams[k]
+}
+
+// Names returns the names of the components, in the order of their substreams.
+func (crn *CommonRandomNumbers) Names() []string {
+	return append([]string{}, crn.names...)
+}
+
+// Replication returns the number of the current replication, starting at 0.
+func (crn *CommonRandomNumbers) Replication() int {
+	return crn.replication
+}
+
+// Reset restarts every component at the beginning of its substream of the current replication, for the
+// run of the next scenario.
+func (crn *CommonRandomNumbers) Reset() {
+	for _, r := range crn.streams {
+		r.RestartSubstream()
+	}
+}
+
+// NextReplication moves every component to the beginning of its substream of the next replication.
+func (crn *CommonRandomNumbers) NextReplication() {
+	for _, r := range crn.streams {
+		for i := 0; i < len(crn.streams); i++ {
+			r.Jump()
+		}
+	}
+
+	crn.replication++
+}
@@ -0,0 +1,87 @@
+package grand_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+)
+
+func newMRG32k3A() grand.JumpableSource {
+	return source32.NewMRG32k3A(12345)
+}
+
+func draws(r *grand.JumpableRand, n int) []uint32 {
+	ans := make([]uint32, n)
+	for i := range ans {
+		ans[i] = r.Uint32()
+	}
+
+	return ans
+}
+
+func TestCommonRandomNumbers(t *testing.T) {
+	names := []string{"arrivals", "service", "failures"}
+	crn := grand.NewCommonRandomNumbers(newMRG32k3A, names...)
+
+	// component k of replication r uses the substream r*3 + k.
+	substream := func(n int) []uint32 {
+		src := newMRG32k3A()
+		for i := 0; i < n; i++ {
+			src.Jump()
+		}
+		return draws(grand.NewJumpable(src), 10)
+	}
+
+	for r := 0; r < 3; r++ {
+		if crn.Replication() != r {
+			t.Errorf("Mismatch. want: %v, got: %v", r, crn.Replication())
+		}
+
+		// scenario 1 draws more from the arrivals than scenario 2: the other components are unaffected.
+		scenario1 := make(map[string][]uint32)
+		draws(crn.Stream("arrivals"), 1000)
+		for _, name := range names {
+			scenario1[name] = draws(crn.Stream(name), 10)
+		}
+
+		crn.Reset()
+		for k, name := range []string{"failures", "service", "arrivals"} {
+			got := draws(crn.Stream(name), 10)
+			want := scenario1[name]
+			if name == "arrivals" {
+				want = substream(r * 3)
+			}
+			for i := range want {
+				if want[i] != got[i] {
+					t.Fatalf("replication %d, %s (%d): Mismatch. want: %v, got: %v", r, name, k, want[i], got[i])
+				}
+			}
+		}
+
+		for k, name := range names {
+			if k == 0 {
+				continue
+			}
+			want := substream(r*3 + k)
+			for i := range want {
+				if want[i] != scenario1[name][i] {
+					t.Fatalf("replication %d, %s: Mismatch. want: %v, got: %v", r, name, want[i], scenario1[name][i])
+				}
+			}
+		}
+
+		crn.NextReplication()
+	}
+
+	if got := crn.Names(); len(got) != 3 || got[1] != "service" {
+		t.Errorf("Mismatch. want: %v, got: %v", names, got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("An unknown component should panic.")
+		}
+	}()
+	crn.Stream("repairs")
+}
@@ -0,0 +1,125 @@
+package grand
+
+import "math"
+
+// Signed is the set of signed integer types, as in golang.org/x/exp/constraints.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is the set of unsigned integer types, as in golang.org/x/exp/constraints.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is the set of integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is the set of floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// N returns, as a T, a pseudo-random number in [0,n).
+// It panics if n <= 0.
+//
+// It draws from Uint64n (a single Uint32() for n < 2^32), so that N(r, n) with an int n gives the
+// same values as r.Intn(n), and with an int64 n those of r.Int63n(n).
+func N[T Integer](r *Rand, n T) T {
+	if n <= 0 {
+		panic("invalid argument to N")
+	}
+
+	return T(r.Uint64n(uint64(n)))
+}
+
+// Uniform returns, as a T, a pseudo-random number in [lo,hi) (see Rand.Uniform).
+// A float32 is rounded from a float64 value, which is drawn again if it rounds to hi.
+// It panics if lo >= hi or if lo or hi is not finite.
+func Uniform[T Float](r *Rand, lo, hi T) T {
+	for {
+		if x := T(r.Uniform(float64(lo), float64(hi))); x < hi {
+			return x
+		}
+	}
+}
+
+// Choice returns a pseudo-random element of s.
+// It panics if s is empty.
+func Choice[T any](r *Rand, s []T) T {
+	if len(s) == 0 {
+		panic("invalid argument to Choice")
+	}
+
+	return s[r.Intn(len(s))]
+}
+
+// WeightedChoice returns a pseudo-random element of s, where s[i] is drawn with probability
+// weights[i]/sum(weights).
+// It panics if the lengths differ, if a weight is negative, NaN or infinite, or if all of them are 0.
+//
+// It takes O(len(s)) time: repeated draws from the same weights are better served by an alias table.
+func WeightedChoice[T any](r *Rand, s []T, weights []float64) T {
+	if len(s) != len(weights) {
+		panic("invalid argument to WeightedChoice")
+	}
+
+	var total float64
+	last := -1
+	for i, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			panic("invalid argument to WeightedChoice")
+		}
+		if w > 0 {
+			total += w
+			last = i
+		}
+	}
+	if last < 0 || math.IsInf(total, 1) {
+		panic("invalid argument to WeightedChoice")
+	}
+
+	// the rounding of the partial sums may leave u above all of them: it then falls to the last
+	// element of positive weight.
+	u := r.Float64() * total
+	for i, w := range weights[:last] {
+		if u < w {
+			return s[i]
+		}
+		u -= w
+	}
+
+	return s[last]
+}
+
+// Sample returns k distinct elements of s (distinct by position), drawn without replacement, in a
+// pseudo-random order. s is not modified.
+// It panics if k < 0 or k > len(s).
+//
+// It runs the first k steps of a Fisher-Yates shuffle on the indices, whose swaps are kept in a map,
+// so that it takes O(k) time and memory whatever the length of s.
+func Sample[T any](r *Rand, s []T, k int) []T {
+	if k < 0 || k > len(s) {
+		panic("invalid argument to Sample")
+	}
+
+	swapped := make(map[int]int, k)
+	at := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+		return i
+	}
+
+	dst := make([]T, k)
+	for i := range dst {
+		j := i + r.Intn(len(s)-i)
+		vi, vj := at(i), at(j)
+		swapped[j] = vi
+		dst[i] = s[vj]
+	}
+
+	return dst
+}
@@ -0,0 +1,139 @@
+package grand_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source64"
+)
+
+type weekday uint8
+
+func TestN(t *testing.T) {
+	for name, newRand := range sources() {
+		t.Run(name, func(t *testing.T) {
+			r := newRand()
+			grandtest.CheckUniform(t, func() uint64 { return uint64(grand.N(r, int8(100))) }, 100, 100, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(grand.N(r, weekday(7))) }, 7, 7, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(grand.N(r, uint64(3<<62))) }, 3<<62, 3, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(grand.N(r, int16(math.MaxInt16))) }, math.MaxInt16, 3, samples)
+
+			// the same values as Intn and Int63n.
+			a, b := newRand(), newRand()
+			for i := 0; i < 1000; i++ {
+				if x, y := grand.N(a, 1000), b.Intn(1000); x != y {
+					t.Fatalf("Mismatch. want: %v, got: %v", y, x)
+				}
+				if x, y := grand.N(a, int64(1)<<40), b.Int63n(1<<40); x != y {
+					t.Fatalf("Mismatch. want: %v, got: %v", y, x)
+				}
+			}
+		})
+	}
+}
+
+func TestGenericUniform(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	grandtest.CheckUniform(t, func() uint64 { return uint64((grand.Uniform(r, float32(-1.5), 1.5) + 1.5) * 1000) }, 3000, 30, samples)
+
+	type celsius float64
+	grandtest.CheckUniform(t, func() uint64 { return uint64(grand.Uniform(r, celsius(-40), 60) + 40) }, 100, 100, samples)
+
+	// half of the float64 values of [1, 1+ulp32) round up to 1+ulp32.
+	hi := math.Nextafter32(1, 2)
+	for i := 0; i < 1000; i++ {
+		if x := grand.Uniform(r, float32(1), hi); x != 1 {
+			t.Fatalf("Mismatch. want: %v, got: %v", 1, x)
+		}
+	}
+}
+
+func TestChoice(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	s := []string{"a", "b", "c", "d", "e"}
+	index := map[string]uint64{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
+	grandtest.CheckUniform(t, func() uint64 { return index[grand.Choice(r, s)] }, 5, 5, samples)
+
+	// weights 1, 0, 2, 0, 3 are checked as the uniform choice of 6 slots.
+	weights := []float64{1, 0, 2, 0, 3}
+	slots := map[string][]uint64{"a": {0}, "c": {1, 2}, "e": {3, 4, 5}}
+	grandtest.CheckUniform(t, func() uint64 {
+		v, ok := slots[grand.WeightedChoice(r, s, weights)]
+		if !ok {
+			t.Fatalf("an element of weight 0 was drawn")
+		}
+		return v[r.Intn(len(v))]
+	}, 6, 6, samples)
+
+	if got := grand.WeightedChoice(r, s, []float64{0, 0, 0, 1e-300, 0}); got != "d" {
+		t.Errorf("Mismatch. want: %v, got: %v", "d", got)
+	}
+}
+
+func TestSample(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	s := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	// all 10*9*8 ordered samples are equally likely.
+	grandtest.CheckUniform(t, func() uint64 {
+		v := grand.Sample(r, s, 3)
+		if v[0] == v[1] || v[0] == v[2] || v[1] == v[2] {
+			t.Fatalf("repeated elements: %v", v)
+		}
+		// the rank of v: each element is numbered among those not drawn before it.
+		b, c := v[1], v[2]
+		if b > v[0] {
+			b--
+		}
+		if v[2] > v[0] {
+			c--
+		}
+		if v[2] > v[1] {
+			c--
+		}
+		return uint64((v[0]*9+b)*8 + c)
+	}, 720, 720, samples)
+
+	for i, v := range s {
+		if v != i {
+			t.Fatalf("Sample should not modify s: %v", s)
+		}
+	}
+
+	// a full sample is a permutation.
+	seen := make(map[int]bool)
+	for _, v := range grand.Sample(r, s, len(s)) {
+		seen[v] = true
+	}
+	if len(seen) != len(s) || len(grand.Sample(r, s, 0)) != 0 {
+		t.Errorf("Mismatch. want: %v, got: %v", len(s), len(seen))
+	}
+}
+
+func TestGenericPanics(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	cases := map[string]func(){
+		"N":                       func() { grand.N(r, int8(-1)) },
+		"N(0)":                    func() { grand.N(r, uint(0)) },
+		"Uniform":                 func() { grand.Uniform(r, float32(1), 1) },
+		"Choice":                  func() { grand.Choice(r, []int{}) },
+		"WeightedChoice(lengths)": func() { grand.WeightedChoice(r, []int{1}, nil) },
+		"WeightedChoice(zero)":    func() { grand.WeightedChoice(r, []int{1, 2}, []float64{0, 0}) },
+		"WeightedChoice(NaN)":     func() { grand.WeightedChoice(r, []int{1, 2}, []float64{1, math.NaN()}) },
+		"WeightedChoice(-1)":      func() { grand.WeightedChoice(r, []int{1, 2}, []float64{2, -1}) },
+		"Sample":                  func() { grand.Sample(r, []int{1, 2}, 3) },
+	}
+
+	for name, f := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s should panic", name)
+				}
+			}()
+			f()
+		})
+	}
+}
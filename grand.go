@@ -13,6 +13,8 @@
 package grand
 
 import (
+	"math"
+	"math/bits"
 	"sync"
 )
 
@@ -58,7 +60,7 @@ func New(src Source) *Rand {
 func (r *Rand) Seed(seed int64) { r.src.Seed(seed) }
 
 // Int63 returns a non-negative pseudo-random 63-bit integer as an int64.
-func (r *Rand) Int63() int64 { return (int64(r.Uint32()) << 31) | int64(r.Uint32()) }
+func (r *Rand) Int63() int64 { return int64(r.Uint64() >> 1) }
 
 // Uint32 returns a pseudo-random 32-bit value as a uint32.
 func (r *Rand) Uint32() uint32 { return r.src.Uint32() }
@@ -69,7 +71,7 @@ func (r *Rand) Int31() int32 { return int32(r.Uint32() << 1 >> 1) }
 // Int returns a non-negative pseudo-random int.
 func (r *Rand) Int() int {
 	u := uint(r.Int63())
-	return int(u)
+	return int(u << 1 >> 1) // clear sign bit if int == int32
 }
 
 // Uint64 returns a pseudo-random 64-bit value as a uint64.
@@ -81,21 +83,64 @@ func (r *Rand) Uint64() uint64 {
 	return (uint64(r.Uint32()) << 32) | uint64(r.Uint32())
 }
 
+// Uint32n returns, as a uint32, a pseudo-random number in [0,n).
+// It panics if n == 0.
+//
+// It uses Lemire's nearly divisionless method: the 64-bit product of a 32-bit value and n is
+// rejected only if its low half falls below 2^32 mod n, which needs a division.
+//
+// Daniel Lemire, Fast Random Integer Generation in an Interval.
+// ACM Transactions on Modeling and Computer Simulation, 2019, 29(1), 3:1--3:12.
+func (r *Rand) Uint32n(n uint32) uint32 {
+	if n == 0 {
+		panic("invalid argument to Uint32n")
+	}
+
+	prod := uint64(r.Uint32()) * uint64(n)
+	low := uint32(prod)
+	if low < n {
+		thresh := -n % n
+		for low < thresh {
+			prod = uint64(r.Uint32()) * uint64(n)
+			low = uint32(prod)
+		}
+	}
+
+	return uint32(prod >> 32)
+}
+
+// Uint64n returns, as a uint64, a pseudo-random number in [0,n).
+// It panics if n == 0.
+//
+// It uses Lemire's method with the 128-bit product of a 64-bit value and n (see Uint32n);
+// n < 2^32 is handled by Uint32n, which draws a single 32-bit value.
+func (r *Rand) Uint64n(n uint64) uint64 {
+	if n == 0 {
+		panic("invalid argument to Uint64n")
+	}
+	if n <= math.MaxUint32 {
+		return uint64(r.Uint32n(uint32(n)))
+	}
+
+	hi, lo := bits.Mul64(r.Uint64(), n)
+	if lo < n {
+		thresh := -n % n
+		for lo < thresh {
+			hi, lo = bits.Mul64(r.Uint64(), n)
+		}
+	}
+
+	return hi
+}
+
 // Int63n returns, as an int64, a non-negative pseudo-random number in [0,n).
 // It panics if n <= 0.
 func (r *Rand) Int63n(n int64) int64 {
 	if n <= 0 {
 		panic("invalid argument to Int63n")
 	}
-	if n&(n-1) == 0 { // n is power of two, can mask
-		return r.Int63() & (n - 1)
-	}
-	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
-	v := r.Int63()
-	for v > max {
-		v = r.Int63()
-	}
-	return v % n
+
+	return int64(r.Uint64n(uint64(n)))
 }
 
 // Int31n returns, as an int32, a non-negative pseudo-random number in [0,n).
@@ -104,39 +149,8 @@ func (r *Rand) Int31n(n int32) int32 {
 	if n <= 0 {
 		panic("invalid argument to Int31n")
 	}
-	if n&(n-1) == 0 { // n is power of two, can mask
-		return r.Int31() & (n - 1)
-	}
-	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
-	v := r.Int31()
-	for v > max {
-		v = r.Int31()
-	}
-	return v % n
-}
 
-// int31n returns, as an int32, a non-negative pseudo-random number in [0,n).
-// n must be > 0, but int31n does not check this; the caller must ensure it.
-// int31n exists because Int31n is inefficient, but Go 1 compatibility
-// requires that the stream of values produced by math/rand remain unchanged.
-// int31n can thus only be used internally, by newly introduced APIs.
-//
-// For implementation details, see:
-// https://lemire.me/blog/2016/06/27/a-fast-alternative-to-the-modulo-reduction
-// https://lemire.me/blog/2016/06/30/fast-random-shuffling
-func (r *Rand) int31n(n int32) int32 {
-	v := r.Uint32()
-	prod := uint64(v) * uint64(n)
-	low := uint32(prod)
-	if low < uint32(n) {
-		thresh := uint32(-n) % uint32(n)
-		for low < thresh {
-			v = r.Uint32()
-			prod = uint64(v) * uint64(n)
-			low = uint32(prod)
-		}
-	}
-	return int32(prod >> 32)
+	return int32(r.Uint32n(uint32(n)))
 }
 
 // Intn returns, as an int, a non-negative pseudo-random number in [0,n).
@@ -145,10 +159,25 @@ func (r *Rand) Intn(n int) int {
 	if n <= 0 {
 		panic("invalid argument to Intn")
 	}
-	if n <= 1<<31-1 {
-		return int(r.Int31n(int32(n)))
+
+	return int(r.Uint64n(uint64(n)))
+}
+
+// IntRange returns, as an int, a pseudo-random number in [lo,hi], both ends included, so that
+// any range of ints can be drawn from.
+// It panics if lo > hi.
+func (r *Rand) IntRange(lo, hi int) int {
+	if lo > hi {
+		panic("invalid argument to IntRange")
+	}
+
+	// the width hi-lo is computed modulo 2^64, and so is the sum below.
+	span := uint64(hi) - uint64(lo)
+	if span == math.MaxUint64 {
+		return int(r.Uint64())
 	}
-	return int(r.Int63n(int64(n)))
+
+	return lo + int(r.Uint64n(span+1))
 }
 
 // Float64 returns, as a float64, a pseudo-random number in [0.0,1.0).
@@ -172,6 +201,120 @@ func (r *Rand) Float32() float32 {
 	return float32(r.Uint32()>>8) * float32_multiplier
 }
 
+// Float64Open returns, as a float64, a pseudo-random number in (0.0,1.0), e.g. for log(U).
+// The values are the odd multiples of 2^-53.
+func (r *Rand) Float64Open() float64 {
+	return (float64(r.Uint64()>>12) + 0.5) * float64_multiplier * 2
+}
+
+// Float64OpenClosed returns, as a float64, a pseudo-random number in (0.0,1.0].
+func (r *Rand) Float64OpenClosed() float64 {
+	return float64(r.Uint64()>>11+1) * float64_multiplier
+}
+
+// Float64Closed returns, as a float64, a pseudo-random number in [0.0,1.0]: the 2^53+1 multiples of 2^-53
+// are equally likely.
+func (r *Rand) Float64Closed() float64 {
+	return float64(r.Uint64n(1<<53+1)) * float64_multiplier
+}
+
+// Float64Full returns, as a float64, a pseudo-random number in [0.0,1.0) with full precision: every float64
+// x of [0.0,1.0) can be returned, with the probability ulp(x) that a real uniform value rounds down to x.
+// The exponent is drawn from the count of trailing zero bits (halving the interval on each 0 bit), the 52
+// bits of the mantissa are uniform. It draws a single Uint64() in all but 1 in 4096 cases.
+func (r *Rand) Float64Full() float64 {
+	u := r.Uint64()
+	mant := u >> 12
+	// the biased exponent of [0.5,1.0).
+	exp := 1022
+	if z := u & 0xfff; z != 0 {
+		exp -= bits.TrailingZeros64(z)
+	} else {
+		exp -= 12
+		for exp > 0 {
+			u = r.Uint64()
+			if u != 0 {
+				exp -= bits.TrailingZeros64(u)
+				break
+			}
+			exp -= 64
+		}
+		// [0.0,2^-1022) holds the subnormal numbers, all 2^-1074 apart.
+		if exp < 0 {
+			exp = 0
+		}
+	}
+
+	return math.Float64frombits(uint64(exp)<<52 | mant)
+}
+
+// Float32Open returns, as a float32, a pseudo-random number in (0.0,1.0).
+// The values are the odd multiples of 2^-24.
+func (r *Rand) Float32Open() float32 {
+	return (float32(r.Uint32()>>9) + 0.5) * float32_multiplier * 2
+}
+
+// Float32OpenClosed returns, as a float32, a pseudo-random number in (0.0,1.0].
+func (r *Rand) Float32OpenClosed() float32 {
+	return float32(r.Uint32()>>8+1) * float32_multiplier
+}
+
+// Float32Closed returns, as a float32, a pseudo-random number in [0.0,1.0]: the 2^24+1 multiples of 2^-24
+// are equally likely.
+func (r *Rand) Float32Closed() float32 {
+	return float32(r.Uint32n(1<<24+1)) * float32_multiplier
+}
+
+// Float32Full returns, as a float32, a pseudo-random number in [0.0,1.0) with full precision (see Float64Full).
+func (r *Rand) Float32Full() float32 {
+	u := r.Uint32()
+	mant := u >> 9
+	// the biased exponent of [0.5,1.0).
+	exp := 126
+	if z := u & 0x1ff; z != 0 {
+		exp -= bits.TrailingZeros32(z)
+	} else {
+		exp -= 9
+		for exp > 0 {
+			u = r.Uint32()
+			if u != 0 {
+				exp -= bits.TrailingZeros32(u)
+				break
+			}
+			exp -= 32
+		}
+		// [0.0,2^-126) holds the subnormal numbers, all 2^-149 apart.
+		if exp < 0 {
+			exp = 0
+		}
+	}
+
+	return math.Float32frombits(uint32(exp)<<23 | mant)
+}
+
+// Uniform returns, as a float64, a pseudo-random number in [a,b).
+// The values a + (b-a)*U that round up to b are drawn again, and a range wider than math.MaxFloat64
+// is scaled by 1/2 so that b-a does not overflow.
+// It panics if a >= b or if a or b is not finite.
+func (r *Rand) Uniform(a, b float64) float64 {
+	if !(a < b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		panic("invalid argument to Uniform")
+	}
+
+	d := b - a
+	for {
+		var x float64
+		if math.IsInf(d, 1) {
+			x = 2 * (0.5*a + float64((0.5*b-0.5*a)*r.Float64()))
+		} else {
+			x = a + float64(d*r.Float64())
+		}
+		if x < b {
+			return x
+		}
+	}
+}
+
 // Returns the next bool.
 func (r *Rand) Bool() bool {
 	if r.s64 != nil {
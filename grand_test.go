@@ -0,0 +1,230 @@
+package grand_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+	"github.com/jtejido/grand/source64"
+)
+
+const samples = 200000
+
+// 32-bit sources build the 64-bit values from two words, 64-bit sources use Uint64().
+func sources() map[string]func() *grand.Rand {
+	return map[string]func() *grand.Rand{
+		"32-bit": func() *grand.Rand { return grand.New(source32.NewXoShiRo128StarStar(1)) },
+		"64-bit": func() *grand.Rand { return grand.New(source64.NewSplitMix64(1)) },
+	}
+}
+
+func TestBits(t *testing.T) {
+	for name, newRand := range sources() {
+		t.Run(name, func(t *testing.T) {
+			r := newRand()
+			grandtest.CheckBits(t, func() uint64 { return uint64(r.Int63()) }, 63, samples)
+			grandtest.CheckBits(t, func() uint64 { return uint64(r.Int31()) }, 31, samples)
+			grandtest.CheckBits(t, func() uint64 { return r.Uint64() }, 64, samples)
+		})
+	}
+}
+
+func TestBounded(t *testing.T) {
+	for name, newRand := range sources() {
+		t.Run(name, func(t *testing.T) {
+			r := newRand()
+			// 2^32 mod n and 2^64 mod n are n/3: a modulo reduction would draw the low third twice as often.
+			for _, n := range []uint32{3 << 30, 1000, 7, 1} {
+				n := n
+				grandtest.CheckUniform(t, func() uint64 { return uint64(r.Uint32n(n)) }, uint64(n), 3, samples)
+				grandtest.CheckUniform(t, func() uint64 { return uint64(r.Int31n(int32(n>>1 | 1))) }, uint64(n>>1|1), 3, samples)
+			}
+			for _, n := range []uint64{3 << 62, 3 << 30, 1 << 40, 12345678901} {
+				n := n
+				grandtest.CheckUniform(t, func() uint64 { return r.Uint64n(n) }, n, 3, samples)
+				grandtest.CheckUniform(t, func() uint64 { return uint64(r.Int63n(int64(n >> 1))) }, n>>1, 3, samples)
+			}
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Intn(1000)) }, 1000, 1000, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Intn(math.MaxInt32 / 3 * 2)) }, math.MaxInt32/3*2, 3, samples)
+		})
+	}
+}
+
+func TestIntRange(t *testing.T) {
+	for name, newRand := range sources() {
+		t.Run(name, func(t *testing.T) {
+			r := newRand()
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.IntRange(-5, 5) + 5) }, 11, 11, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.IntRange(-1<<29, 1<<30) + 1<<29) }, 3<<29+1, 3, samples)
+
+			if got := r.IntRange(42, 42); got != 42 {
+				t.Errorf("Mismatch. want: %v, got: %v", 42, got)
+			}
+
+			// the full range of int.
+			negative := 0
+			for i := 0; i < samples; i++ {
+				if r.IntRange(math.MinInt, math.MaxInt) < 0 {
+					negative++
+				}
+			}
+			if z := math.Abs(float64(negative)-samples/2) / (math.Sqrt(samples) / 2); z > 5 {
+				t.Errorf("biased sign. frequency: %.4f", float64(negative)/samples)
+			}
+		})
+	}
+}
+
+func TestBoundedPanics(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	cases := map[string]func(){
+		"Uint32n":  func() { r.Uint32n(0) },
+		"Uint64n":  func() { r.Uint64n(0) },
+		"Int31n":   func() { r.Int31n(-1) },
+		"Int63n":   func() { r.Int63n(0) },
+		"Intn":     func() { r.Intn(0) },
+		"IntRange": func() { r.IntRange(1, 0) },
+	}
+
+	for name, f := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s should panic", name)
+				}
+			}()
+			f()
+		})
+	}
+}
+
+// The harness must detect the biases it is meant for.
+func TestHarness(t *testing.T) {
+	src := source32.NewXoShiRo128StarStar(1)
+
+	modulo := func() uint64 { return uint64(src.Uint32() % (3 << 30)) }
+	if stat, crit := grandtest.ChiSquareUniform(modulo, 3<<30, 3, samples); stat <= crit {
+		t.Errorf("a modulo reduction should be detected. chi-square: %.2f, critical value: %.2f", stat, crit)
+	}
+
+	unbiased := func() uint64 { return uint64(src.Uint32()) }
+	if stat, crit := grandtest.ChiSquareUniform(unbiased, 1<<32, 100, samples); stat > crit {
+		t.Errorf("Uint32() should not be biased. chi-square: %.2f, critical value: %.2f", stat, crit)
+	}
+}
+
+// constSource returns the same value forever, to check the ends of the intervals.
+type constSource uint64
+
+func (c constSource) Uint32() uint32 { return uint32(c) }
+func (c constSource) Uint64() uint64 { return uint64(c) }
+func (c constSource) Bool() bool     { return c&1 != 0 }
+func (c constSource) Seed(int64)     {}
+func (c constSource) Restart()       {}
+
+func TestFloatBounds(t *testing.T) {
+	zero, ones := grand.New(constSource(0)), grand.New(constSource(math.MaxUint64))
+	cases := []struct {
+		name      string
+		got, want float64
+	}{
+		{"Float64Open(0)", zero.Float64Open(), 0x1p-53},
+		{"Float64Open(1)", ones.Float64Open(), 1 - 0x1p-53},
+		{"Float64OpenClosed(0)", zero.Float64OpenClosed(), 0x1p-53},
+		{"Float64OpenClosed(1)", ones.Float64OpenClosed(), 1},
+		{"Float64Full(0)", zero.Float64Full(), 0},
+		{"Float64Full(1)", ones.Float64Full(), 1 - 0x1p-53},
+		{"Float32Open(0)", float64(zero.Float32Open()), 0x1p-24},
+		{"Float32Open(1)", float64(ones.Float32Open()), 1 - 0x1p-24},
+		{"Float32OpenClosed(0)", float64(zero.Float32OpenClosed()), 0x1p-24},
+		{"Float32OpenClosed(1)", float64(ones.Float32OpenClosed()), 1},
+		{"Float32Full(0)", float64(zero.Float32Full()), 0},
+		{"Float32Full(1)", float64(ones.Float32Full()), 1 - 0x1p-24},
+	}
+
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("%s: Mismatch. want: %v, got: %v", c.name, c.want, c.got)
+		}
+	}
+}
+
+func TestFloat(t *testing.T) {
+	for name, newRand := range sources() {
+		t.Run(name, func(t *testing.T) {
+			r := newRand()
+			// the values are checked as the integers x*2^k, or as their buckets of width 1/1000.
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Float64Open()*0x1p53-1) / 2 }, 1<<52, 1000, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Float64OpenClosed()*0x1p53) - 1 }, 1<<53, 1000, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Float64Closed() * 0x1p53) }, 1<<53+1, 1000, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Float64Full() * 1000) }, 1000, 1000, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Float32Open()*0x1p24-1) / 2 }, 1<<23, 1000, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Float32OpenClosed()*0x1p24) - 1 }, 1<<24, 1000, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(r.Float32Closed() * 0x1p24) }, 1<<24+1, 1000, samples)
+			grandtest.CheckUniform(t, func() uint64 { return uint64(float64(r.Float32Full()) * 1000) }, 1000, 1000, samples)
+		})
+	}
+
+	t.Run("Full", func(t *testing.T) {
+		// below 2^-8, the values are finer than the multiples of 2^-53 (or 2^-24) of Float64 (Float32).
+		r := grand.New(source64.NewSplitMix64(1))
+		small, fine, small32, fine32 := 0, 0, 0, 0
+		for i := 0; i < samples; i++ {
+			if x := r.Float64Full(); x < 0x1p-8 {
+				small++
+				if x*0x1p53 != math.Trunc(x*0x1p53) {
+					fine++
+				}
+			}
+			if x := float64(r.Float32Full()); x < 0x1p-8 {
+				small32++
+				if x*0x1p24 != math.Trunc(x*0x1p24) {
+					fine32++
+				}
+			}
+		}
+		if fine < small/2 || fine32 < small32/2 {
+			t.Errorf("Float64Full: %d of %d small values below 2^-53 precision, Float32Full: %d of %d", fine, small, fine32, small32)
+		}
+	})
+}
+
+func TestUniform(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	grandtest.CheckUniform(t, func() uint64 { return uint64(r.Uniform(-1.5, 1.5)*1000 + 1500) }, 3000, 30, samples)
+
+	// the only float64 of [1, 1+ulp) is 1: a + (b-a)*U rounds to b for U >= 1/2.
+	for i := 0; i < 1000; i++ {
+		if x := r.Uniform(1, math.Nextafter(1, 2)); x != 1 {
+			t.Fatalf("Mismatch. want: %v, got: %v", 1, x)
+		}
+	}
+
+	// b-a overflows.
+	negative := 0
+	for i := 0; i < samples; i++ {
+		x := r.Uniform(-math.MaxFloat64, math.MaxFloat64)
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			t.Fatalf("Uniform should be finite, got: %v", x)
+		}
+		if x < 0 {
+			negative++
+		}
+	}
+	if z := math.Abs(float64(negative)-samples/2) / (math.Sqrt(samples) / 2); z > 5 {
+		t.Errorf("biased sign. frequency: %.4f", float64(negative)/samples)
+	}
+
+	for _, c := range [][2]float64{{1, 1}, {2, 1}, {math.NaN(), 1}, {0, math.Inf(1)}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Uniform(%v, %v) should panic", c[0], c[1])
+				}
+			}()
+			r.Uniform(c[0], c[1])
+		}()
+	}
+}
@@ -0,0 +1,99 @@
+package grandtest
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+)
+
+const (
+	// The standard normal quantile of the checks' significance level, 1e-7: as the sources are seeded,
+	// a check either always passes or always fails, and a false alarm would be a permanent one.
+	biasQuantile = 5.199
+)
+
+// ChiSquareUniform draws samples values of next, which must lie in [0, n), and counts them in buckets
+// intervals of (nearly) equal width. It returns Pearson's chi-square statistic of the counts against
+// their exact expectations under the uniform distribution on [0, n), and its critical value at level 1e-7.
+// The integers are split as v -> floor(v*buckets/n), so that a bias between the low and the high part of
+// the range (that of a modulo reduction) shows up with a few buckets.
+func ChiSquareUniform(next func() uint64, n uint64, buckets, samples int) (stat, crit float64) {
+	counts := make([]int, buckets)
+	for i := 0; i < samples; i++ {
+		v := next()
+		if v >= n {
+			return math.Inf(1), 0
+		}
+		hi, lo := bits.Mul64(v, uint64(buckets))
+		b, _ := bits.Div64(hi, lo, n)
+		counts[b]++
+	}
+
+	// bucket i holds the integers of [ceil(i*n/buckets), ceil((i+1)*n/buckets)).
+	start := func(i int) uint64 {
+		hi, lo := bits.Mul64(uint64(i), n)
+		q, r := bits.Div64(hi, lo, uint64(buckets))
+		if r != 0 {
+			q++
+		}
+		return q
+	}
+
+	// buckets are empty if n < buckets.
+	df := -1.0
+	for i, c := range counts {
+		width := start(i+1) - start(i)
+		if width == 0 {
+			continue
+		}
+
+		want := float64(samples) * (float64(width) / float64(n))
+		d := float64(c) - want
+		stat += d * d / want
+		df++
+	}
+	if df == 0 {
+		return
+	}
+
+	// Wilson-Hilferty approximation of the quantile of the chi-square distribution.
+	h := 2 / (9 * df)
+	crit = df * math.Pow(1-h+biasQuantile*math.Sqrt(h), 3)
+	return
+}
+
+// CheckUniform fails the test if the values of next are not uniform on [0, n) (see ChiSquareUniform).
+func CheckUniform(t *testing.T, next func() uint64, n uint64, buckets, samples int) {
+	t.Helper()
+
+	stat, crit := ChiSquareUniform(next, n, buckets, samples)
+	if stat > crit {
+		t.Errorf("biased values in [0, %d). chi-square: %.2f, critical value: %.2f", n, stat, crit)
+	}
+}
+
+// CheckBits fails the test if any of the low width bits of the values of next is not set
+// with probability 1/2 (bits stuck at 0 or 1, or overlapping words).
+func CheckBits(t *testing.T, next func() uint64, width, samples int) {
+	t.Helper()
+
+	counts := make([]int, width)
+	for i := 0; i < samples; i++ {
+		v := next()
+		if v>>uint(width) != 0 {
+			t.Errorf("value %#x has more than %d bits", v, width)
+			return
+		}
+		for j := range counts {
+			counts[j] += int(v >> uint(j) & 1)
+		}
+	}
+
+	// the count of each bit is binomial(samples, 1/2).
+	sd := math.Sqrt(float64(samples)) / 2
+	for j, c := range counts {
+		if z := math.Abs(float64(c)-float64(samples)/2) / sd; z > biasQuantile {
+			t.Errorf("biased bit %d. frequency: %.4f, z-score: %.2f", j, float64(c)/float64(samples), z)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Package grandtest implements a conformance kit for grand.Source implementations.
+//
+// RunSourceTests checks the contracts shared by every source (restartable streams,
+// deterministic seeding and the cached Bool()/Uint32() values), plus the substream
+// contracts if the source is a grand.JumpableSource.
+//
+// CheckVectors compares a source against a reference-vector file, i.e. the output of
+// the original (mostly C) implementation written down in testdata.
+//
+// CheckUniform and CheckBits are statistical checks for the bias of the values derived from a
+// source, e.g. the bounded integers of grand.Rand.
+package grandtest
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jtejido/grand"
+)
+
+const (
+	// The number of values compared in each check.
+	streamLength = 1000
+	// The seed used for the seeding checks.
+	seedValue int64 = 0x5eed
+)
+
+// RunSourceTests runs the conformance checks against sources created by factory.
+// factory must return a new, independent source on every call, all starting at the same stream.
+func RunSourceTests(t *testing.T, factory func() grand.Source) {
+	t.Helper()
+
+	t.Run("Restart", func(t *testing.T) { testRestart(t, factory) })
+	t.Run("Seed", func(t *testing.T) { testSeed(t, factory) })
+	t.Run("BoolCache", func(t *testing.T) { testBoolCache(t, factory) })
+
+	if _, ok := factory().(grand.Source64); ok {
+		t.Run("Uint32Cache", func(t *testing.T) { testUint32Cache(t, factory) })
+	}
+
+	if _, ok := factory().(grand.JumpableSource); ok {
+		jumpable := func() grand.JumpableSource { return factory().(grand.JumpableSource) }
+		t.Run("RestartSubstream", func(t *testing.T) { testRestartSubstream(t, jumpable) })
+		t.Run("Jump", func(t *testing.T) { testJump(t, jumpable) })
+	}
+}
+
+// CheckVectors compares the output of src against the reference-vector file at path.
+//
+// The file holds one value per line, either in decimal or in hexadecimal (0x prefixed).
+// Empty lines and lines starting with '#' are ignored. The first value line must be
+// either "uint32" or "uint64", which selects the method the values are compared against.
+func CheckVectors(t *testing.T, src grand.Source, path string) {
+	t.Helper()
+
+	method, expected, err := ReadVectors(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var next func() uint64
+	switch method {
+	case "uint32":
+		next = func() uint64 { return uint64(src.Uint32()) }
+	case "uint64":
+		s64, ok := src.(grand.Source64)
+		if !ok {
+			t.Fatalf("%s: %T does not implement grand.Source64", path, src)
+		}
+		next = s64.Uint64
+	}
+
+	for i, want := range expected {
+		if got := next(); got != want {
+			t.Fatalf("%s: mismatch at index %d. want: %#x, got: %#x", path, i, want, got)
+		}
+	}
+}
+
+// ReadVectors parses the reference-vector file at path (see CheckVectors).
+// It returns the method ("uint32" or "uint64") and the expected values.
+func ReadVectors(path string) (method string, values []uint64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if method == "" {
+			if line != "uint32" && line != "uint64" {
+				return "", nil, fmt.Errorf("%s:%d: expected uint32 or uint64, got %q", path, n, line)
+			}
+			method = line
+			continue
+		}
+
+		bitSize := 64
+		if method == "uint32" {
+			bitSize = 32
+		}
+
+		v, perr := strconv.ParseUint(line, 0, bitSize)
+		if perr != nil {
+			return "", nil, fmt.Errorf("%s:%d: %v", path, n, perr)
+		}
+		values = append(values, v)
+	}
+
+	if err = sc.Err(); err != nil {
+		return
+	}
+
+	if method == "" {
+		err = fmt.Errorf("%s: no values found", path)
+	}
+
+	return
+}
+
+func draw(src grand.Source, n int) []uint32 {
+	ans := make([]uint32, n)
+	for i := range ans {
+		ans[i] = src.Uint32()
+	}
+
+	return ans
+}
+
+func drawBool(src grand.Source, n int) []bool {
+	ans := make([]bool, n)
+	for i := range ans {
+		ans[i] = src.Bool()
+	}
+
+	return ans
+}
+
+func compare(t *testing.T, what string, want, got []uint32) {
+	t.Helper()
+
+	for i := range want {
+		if want[i] != got[i] {
+			t.Fatalf("%s: mismatch at index %d. want: %#x, got: %#x", what, i, want[i], got[i])
+		}
+	}
+}
+
+func compareBool(t *testing.T, what string, want, got []bool) {
+	t.Helper()
+
+	for i := range want {
+		if want[i] != got[i] {
+			t.Fatalf("%s: mismatch at index %d. want: %v, got: %v", what, i, want[i], got[i])
+		}
+	}
+}
+
+func same(a, b []uint32) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Restart() must reproduce the stream from its initial state.
+func testRestart(t *testing.T, factory func() grand.Source) {
+	src := factory()
+	want := draw(src, streamLength)
+	src.Restart()
+	compare(t, "restarted stream", want, draw(src, streamLength))
+	compare(t, "new source", want, draw(factory(), streamLength))
+}
+
+// Seed() must be deterministic and must define the starting point for Restart().
+func testSeed(t *testing.T, factory func() grand.Source) {
+	src := factory()
+	src.Seed(seedValue)
+	want := draw(src, streamLength)
+
+	other := factory()
+	draw(other, streamLength)
+	other.Seed(seedValue)
+	compare(t, "seeded stream", want, draw(other, streamLength))
+
+	other.Restart()
+	compare(t, "restarted seeded stream", want, draw(other, streamLength))
+}
+
+// Restart() must discard the bits cached by Bool().
+func testBoolCache(t *testing.T, factory func() grand.Source) {
+	want := drawBool(factory(), streamLength)
+
+	src := factory()
+	src.Bool()
+	src.Restart()
+	compareBool(t, "restarted Bool()", want, drawBool(src, streamLength))
+}
+
+// Restart() must discard the half of a uint64 cached by Uint32().
+func testUint32Cache(t *testing.T, factory func() grand.Source) {
+	want := draw(factory(), streamLength)
+
+	src := factory()
+	src.Uint32()
+	src.Restart()
+	compare(t, "restarted Uint32()", want, draw(src, streamLength))
+}
+
+// RestartSubstream() must go back to the beginning of the current substream,
+// which is the beginning of the stream until Jump() is called.
+func testRestartSubstream(t *testing.T, factory func() grand.JumpableSource) {
+	src := factory()
+	want := draw(src, streamLength)
+	src.RestartSubstream()
+	compare(t, "first substream", want, draw(src, streamLength))
+
+	src.Jump()
+	want = draw(src, streamLength)
+	src.Bool()
+	src.RestartSubstream()
+	compare(t, "second substream", want, draw(src, streamLength))
+}
+
+// Jump() must move to the beginning of the next substream regardless of how much of
+// the current one has been consumed, and Restart() must go back to the first substream.
+func testJump(t *testing.T, factory func() grand.JumpableSource) {
+	first := draw(factory(), streamLength)
+
+	src := factory()
+	src.Jump()
+	second := draw(src, streamLength)
+	if same(first, second) {
+		t.Fatal("Jump() did not move the stream")
+	}
+
+	src.Jump()
+	third := draw(src, streamLength)
+	if same(second, third) {
+		t.Fatal("second Jump() did not move the stream")
+	}
+
+	other := factory()
+	draw(other, streamLength)
+	other.Jump()
+	compare(t, "Jump() after consuming the first substream", second, draw(other, streamLength))
+
+	other.Jump()
+	other.RestartSubstream()
+	compare(t, "RestartSubstream() after the second Jump()", third, draw(other, streamLength))
+
+	other.Restart()
+	compare(t, "Restart() after Jump()", first, draw(other, streamLength))
+}
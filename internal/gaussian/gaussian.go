@@ -0,0 +1,30 @@
+// Package gaussian draws the standard normal values shared by the samplers of the multivariate and
+// process packages.
+package gaussian
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// Fill fills dst with standard normal values, with Marsaglia's polar method (both values of a pair are used).
+func Fill(r *grand.Rand, dst []float64) {
+	for i := 0; i < len(dst); i += 2 {
+		var x, y, s float64
+		for {
+			x = 2*r.Float64() - 1
+			y = 2*r.Float64() - 1
+			s = x*x + y*y
+			if s < 1 && s != 0 {
+				break
+			}
+		}
+
+		f := math.Sqrt(-2 * math.Log(s) / s)
+		dst[i] = f * x
+		if i+1 < len(dst) {
+			dst[i+1] = f * y
+		}
+	}
+}
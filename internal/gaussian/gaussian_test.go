@@ -0,0 +1,44 @@
+package gaussian_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/internal/gaussian"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestFill(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+
+	// the moments of N(0, 1), over buffers of odd and even lengths.
+	const n = 100000
+	var sum, sumSq, sum4 float64
+	count := 0
+	for count < n {
+		dst := make([]float64, 1+count%4)
+		gaussian.Fill(r, dst)
+		for _, x := range dst {
+			sum += x
+			sumSq += x * x
+			sum4 += x * x * x * x
+			count++
+		}
+	}
+
+	cases := []struct {
+		name      string
+		got, want float64
+		se        float64
+	}{
+		{"mean", sum / float64(count), 0, math.Sqrt(1 / float64(count))},
+		{"variance", sumSq / float64(count), 1, math.Sqrt(2 / float64(count))},
+		{"fourth moment", sum4 / float64(count), 3, math.Sqrt(96 / float64(count))},
+	}
+	for _, c := range cases {
+		if math.Abs(c.got-c.want) > 5*c.se {
+			t.Errorf("%s: Mismatch. want: %v, got: %v", c.name, c.want, c.got)
+		}
+	}
+}
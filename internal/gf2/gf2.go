@@ -0,0 +1,309 @@
+// Package gf2 implements polynomials and linear algebra over GF(2), for the parameter searches
+// of the F2-linear sources (e.g. finding characteristic polynomials and equidistribution dimensions).
+package gf2
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Poly is a polynomial over GF(2), where bit i of the words (the least significant word first)
+// is the coefficient of x^i.
+type Poly []uint64
+
+// Monomial returns x^n.
+func Monomial(n int) Poly {
+	ans := make(Poly, n/64+1)
+	ans[n/64] = 1 << uint(n%64)
+	return ans
+}
+
+// Degree returns the degree of p, or -1 if p is 0.
+func (p Poly) Degree() int {
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i] != 0 {
+			return 64*i + bits.Len64(p[i]) - 1
+		}
+	}
+
+	return -1
+}
+
+// Coeff returns the coefficient of x^i.
+func (p Poly) Coeff(i int) uint64 {
+	if i < 0 || i/64 >= len(p) {
+		return 0
+	}
+
+	return p[i/64] >> uint(i%64) & 1
+}
+
+// Equal reports whether p and q are the same polynomial.
+func (p Poly) Equal(q Poly) bool {
+	if len(p) < len(q) {
+		p, q = q, p
+	}
+
+	for i := range p {
+		var w uint64
+		if i < len(q) {
+			w = q[i]
+		}
+		if p[i] != w {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the coefficients of p in hexadecimal, the highest degree first.
+func (p Poly) String() string {
+	d := p.Degree()
+	if d < 0 {
+		return "0"
+	}
+
+	var sb strings.Builder
+	n := d / 64
+	fmt.Fprintf(&sb, "%x", p[n])
+	for i := n - 1; i >= 0; i-- {
+		fmt.Fprintf(&sb, "%016x", p[i])
+	}
+
+	return sb.String()
+}
+
+func (p Poly) clone(n int) Poly {
+	if n < len(p) {
+		n = len(p)
+	}
+
+	ans := make(Poly, n)
+	copy(ans, p)
+	return ans
+}
+
+// xors q*x^s into p, which must be long enough.
+func (p Poly) addShifted(q Poly, s int) {
+	w, b := s/64, uint(s%64)
+	for i := len(q) - 1; i >= 0; i-- {
+		if q[i] == 0 {
+			continue
+		}
+		p[i+w] ^= q[i] << b
+		if b != 0 && i+w+1 < len(p) {
+			p[i+w+1] ^= q[i] >> (64 - b)
+		}
+	}
+}
+
+// Add returns p+q.
+func Add(p, q Poly) Poly {
+	ans := p.clone(len(q))
+	for i := range q {
+		ans[i] ^= q[i]
+	}
+
+	return ans
+}
+
+// Mul returns p*q.
+func Mul(p, q Poly) Poly {
+	ans := make(Poly, len(p)+len(q))
+	for i := 0; i <= p.Degree(); i++ {
+		if p.Coeff(i) != 0 {
+			ans.addShifted(q, i)
+		}
+	}
+
+	return ans
+}
+
+// Mod returns p mod m, m must not be 0.
+func Mod(p, m Poly) Poly {
+	dm := m.Degree()
+	if dm < 0 {
+		panic("gf2: division by zero")
+	}
+
+	ans := p.clone(dm/64 + 1)
+	for d := ans.Degree(); d >= dm; d = ans.Degree() {
+		ans.addShifted(m, d-dm)
+	}
+
+	return ans[:dm/64+1]
+}
+
+// MulMod returns p*q mod m.
+func MulMod(p, q, m Poly) Poly {
+	return Mod(Mul(p, q), m)
+}
+
+// PowMod returns p^e mod m.
+func PowMod(p Poly, e uint64, m Poly) Poly {
+	ans := Mod(Monomial(0), m)
+	p = Mod(p, m)
+	for e != 0 {
+		if e&1 == 1 {
+			ans = MulMod(ans, p, m)
+		}
+		p = MulMod(p, p, m)
+		e >>= 1
+	}
+
+	return ans
+}
+
+// GCD returns the greatest common divisor of p and q.
+func GCD(p, q Poly) Poly {
+	for q.Degree() >= 0 {
+		p, q = q, Mod(p, q)
+	}
+
+	return p
+}
+
+// Returns x^(2^k) mod m.
+func frobenius(k int, m Poly) Poly {
+	ans := Mod(Monomial(1), m)
+	for i := 0; i < k; i++ {
+		ans = MulMod(ans, ans, m)
+	}
+
+	return ans
+}
+
+// IsIrreducible reports whether f is irreducible, using Rabin's test: f of degree n is irreducible
+// iff x^(2^n) = x mod f, and gcd(x^(2^(n/q)) - x, f) = 1 for each prime factor q of n.
+//
+// If n is a Mersenne exponent (i.e. 2^n-1 is prime), an irreducible f is also primitive.
+func IsIrreducible(f Poly) bool {
+	n := f.Degree()
+	if n < 1 {
+		return false
+	}
+
+	x := Mod(Monomial(1), f)
+	if !frobenius(n, f).Equal(x) {
+		return false
+	}
+
+	for _, q := range primeFactors(n) {
+		g := GCD(f, Add(frobenius(n/q, f), x))
+		if g.Degree() != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func primeFactors(n int) (ans []int) {
+	for q := 2; q*q <= n; q++ {
+		if n%q == 0 {
+			ans = append(ans, q)
+			for n%q == 0 {
+				n /= q
+			}
+		}
+	}
+	if n > 1 {
+		ans = append(ans, n)
+	}
+
+	return
+}
+
+// MinimalPolynomial returns the minimal polynomial of the linearly recurrent sequence of bits s,
+// using the Berlekamp-Massey algorithm. The result is exact if len(s) is at least twice its degree.
+func MinimalPolynomial(s []uint8) Poly {
+	n := len(s)
+	// c is the connection polynomial, b is its last version before the length changed.
+	c, b := make(Poly, n/64+1), make(Poly, n/64+1)
+	c[0], b[0] = 1, 1
+	l, m := 0, 1
+	for i := 0; i < n; i++ {
+		d := uint64(s[i] & 1)
+		for j := 1; j <= l; j++ {
+			d ^= c.Coeff(j) & uint64(s[i-j])
+		}
+
+		if d == 0 {
+			m++
+			continue
+		}
+
+		if 2*l <= i {
+			t := c.clone(0)
+			c.addShifted(b, m)
+			l, b, m = i+1-l, t, 1
+		} else {
+			c.addShifted(b, m)
+			m++
+		}
+	}
+
+	// The minimal polynomial is the reciprocal of the connection polynomial, at degree l.
+	ans := make(Poly, l/64+1)
+	for j := 0; j <= l; j++ {
+		if c.Coeff(j) != 0 {
+			k := l - j
+			ans[k/64] |= 1 << uint(k%64)
+		}
+	}
+
+	return ans
+}
+
+// Basis is a set of linearly independent bit vectors of the same length, kept in echelon form.
+type Basis struct {
+	words int
+	// the words of the vector whose highest set bit is i start at pivots[i*words], if has[i].
+	pivots []uint64
+	has    []bool
+	v      []uint64
+	rank   int
+}
+
+// NewBasis returns an empty basis for vectors of n bits.
+func NewBasis(n int) *Basis {
+	words := (n + 63) / 64
+	return &Basis{
+		words:  words,
+		pivots: make([]uint64, n*words),
+		has:    make([]bool, n),
+		v:      make([]uint64, words),
+	}
+}
+
+// Add adds v to the basis if it is linearly independent of the vectors already there, and reports
+// whether it was.
+func (b *Basis) Add(v []uint64) bool {
+	x := b.v
+	copy(x, v)
+	for i := b.words - 1; i >= 0; i-- {
+		for x[i] != 0 {
+			top := 64*i + bits.Len64(x[i]) - 1
+			p := b.pivots[top*b.words : (top+1)*b.words]
+			if !b.has[top] {
+				copy(p, x)
+				b.has[top] = true
+				b.rank++
+				return true
+			}
+
+			for j := range p {
+				x[j] ^= p[j]
+			}
+		}
+	}
+
+	return false
+}
+
+// Rank returns the number of vectors of the basis.
+func (b *Basis) Rank() int {
+	return b.rank
+}
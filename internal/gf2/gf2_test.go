@@ -0,0 +1,120 @@
+package gf2_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/internal/gf2"
+)
+
+// builds a polynomial from the exponents of its terms
+func poly(exps ...int) gf2.Poly {
+	ans := gf2.Poly{}
+	for _, e := range exps {
+		ans = gf2.Add(ans, gf2.Monomial(e))
+	}
+
+	return ans
+}
+
+func TestIsIrreducible(t *testing.T) {
+	tests := []struct {
+		p    gf2.Poly
+		want bool
+	}{
+		{poly(1), true},
+		{poly(1, 0), true},
+		{poly(2, 1, 0), true},
+		{poly(2, 0), false},
+		{poly(4, 1, 0), true},
+		{poly(4, 2, 0), false},
+		{poly(4, 3, 2, 1, 0), true},
+		{poly(6, 1, 0), true},
+		{poly(6, 3, 0), true},
+		{poly(6, 4, 3, 1, 0), true},
+		{poly(6, 5, 4, 1, 0), true},
+		{poly(64, 4, 3, 1, 0), true},
+		{poly(127, 1, 0), true},
+		{poly(127, 2, 0), false},
+		{poly(607, 105, 0), true},
+		{gf2.Mul(poly(4, 1, 0), poly(3, 1, 0)), false},
+		{gf2.Mul(poly(5, 2, 0), poly(5, 2, 0)), false},
+	}
+
+	for _, test := range tests {
+		if got := gf2.IsIrreducible(test.p); got != test.want {
+			t.Errorf("IsIrreducible(%v): want: %v, got: %v", test.p, test.want, got)
+		}
+	}
+}
+
+func TestMinimalPolynomial(t *testing.T) {
+	for _, f := range []gf2.Poly{poly(4, 1, 0), poly(127, 1, 0), poly(89, 38, 0), gf2.Mul(poly(7, 1, 0), poly(9, 4, 0))} {
+		n := f.Degree()
+		// s(k+n) = sum of s(k+i) over the lower terms x^i of f
+		s := make([]uint8, 2*n+16)
+		s[n-1] = 1
+		for k := 0; k+n < len(s); k++ {
+			for i := 0; i < n; i++ {
+				s[k+n] ^= uint8(f.Coeff(i)) & s[k+i]
+			}
+		}
+
+		if got := gf2.MinimalPolynomial(s); !got.Equal(f) {
+			t.Errorf("MinimalPolynomial: want: %v, got: %v", f, got)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a, b, m := poly(200, 65, 3, 0), poly(130, 64, 63, 1), poly(127, 1, 0)
+	ab := gf2.Mul(a, b)
+	if ab.Degree() != 330 || !gf2.Mod(ab, a).Equal(gf2.Poly{}) || !gf2.Mod(ab, b).Equal(gf2.Poly{}) {
+		t.Errorf("Mul: %v", ab)
+	}
+
+	if got := gf2.MulMod(a, b, m); !got.Equal(gf2.Mod(gf2.Mul(gf2.Mod(a, m), gf2.Mod(b, m)), m)) || got.Degree() >= 127 {
+		t.Errorf("MulMod: %v", got)
+	}
+
+	if got := gf2.PowMod(poly(1), 1000, m); !got.Equal(gf2.Mod(gf2.Monomial(1000), m)) {
+		t.Errorf("PowMod: %v", got)
+	}
+
+	if got, a2 := gf2.PowMod(a, 5, m), gf2.MulMod(a, a, m); !got.Equal(gf2.MulMod(gf2.MulMod(a2, a2, m), a, m)) {
+		t.Errorf("PowMod: %v", got)
+	}
+
+	if got := gf2.GCD(gf2.Mul(poly(4, 1, 0), m), gf2.Mul(poly(6, 1, 0), m)); !got.Equal(m) {
+		t.Errorf("GCD: want: %v, got: %v", m, got)
+	}
+
+	if got := poly(127, 1, 0).String(); got != "80000000000000000000000000000003" {
+		t.Errorf("String: %v", got)
+	}
+}
+
+func TestBasis(t *testing.T) {
+	b := gf2.NewBasis(130)
+	vectors := []struct {
+		v    []uint64
+		want bool
+	}{
+		{[]uint64{1, 0, 0}, true},
+		{[]uint64{1, 1, 0}, true},
+		{[]uint64{0, 1, 0}, false},
+		{[]uint64{3, 0, 2}, true},
+		{[]uint64{2, 0, 0}, true},
+		{[]uint64{0, 0, 0}, false},
+		{[]uint64{2, 1, 0}, false},
+	}
+
+	for i, test := range vectors {
+		if got := b.Add(test.v); got != test.want {
+			t.Errorf("Add #%d: want: %v, got: %v", i, test.want, got)
+		}
+	}
+
+	if b.Rank() != 4 {
+		t.Errorf("Rank: want: 4, got: %d", b.Rank())
+	}
+}
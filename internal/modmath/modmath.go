@@ -0,0 +1,148 @@
+// Package modmath implements exact modular arithmetic on uint64 for the linear recurrences
+// used by the MRG and LCG sources.
+//
+// Products are computed in 128-bits (math/bits), so any modulus m with 0 < m < 2^64 can be used
+// and the operands don't need to be split (as is done in the double-precision reference codes).
+// All operands must already be reduced modulo m.
+package modmath
+
+import (
+	"math/bits"
+)
+
+// Mul returns a*b mod m.
+func Mul(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// Add returns a+b mod m.
+func Add(a, b, m uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 || sum >= m {
+		sum -= m
+	}
+
+	return sum
+}
+
+// Sub returns a-b mod m.
+func Sub(a, b, m uint64) uint64 {
+	if a >= b {
+		return a - b
+	}
+
+	return m - (b - a)
+}
+
+// MulAdd returns a*b+c mod m.
+func MulAdd(a, b, c, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	lo, carry := bits.Add64(lo, c, 0)
+	return bits.Rem64(hi+carry, lo, m)
+}
+
+// Pow returns a^e mod m.
+func Pow(a, e, m uint64) uint64 {
+	ans := 1 % m
+	for e != 0 {
+		if e&1 == 1 {
+			ans = Mul(ans, a, m)
+		}
+		a = Mul(a, a, m)
+		e >>= 1
+	}
+
+	return ans
+}
+
+// Matrix is a square matrix over the integers modulo some m.
+type Matrix [][]uint64
+
+// Identity returns the k x k identity matrix.
+func Identity(k int) Matrix {
+	ans := make(Matrix, k)
+	for i := range ans {
+		ans[i] = make([]uint64, k)
+		ans[i][i] = 1
+	}
+
+	return ans
+}
+
+// Companion returns the transition matrix of the multiple recursive generator
+//
+//	x(n) = (a[0]*x(n-1) + a[1]*x(n-2) + ... + a[k-1]*x(n-k)) mod m
+//
+// for the state vector (x(n-k), ..., x(n-2), x(n-1)), i.e. the oldest value first.
+// Negative coefficients must be given as m - |a[i]|.
+func Companion(a []uint64, m uint64) Matrix {
+	k := len(a)
+	ans := make(Matrix, k)
+	for i := range ans {
+		ans[i] = make([]uint64, k)
+		if i < k-1 {
+			ans[i][i+1] = 1
+		}
+	}
+
+	for j := 0; j < k; j++ {
+		ans[k-1][j] = a[k-1-j] % m
+	}
+
+	return ans
+}
+
+// Mul returns the product A*B mod m.
+func (A Matrix) Mul(B Matrix, m uint64) Matrix {
+	k := len(A)
+	ans := make(Matrix, k)
+	for i := 0; i < k; i++ {
+		ans[i] = make([]uint64, k)
+		for j := 0; j < k; j++ {
+			var x uint64
+			for l := 0; l < k; l++ {
+				x = Add(x, Mul(A[i][l], B[l][j], m), m)
+			}
+			ans[i][j] = x
+		}
+	}
+
+	return ans
+}
+
+// Pow returns A^e mod m, i.e. the transition matrix that advances the recurrence by e steps.
+func (A Matrix) Pow(e, m uint64) Matrix {
+	ans := Identity(len(A))
+	for e != 0 {
+		if e&1 == 1 {
+			ans = ans.Mul(A, m)
+		}
+		A = A.Mul(A, m)
+		e >>= 1
+	}
+
+	return ans
+}
+
+// Pow2 returns A^(2^e) mod m, by squaring A e times.
+// This covers the jumps that are too long for Pow (i.e. 2^64 steps or more).
+func (A Matrix) Pow2(e uint, m uint64) Matrix {
+	for i := uint(0); i < e; i++ {
+		A = A.Mul(A, m)
+	}
+
+	return A
+}
+
+// MulVec computes A*s mod m, storing the result in v (s and v can be the same slice).
+func (A Matrix) MulVec(s, v []uint64, m uint64) {
+	x := make([]uint64, len(A))
+	for i := range A {
+		for j := range s {
+			x[i] = Add(x[i], Mul(A[i][j], s[j], m), m)
+		}
+	}
+
+	copy(v, x)
+}
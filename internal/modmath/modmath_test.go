@@ -0,0 +1,112 @@
+package modmath_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/jtejido/grand/internal/modmath"
+)
+
+var operands = []uint64{
+	0, 1, 2, 12345, 1403580, 810728, 4294967087, 4294944443, 1 << 32, 1<<53 + 1,
+	9223372036854769163, 9223372036854754679, 1<<63 + 12345, 1<<64 - 60, 1<<64 - 59,
+}
+
+var moduli = []uint64{
+	2, 4294967087, 2147483647, 9223372036854769163, 1<<63 + 1, 1<<64 - 59,
+}
+
+func TestArithmetic(t *testing.T) {
+	for _, m := range moduli {
+		M := new(big.Int).SetUint64(m)
+		for _, a := range operands {
+			a %= m
+			A := new(big.Int).SetUint64(a)
+			for _, b := range operands {
+				b %= m
+				B := new(big.Int).SetUint64(b)
+
+				want := new(big.Int).Mul(A, B)
+				want.Mod(want, M)
+				if got := modmath.Mul(a, b, m); got != want.Uint64() {
+					t.Errorf("Mul(%d, %d, %d): want: %v, got: %v", a, b, m, want, got)
+				}
+
+				want.Add(want, A)
+				want.Mod(want, M)
+				if got := modmath.MulAdd(a, b, a, m); got != want.Uint64() {
+					t.Errorf("MulAdd(%d, %d, %d, %d): want: %v, got: %v", a, b, a, m, want, got)
+				}
+
+				want.Add(A, B)
+				want.Mod(want, M)
+				if got := modmath.Add(a, b, m); got != want.Uint64() {
+					t.Errorf("Add(%d, %d, %d): want: %v, got: %v", a, b, m, want, got)
+				}
+
+				want.Sub(A, B)
+				want.Mod(want, M)
+				if got := modmath.Sub(a, b, m); got != want.Uint64() {
+					t.Errorf("Sub(%d, %d, %d): want: %v, got: %v", a, b, m, want, got)
+				}
+
+				want.Exp(A, B, M)
+				if got := modmath.Pow(a, b, m); got != want.Uint64() {
+					t.Errorf("Pow(%d, %d, %d): want: %v, got: %v", a, b, m, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestMatrixPow(t *testing.T) {
+	// The jump matrices of L'Ecuyer's RngStreams (MRG32k3a), i.e. the transition matrices raised to 2^76.
+	const (
+		m1 = 4294967087
+		m2 = 4294944443
+	)
+	a1p76 := modmath.Matrix{
+		{82758667, 1871391091, 4127413238},
+		{3672831523, 69195019, 1871391091},
+		{3672091415, 3528743235, 69195019},
+	}
+	a2p76 := modmath.Matrix{
+		{1511326704, 3759209742, 1610795712},
+		{4292754251, 1511326704, 3889917532},
+		{3859662829, 4292754251, 3708466080},
+	}
+
+	A1 := modmath.Companion([]uint64{0, 1403580, m1 - 810728}, m1)
+	A2 := modmath.Companion([]uint64{527612, 0, m2 - 1370589}, m2)
+
+	equal(t, "A1^(2^76)", a1p76, A1.Pow2(76, m1))
+	equal(t, "A2^(2^76)", a2p76, A2.Pow2(76, m2))
+	equal(t, "A1^(2^63) * A1^(2^63)", A1.Pow2(64, m1), A1.Pow(1<<63, m1).Mul(A1.Pow(1<<63, m1), m1))
+
+	// Advancing the state by the matrix must match stepping the recurrence.
+	s := []uint64{12345, 12345, 12345}
+	v := append([]uint64{}, s...)
+	for i := 0; i < 1000; i++ {
+		x := modmath.Sub(modmath.Mul(1403580, v[1], m1), modmath.Mul(810728, v[0], m1), m1)
+		v = append(v[1:], x)
+	}
+	A1.Pow(1000, m1).MulVec(s, s, m1)
+	for i := range s {
+		if s[i] != v[i] {
+			t.Errorf("Mismatch. want: %v, got: %v", v, s)
+			break
+		}
+	}
+}
+
+func equal(t *testing.T, what string, want, got modmath.Matrix) {
+	t.Helper()
+
+	for i := range want {
+		for j := range want[i] {
+			if want[i][j] != got[i][j] {
+				t.Fatalf("%s: mismatch at (%d, %d). want: %v, got: %v", what, i, j, want[i][j], got[i][j])
+			}
+		}
+	}
+}
@@ -0,0 +1,308 @@
+// Package ranlux implements the arithmetic of the LCG equivalent to the RANLUX subtract-with-borrow
+// generators, and the conversions between the states of both.
+//
+// The subtract-with-borrow recursion x(n) = x(n-10) - x(n-24) - c(n-1) in base b = 2^24 (and the one
+// with lags 5 and 12 in base 2^48, which does two such steps at once) is equivalent to the LCG
+// X(n+1) = a*X(n) mod m, with the prime modulus m = 2^576 - 2^240 + 1 and the multiplier
+// a = m - (m-1)/2^24, as shown by Marsaglia and Zaman and used by Sibidanov's RANLUX++.
+//
+// Alexei Sibidanov, A revision of the subtract-with-borrow random number generators.
+// Computer Physics Communications, 2017, 221, 299--303. https://arxiv.org/abs/1705.03123
+package ranlux
+
+import (
+	"math/bits"
+)
+
+// Number is a 576-bit number, the least significant word first.
+//
+// The state of the subtract-with-borrow generators is the Number R whose base-2^24 (or base-2^48)
+// digits are the lag values, the oldest first, together with the carry c.
+type Number [9]uint64
+
+var (
+	// M is the modulus 2^576 - 2^240 + 1.
+	M = Number{
+		0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0xffff000000000000, 0xffffffffffffffff,
+		0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff,
+	}
+	// A is the multiplier m - (m-1)/2^24, i.e. one step of the 24-bit subtract-with-borrow recursion.
+	A = Number{
+		0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0xffff000001000000, 0xffffffffffffffff,
+		0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff, 0xfffffeffffffffff,
+	}
+	one = Number{1}
+)
+
+// IsZero reports whether x is 0.
+func (x *Number) IsZero() bool {
+	return *x == Number{}
+}
+
+// Less reports whether x < y.
+func (x *Number) Less(y *Number) bool {
+	for i := len(x) - 1; i >= 0; i-- {
+		if x[i] != y[i] {
+			return x[i] < y[i]
+		}
+	}
+
+	return false
+}
+
+// Reduce brings x, which must be less than 2m, back to [0, m).
+func (x *Number) Reduce() {
+	if !x.Less(&M) {
+		var borrow uint64
+		for i := range x {
+			x[i], borrow = bits.Sub64(x[i], M[i], borrow)
+		}
+	}
+}
+
+// reduce returns p mod m, for p of at most 18 words.
+//
+// As 2^576 = 2^240 - 1 mod m, the high part H of p = L + H*2^576 is folded as L + H*2^240 - H,
+// which is never negative and has at most 241 bits more than 576 after the first fold.
+func reduce(p []uint64) (ans Number) {
+	var t [19]uint64
+	copy(t[:], p)
+	for {
+		var h [10]uint64
+		copy(h[:], t[len(ans):])
+		if h == ([10]uint64{}) {
+			break
+		}
+
+		for i := len(ans); i < len(t); i++ {
+			t[i] = 0
+		}
+
+		// t += H*2^240, where 240 = 3*64 + 48.
+		var carry uint64
+		for i := 0; i <= len(h); i++ {
+			var w uint64
+			if i < len(h) {
+				w = h[i] << 48
+			}
+			if i > 0 {
+				w |= h[i-1] >> 16
+			}
+			t[i+3], carry = bits.Add64(t[i+3], w, carry)
+		}
+		for i := len(h) + 4; i < len(t); i++ {
+			t[i], carry = bits.Add64(t[i], 0, carry)
+		}
+
+		// t -= H
+		var borrow uint64
+		for i := range t {
+			var w uint64
+			if i < len(h) {
+				w = h[i]
+			}
+			t[i], borrow = bits.Sub64(t[i], w, borrow)
+		}
+	}
+
+	copy(ans[:], t[:])
+	ans.Reduce()
+	return
+}
+
+// MulMod returns x*y mod m, x and y must be less than m.
+func MulMod(x, y *Number) Number {
+	var p [18]uint64
+	for i := range x {
+		var carry uint64
+		for j := range y {
+			hi, lo := bits.Mul64(x[i], y[j])
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			p[i+j], c = bits.Add64(p[i+j], lo, 0)
+			carry = hi + c
+		}
+		p[i+len(y)] = carry
+	}
+
+	return reduce(p[:])
+}
+
+// PowMod returns x^e mod m.
+func PowMod(x *Number, e uint64) Number {
+	ans, base := one, *x
+	for e != 0 {
+		if e&1 == 1 {
+			ans = MulMod(&ans, &base)
+		}
+		base = MulMod(&base, &base)
+		e >>= 1
+	}
+
+	return ans
+}
+
+// Pow2Mod returns x^(2^k) mod m.
+func Pow2Mod(x *Number, k int) Number {
+	ans := *x
+	for i := 0; i < k; i++ {
+		ans = MulMod(&ans, &ans)
+	}
+
+	return ans
+}
+
+// ToLCG returns the state X = R - floor(R/2^336) + c mod m of the LCG equivalent to the
+// subtract-with-borrow state (R, c).
+func ToLCG(r *Number, c uint64) Number {
+	var ans Number
+	var borrow uint64
+	// floor(R/2^336), where 336 = 5*64 + 16.
+	for i := range ans {
+		var w uint64
+		if i < 4 {
+			w = r[i+5] >> 16
+			if i < 3 {
+				w |= r[i+6] << 48
+			}
+		}
+		ans[i], borrow = bits.Sub64(r[i], w, borrow)
+	}
+
+	carry := c
+	for i := range ans {
+		ans[i], carry = bits.Add64(ans[i], 0, carry)
+	}
+
+	// ans <= m, as R - floor(R/2^336) <= 2^576 - 2^240.
+	ans.Reduce()
+	return ans
+}
+
+// ToRanlux returns the subtract-with-borrow state (R, c) of the LCG state x < m, with
+// R = floor(x*2^576/m). The states (R, 0) and (R-1, 1) may both map to x, they generate the same
+// sequence, and the first one is almost always the one reached by the recursion.
+func ToRanlux(x *Number) (Number, uint64) {
+	r := fixedPoint(x, 0)
+
+	// R is the floor iff R <= (R mod 2^336)*2^240, otherwise the carry is set.
+	var low Number
+	for i := 0; i <= 5; i++ {
+		var w uint64
+		if i < 5 {
+			w = r[i] << 48
+		} else {
+			w = (r[5] & 0xffff) << 48
+		}
+		if i > 0 {
+			w |= r[i-1] >> 16
+		}
+		low[i+3] = w
+	}
+
+	if low.Less(&r) {
+		return fixedPoint(x, 1), 1
+	}
+
+	return r, 0
+}
+
+// Returns the solution R of R = x + floor(R/2^336) - c, found by iterating from x.
+func fixedPoint(x *Number, c uint64) Number {
+	r := *x
+	for {
+		var next Number
+		var carry uint64
+		for i := range next {
+			var w uint64
+			if i < 4 {
+				w = r[i+5] >> 16
+				if i < 3 {
+					w |= r[i+6] << 48
+				}
+			}
+			next[i], carry = bits.Add64(x[i], w, carry)
+		}
+
+		borrow := c
+		for i := range next {
+			next[i], borrow = bits.Sub64(next[i], 0, borrow)
+		}
+
+		if next == r {
+			return r
+		}
+		r = next
+	}
+}
+
+// Pack returns the Number whose base-2^w digits are x, the least significant first.
+func Pack(x []uint64, w uint) (ans Number) {
+	for j, d := range x {
+		pos := uint(j) * w
+		i, off := pos/64, pos%64
+		ans[i] |= d << off
+		if off+w > 64 {
+			ans[i+1] |= d >> (64 - off)
+		}
+	}
+
+	return
+}
+
+// Unpack stores the base-2^w digits of r in x, the least significant first.
+func Unpack(r *Number, w uint, x []uint64) {
+	mask := uint64(1)<<w - 1
+	for j := range x {
+		pos := uint(j) * w
+		i, off := pos/64, pos%64
+		d := r[i] >> off
+		if off+w > 64 {
+			d |= r[i+1] << (64 - off)
+		}
+		x[j] = d & mask
+	}
+}
+
+// Advance multiplies the subtract-with-borrow state (x, c), with the lag values x as w-bit digits,
+// by mult as a state of the equivalent LCG, and returns the new carry.
+// The multiplier a^k advances the recursion in base 2^24 by k steps.
+func Advance(x []uint64, c uint64, w uint, mult *Number) uint64 {
+	r := Pack(x, w)
+	lcg := ToLCG(&r, c)
+	lcg = MulMod(mult, &lcg)
+	r, c = ToRanlux(&lcg)
+	Unpack(&r, w, x)
+	return c
+}
+
+// SeedSequence fills x with the w-bit lag values, and returns the carry, set by the seed(value) member of
+// std::subtract_with_carry_engine: the values are drawn from the LCG with the multiplier 40014 and the
+// modulus 2147483563, seeded with value (or 19780503 if value is 0).
+func SeedSequence(value uint64, w uint, x []uint64) uint64 {
+	const lcgA, lcgM = 40014, 2147483563
+	if value == 0 {
+		value = 19780503
+	}
+	e := value % lcgM
+	if e == 0 {
+		e = 1
+	}
+
+	n := (w + 31) / 32
+	for i := range x {
+		var v uint64
+		for j := uint(0); j < n; j++ {
+			e = e * lcgA % lcgM
+			v += e << (32 * j)
+		}
+		x[i] = v & (1<<w - 1)
+	}
+
+	if x[len(x)-1] == 0 {
+		return 1
+	}
+
+	return 0
+}
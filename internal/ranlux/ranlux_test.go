@@ -0,0 +1,128 @@
+package ranlux_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/jtejido/grand/internal/ranlux"
+)
+
+func toBig(x ranlux.Number) *big.Int {
+	ans := new(big.Int)
+	for i := len(x) - 1; i >= 0; i-- {
+		ans.Lsh(ans, 64)
+		ans.Or(ans, new(big.Int).SetUint64(x[i]))
+	}
+
+	return ans
+}
+
+func fromBig(x *big.Int) (ans ranlux.Number) {
+	y := new(big.Int).Set(x)
+	mask := new(big.Int).SetUint64(^uint64(0))
+	for i := range ans {
+		ans[i] = new(big.Int).And(y, mask).Uint64()
+		y.Rsh(y, 64)
+	}
+
+	return
+}
+
+// returns random numbers below m, with a few edge cases first.
+func numbers(n int) []*big.Int {
+	m := toBig(ranlux.M)
+	one := big.NewInt(1)
+	ans := []*big.Int{
+		big.NewInt(0), big.NewInt(1), big.NewInt(2),
+		new(big.Int).Sub(m, one), new(big.Int).Sub(m, big.NewInt(2)),
+		new(big.Int).Lsh(one, 336), new(big.Int).Sub(new(big.Int).Lsh(one, 336), one),
+		new(big.Int).Lsh(one, 575), new(big.Int).Sub(new(big.Int).Lsh(one, 576), new(big.Int).Lsh(one, 240)),
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for len(ans) < n {
+		ans = append(ans, new(big.Int).Rand(r, m))
+	}
+
+	return ans
+}
+
+func TestMulMod(t *testing.T) {
+	m := toBig(ranlux.M)
+	xs := numbers(60)
+	for i, x := range xs {
+		y := xs[(i*7+3)%len(xs)]
+		want := new(big.Int).Mul(x, y)
+		want.Mod(want, m)
+
+		a, b := fromBig(x), fromBig(y)
+		if got := ranlux.MulMod(&a, &b); toBig(got).Cmp(want) != 0 {
+			t.Errorf("MulMod(%x, %x): want: %x, got: %x", x, y, want, toBig(got))
+		}
+	}
+
+	a := fromBig(xs[20])
+	want := new(big.Int).Exp(xs[20], big.NewInt(1234567), m)
+	if got := ranlux.PowMod(&a, 1234567); toBig(got).Cmp(want) != 0 {
+		t.Errorf("PowMod: want: %x, got: %x", want, toBig(got))
+	}
+
+	want.Exp(xs[20], new(big.Int).Lsh(big.NewInt(1), 100), m)
+	if got := ranlux.Pow2Mod(&a, 100); toBig(got).Cmp(want) != 0 {
+		t.Errorf("Pow2Mod: want: %x, got: %x", want, toBig(got))
+	}
+}
+
+func TestConversions(t *testing.T) {
+	m := toBig(ranlux.M)
+	for _, x := range numbers(200) {
+		want := new(big.Int).Lsh(x, 576)
+		want.Quo(want, m)
+
+		a := fromBig(x)
+		r, c := ranlux.ToRanlux(&a)
+		if toBig(r).Cmp(want) != 0 || c > 1 {
+			t.Errorf("ToRanlux(%x): want: %x, got: %x, %d", x, want, toBig(r), c)
+		}
+
+		if got := ranlux.ToLCG(&r, c); got != a {
+			t.Errorf("ToLCG(ToRanlux(%x)): got: %x", x, toBig(got))
+		}
+	}
+}
+
+// The multiplication by A is one step of the recursion x(n) = x(n-10) - x(n-24) - c(n-1) mod 2^24.
+func TestStep(t *testing.T) {
+	x := make([]uint64, 24)
+	for i := range x {
+		x[i] = uint64(i) * 2654435761 & 0xffffff
+	}
+	var c uint64
+
+	r := ranlux.Pack(x, 24)
+	lcg := ranlux.ToLCG(&r, c)
+	for n := 0; n < 1000; n++ {
+		y := int64(x[14]) - int64(x[0]) - int64(c)
+		c = 0
+		if y < 0 {
+			y += 1 << 24
+			c = 1
+		}
+		x = append(x[1:], uint64(y))
+
+		lcg = ranlux.MulMod(&ranlux.A, &lcg)
+		r := ranlux.Pack(x, 24)
+		if want := ranlux.ToLCG(&r, c); want != lcg {
+			t.Fatalf("Step %d: want: %x, got: %x", n, want, lcg)
+		}
+
+		// the newest digit is the output of the recursion.
+		r, _ = ranlux.ToRanlux(&lcg)
+		got := make([]uint64, 24)
+		ranlux.Unpack(&r, 24, got)
+		if got[23] != x[23] {
+			t.Fatalf("Step %d: want: %x, got: %x", n, x[23], got[23])
+		}
+	}
+}
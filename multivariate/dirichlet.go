@@ -0,0 +1,37 @@
+package multivariate
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// Dirichlet writes into dst a value of the Dirichlet distribution of parameters alpha (all > 0), which has
+// the same length: normalized Gamma(alpha[i], 1) values. These are handled by their logarithms, so that
+// small parameters, whose Gamma values underflow, are still sampled correctly.
+func Dirichlet(r *grand.Rand, alpha, dst []float64) {
+	if len(alpha) == 0 || len(dst) != len(alpha) {
+		panic("invalid argument to Dirichlet")
+	}
+
+	max := math.Inf(-1)
+	for i, a := range alpha {
+		if !(a > 0) || math.IsInf(a, 1) {
+			panic("invalid argument to Dirichlet")
+		}
+
+		dst[i] = logGamma(r, a)
+		if dst[i] > max {
+			max = dst[i]
+		}
+	}
+
+	sum := 0.0
+	for i := range dst {
+		dst[i] = math.Exp(dst[i] - max)
+		sum += dst[i]
+	}
+	for i := range dst {
+		dst[i] /= sum
+	}
+}
@@ -0,0 +1,48 @@
+package multivariate_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/multivariate"
+)
+
+func TestDirichlet(t *testing.T) {
+	r := newRand()
+	alpha := []float64{0.5, 1, 2.5, 10}
+	total := 14.0
+
+	x := make([]float64, len(alpha))
+	var sum, sumSq [4]float64
+	for s := 0; s < samples; s++ {
+		multivariate.Dirichlet(r, alpha, x)
+		tot := 0.0
+		for i, v := range x {
+			if v < 0 || v > 1 {
+				t.Fatalf("value out of [0, 1]: %v", v)
+			}
+			tot += v
+			sum[i] += v
+			sumSq[i] += v * v
+		}
+		if math.Abs(tot-1) > 1e-12 {
+			t.Fatalf("Mismatch. want: %v, got: %v", 1, tot)
+		}
+	}
+
+	for i, a := range alpha {
+		checkMean(t, "mean", sum[i], sumSq[i], samples, a/total)
+	}
+
+	t.Run("SmallAlpha", func(t *testing.T) {
+		// the Gamma values underflow to 0, but one coordinate takes (nearly) all the mass.
+		alpha := []float64{1e-3, 1e-3, 1e-3}
+		x := make([]float64, 3)
+		for s := 0; s < 1000; s++ {
+			multivariate.Dirichlet(r, alpha, x)
+			if tot := x[0] + x[1] + x[2]; math.IsNaN(tot) || math.Abs(tot-1) > 1e-12 {
+				t.Fatalf("Mismatch. want: %v, got: %v", 1, tot)
+			}
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package multivariate
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// Multinomial writes into dst the counts of n trials among len(p) outcomes of probabilities proportional
+// to the weights p (all >= 0, not all 0). dst has the same length as p.
+// The counts are drawn one after the other, each as a binomial value of the remaining trials.
+func Multinomial(r *grand.Rand, n int, p []float64, dst []int) {
+	if n < 0 || len(p) == 0 || len(dst) != len(p) {
+		panic("invalid argument to Multinomial")
+	}
+
+	last := -1
+	for i, w := range p {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			panic("invalid argument to Multinomial")
+		}
+		if w > 0 {
+			last = i
+		}
+	}
+	if last < 0 {
+		panic("invalid argument to Multinomial")
+	}
+
+	// rem[i] is the sum of p[i:], added from the end so that it doesn't carry the rounding errors of the
+	// weights already drawn.
+	rem := make([]float64, len(p))
+	for i, acc := len(p)-1, 0.0; i >= 0; i-- {
+		acc += p[i]
+		rem[i] = acc
+	}
+
+	for i, w := range p {
+		if w == 0 || n == 0 {
+			dst[i] = 0
+			continue
+		}
+		// the remaining trials go to the last outcome of positive weight, never to an outcome of weight 0.
+		if i == last {
+			dst[i] = n
+			n = 0
+			continue
+		}
+
+		dst[i] = binomial(r, n, math.Min(w/rem[i], 1))
+		n -= dst[i]
+	}
+}
@@ -0,0 +1,72 @@
+package multivariate_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/multivariate"
+)
+
+func TestMultinomial(t *testing.T) {
+	r := newRand()
+
+	// small and large numbers of trials (the latter use the Beta splitting of the binomial values).
+	for _, n := range []int{10, 1000, 1 << 30} {
+		p := []float64{1, 0, 2, 3, 4}
+		counts := make([]int, len(p))
+		var sum, sumSq [5]float64
+		for s := 0; s < samples/10; s++ {
+			multivariate.Multinomial(r, n, p, counts)
+			tot := 0
+			for i, c := range counts {
+				tot += c
+				sum[i] += float64(c)
+				sumSq[i] += float64(c) * float64(c)
+			}
+			if tot != n {
+				t.Fatalf("Mismatch. want: %v, got: %v", n, tot)
+			}
+			if counts[1] != 0 {
+				t.Fatalf("Mismatch. want: %v, got: %v", 0, counts[1])
+			}
+		}
+
+		for i, w := range p {
+			if w != 0 {
+				checkMean(t, "count", sum[i], sumSq[i], samples/10, float64(n)*w/10)
+			}
+		}
+	}
+
+	t.Run("ZeroWeight", func(t *testing.T) {
+		// 1e16+8 loses the rounding of the weights 5 and 3, so a running remainder would leave about a quarter
+		// of the trials left at the third outcome for the outcome of weight 0 (with a 64-bit int, some thousand
+		// trials get past the first two outcomes).
+		p := []float64{5, 1e16, 3, 0}
+		counts := make([]int, len(p))
+		for s := 0; s < 100; s++ {
+			multivariate.Multinomial(r, math.MaxInt, p, counts)
+			if counts[3] != 0 {
+				t.Fatalf("Mismatch. want: %v, got: %v", 0, counts[3])
+			}
+		}
+	})
+
+	t.Run("Variance", func(t *testing.T) {
+		// the binomial variance n*p*(1-p).
+		const n = 12345
+		counts := make([]int, 2)
+		var sum, sumSq, devSq, devSqSq float64
+		for s := 0; s < samples; s++ {
+			multivariate.Multinomial(r, n, []float64{0.3, 0.7}, counts)
+			c := float64(counts[0])
+			d := (c - 0.3*n) * (c - 0.3*n)
+			sum += c
+			sumSq += c * c
+			devSq += d
+			devSqSq += d * d
+		}
+		checkMean(t, "mean", sum, sumSq, samples, 0.3*n)
+		checkMean(t, "variance", devSq, devSqSq, samples, 0.3*0.7*n)
+	})
+}
@@ -0,0 +1,96 @@
+// Package multivariate implements vector-valued random variates over grand.Rand: the multivariate normal
+// distribution, the Dirichlet and multinomial distributions, uniform points on spheres, balls and simplices,
+// and random orthogonal matrices.
+//
+// The samplers write into slices provided by the caller and do not allocate, so that one buffer can be
+// reused for every draw. Matrices are dense, row-major slices of n*n values.
+package multivariate
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/internal/gaussian"
+)
+
+const (
+	// Below this number of trials, the binomial values are counted directly.
+	binomial_direct = 16
+)
+
+// Returns a standard normal value.
+func normal(r *grand.Rand) float64 {
+	var z [1]float64
+	gaussian.Fill(r, z[:])
+	return z[0]
+}
+
+// Returns a Gamma(a, 1) value, a >= 1, with Marsaglia and Tsang's method.
+//
+// George Marsaglia and Wai Wan Tsang, A Simple Method for Generating Gamma Variables.
+// ACM Transactions on Mathematical Software, 2000, 26(3), 363--372.
+func gamma(r *grand.Rand, a float64) float64 {
+	d := a - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		var x, v float64
+		for v <= 0 {
+			x = normal(r)
+			v = 1 + c*x
+		}
+
+		v = v * v * v
+		u := r.Float64Open()
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
+
+// Returns the logarithm of a Gamma(a, 1) value, a > 0. For a < 1, Gamma(a) = Gamma(a+1)*U^(1/a)
+// underflows for small a, but not its logarithm.
+func logGamma(r *grand.Rand, a float64) float64 {
+	if a >= 1 {
+		return math.Log(gamma(r, a))
+	}
+
+	return math.Log(gamma(r, a+1)) + math.Log(r.Float64Open())/a
+}
+
+// Returns a Binomial(n, p) value. Above binomial_direct trials, the a-th smallest of the n uniform values,
+// a Beta(a, n+1-a) value, tells how many of them are below p, and the search goes on among those on the
+// side of p: this is exact, and takes O(log(n)) Beta values.
+//
+// Luc Devroye, Non-Uniform Random Variate Generation. Springer-Verlag, 1986, X.4.
+func binomial(r *grand.Rand, n int, p float64) int {
+	ans := 0
+	for n > binomial_direct {
+		if p <= 0 {
+			return ans
+		}
+		if p >= 1 {
+			return ans + n
+		}
+
+		a := 1 + n/2
+		b := n + 1 - a
+		ga := gamma(r, float64(a))
+		x := ga / (ga + gamma(r, float64(b)))
+		if x >= p {
+			n = a - 1
+			p /= x
+		} else {
+			ans += a
+			n = b - 1
+			p = (p - x) / (1 - x)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if r.Float64() < p {
+			ans++
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,52 @@
+package multivariate_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/multivariate"
+	"github.com/jtejido/grand/source64"
+)
+
+const samples = 100000
+
+func newRand() *grand.Rand {
+	return grand.New(source64.NewSplitMix64(1))
+}
+
+// checkMean fails the test if the mean of samples values of sd is farther than 5 standard errors from want.
+func checkMean(t *testing.T, what string, sum, sumSq float64, n int, want float64) {
+	t.Helper()
+
+	mean := sum / float64(n)
+	se := math.Sqrt((sumSq/float64(n) - mean*mean) / float64(n))
+	if math.Abs(mean-want) > 5*se+1e-12 {
+		t.Errorf("%s: Mismatch. want: %v, got: %v (standard error %v)", what, want, mean, se)
+	}
+}
+
+func TestNoAllocs(t *testing.T) {
+	r := newRand()
+	mvn, err := multivariate.NewNormal([]float64{1, 2}, []float64{2, 1, 1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, p, counts, m := make([]float64, 2), []float64{0.5, 0.5}, make([]int, 2), make([]float64, 9)
+	cases := map[string]func(){
+		"Normal":      func() { mvn.Rand(r, x) },
+		"Dirichlet":   func() { multivariate.Dirichlet(r, p, x) },
+		"Multinomial": func() { multivariate.Multinomial(r, 1000, p, counts) },
+		"OnSphere":    func() { multivariate.OnSphere(r, x) },
+		"InBall":      func() { multivariate.InBall(r, x) },
+		"OnSimplex":   func() { multivariate.OnSimplex(r, x) },
+		"Orthogonal":  func() { multivariate.Orthogonal(r, 3, m) },
+	}
+
+	for name, f := range cases {
+		if allocs := testing.AllocsPerRun(100, f); allocs != 0 {
+			t.Errorf("%s: %v allocations per call", name, allocs)
+		}
+	}
+}
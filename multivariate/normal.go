@@ -0,0 +1,92 @@
+package multivariate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/internal/gaussian"
+)
+
+// Cholesky computes the lower triangular matrix L of the Cholesky decomposition a = L*L^T of the symmetric
+// positive definite n*n matrix a, into dst (the upper triangle is set to 0). Only the lower triangle of a is
+// read, and dst may be a.
+func Cholesky(dst, a []float64, n int) error {
+	if len(a) != n*n || len(dst) != n*n {
+		return fmt.Errorf("The matrices must have %d values", n*n)
+	}
+
+	for j := 0; j < n; j++ {
+		d := a[j*n+j]
+		for k := 0; k < j; k++ {
+			d -= dst[j*n+k] * dst[j*n+k]
+		}
+		if !(d > 0) {
+			return errors.New("The matrix must be positive definite")
+		}
+
+		d = math.Sqrt(d)
+		dst[j*n+j] = d
+		for i := j + 1; i < n; i++ {
+			s := a[i*n+j]
+			for k := 0; k < j; k++ {
+				s -= dst[i*n+k] * dst[j*n+k]
+			}
+			dst[i*n+j] = s / d
+		}
+		for k := j + 1; k < n; k++ {
+			dst[j*n+k] = 0
+		}
+	}
+
+	return nil
+}
+
+// Normal is the multivariate normal distribution N(mean, cov), sampled as mean + L*z, where
+// cov = L*L^T and z holds independent standard normal values.
+type Normal struct {
+	mean []float64
+	chol []float64
+}
+
+// NewNormal returns the multivariate normal distribution of the given mean and covariance matrix
+// (row-major, len(mean)^2 values), which must be symmetric positive definite. Both are copied.
+func NewNormal(mean, cov []float64) (*Normal, error) {
+	n := len(mean)
+	if n == 0 {
+		return nil, errors.New("The mean cannot be empty")
+	}
+
+	ans := &Normal{mean: append([]float64{}, mean...), chol: make([]float64, n*n)}
+	err := Cholesky(ans.chol, cov, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// Dim returns the dimension of the distribution.
+func (mvn *Normal) Dim() int {
+	return len(mvn.mean)
+}
+
+// Rand writes a value of the distribution into dst, which must have Dim() values.
+func (mvn *Normal) Rand(r *grand.Rand, dst []float64) {
+	n := len(mvn.mean)
+	if len(dst) != n {
+		panic("invalid argument to Normal.Rand")
+	}
+
+	gaussian.Fill(r, dst)
+	// dst[i] only needs z[0..i], so L*z is computed in place from the last row.
+	for i := n - 1; i >= 0; i-- {
+		s := mvn.mean[i]
+		row := mvn.chol[i*n : i*n+i+1]
+		for k, l := range row {
+			s += l * dst[k]
+		}
+		dst[i] = s
+	}
+}
@@ -0,0 +1,99 @@
+package multivariate_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/multivariate"
+)
+
+func TestCholesky(t *testing.T) {
+	a := []float64{
+		4, 12, -16,
+		12, 37, -43,
+		-16, -43, 98,
+	}
+	expected := []float64{
+		2, 0, 0,
+		6, 1, 0,
+		-8, 5, 3,
+	}
+
+	l := make([]float64, 9)
+	if err := multivariate.Cholesky(l, a, 3); err != nil {
+		t.Fatal(err)
+	}
+	for i := range expected {
+		if expected[i] != l[i] {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], l[i])
+		}
+	}
+
+	t.Run("InPlace", func(t *testing.T) {
+		b := append([]float64{}, a...)
+		if err := multivariate.Cholesky(b, b, 3); err != nil {
+			t.Fatal(err)
+		}
+		for i := range expected {
+			if expected[i] != b[i] {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], b[i])
+			}
+		}
+	})
+
+	t.Run("NotPositiveDefinite", func(t *testing.T) {
+		if err := multivariate.Cholesky(l[:4], []float64{1, 2, 2, 1}, 2); err == nil {
+			t.Errorf("The matrix should be rejected.")
+		}
+		if err := multivariate.Cholesky(l[:4], []float64{1, 1, 1, 1}, 2); err == nil {
+			t.Errorf("A singular matrix should be rejected.")
+		}
+		if err := multivariate.Cholesky(l, []float64{1, 1, 1, 1}, 2); err == nil {
+			t.Errorf("The size should be checked.")
+		}
+	})
+}
+
+func TestNormal(t *testing.T) {
+	mean := []float64{1, -2, 3}
+	cov := []float64{
+		4, 2, -1,
+		2, 3, 0.5,
+		-1, 0.5, 2,
+	}
+
+	mvn, err := multivariate.NewNormal(mean, cov)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mvn.Dim() != 3 {
+		t.Errorf("Mismatch. want: %v, got: %v", 3, mvn.Dim())
+	}
+
+	r := newRand()
+	x := make([]float64, 3)
+	var sum [3]float64
+	var sumSq, prod, prodSq [3][3]float64
+	for s := 0; s < samples; s++ {
+		mvn.Rand(r, x)
+		for i := range x {
+			sum[i] += x[i]
+			sumSq[i][i] += x[i] * x[i]
+			for j := range x {
+				p := (x[i] - mean[i]) * (x[j] - mean[j])
+				prod[i][j] += p
+				prodSq[i][j] += p * p
+			}
+		}
+	}
+
+	for i := range mean {
+		checkMean(t, "mean", sum[i], sumSq[i][i], samples, mean[i])
+		for j := range mean {
+			checkMean(t, "covariance", prod[i][j], prodSq[i][j], samples, cov[i*3+j])
+		}
+	}
+
+	if _, err := multivariate.NewNormal(mean, cov[:4]); err == nil {
+		t.Errorf("The size of the covariance should be checked.")
+	}
+}
@@ -0,0 +1,61 @@
+package multivariate
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/internal/gaussian"
+)
+
+// Orthogonal writes into dst (row-major, n*n values) a random orthogonal matrix, distributed according to
+// the Haar measure on O(n). The columns of a matrix of independent normal values are orthonormalized by
+// Gram-Schmidt (twice, for accuracy): this is the QR decomposition in which R has a positive diagonal,
+// whose Q is Haar-distributed.
+//
+// Francesco Mezzadri, How to Generate Random Matrices from the Classical Compact Groups.
+// Notices of the AMS, 2007, 54(5), 592--604.
+func Orthogonal(r *grand.Rand, n int, dst []float64) {
+	if n <= 0 || len(dst) != n*n {
+		panic("invalid argument to Orthogonal")
+	}
+
+	for {
+		gaussian.Fill(r, dst)
+		if orthonormalize(n, dst) {
+			return
+		}
+	}
+}
+
+// Orthonormalizes the columns of the n*n matrix a, with modified Gram-Schmidt repeated twice.
+// It returns false if the columns are (numerically) dependent, which happens with probability 0.
+func orthonormalize(n int, a []float64) bool {
+	for j := 0; j < n; j++ {
+		for pass := 0; pass < 2; pass++ {
+			for k := 0; k < j; k++ {
+				dot := 0.0
+				for i := 0; i < n; i++ {
+					dot += a[i*n+k] * a[i*n+j]
+				}
+				for i := 0; i < n; i++ {
+					a[i*n+j] -= dot * a[i*n+k]
+				}
+			}
+		}
+
+		norm := 0.0
+		for i := 0; i < n; i++ {
+			norm += a[i*n+j] * a[i*n+j]
+		}
+		if !(norm > 0) {
+			return false
+		}
+
+		norm = math.Sqrt(norm)
+		for i := 0; i < n; i++ {
+			a[i*n+j] /= norm
+		}
+	}
+
+	return true
+}
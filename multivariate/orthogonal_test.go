@@ -0,0 +1,46 @@
+package multivariate_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/multivariate"
+)
+
+func TestOrthogonal(t *testing.T) {
+	r := newRand()
+	const n = 4
+	q := make([]float64, n*n)
+
+	// under the Haar measure, the trace has mean 0 and second moment 1.
+	var sum, sumSq, sumSqSq float64
+	for s := 0; s < samples/10; s++ {
+		multivariate.Orthogonal(r, n, q)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				dot := 0.0
+				for k := 0; k < n; k++ {
+					dot += q[k*n+i] * q[k*n+j]
+				}
+				want := 0.0
+				if i == j {
+					want = 1
+				}
+				if math.Abs(dot-want) > 1e-12 {
+					t.Fatalf("Q^T*Q: Mismatch. want: %v, got: %v", want, dot)
+				}
+			}
+		}
+
+		tr := 0.0
+		for i := 0; i < n; i++ {
+			tr += q[i*n+i]
+		}
+		sum += tr
+		sumSq += tr * tr
+		sumSqSq += tr * tr * tr * tr
+	}
+
+	checkMean(t, "trace", sum, sumSq, samples/10, 0)
+	checkMean(t, "squared trace", sumSq, sumSqSq, samples/10, 1)
+}
@@ -0,0 +1,64 @@
+package multivariate
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/internal/gaussian"
+)
+
+// OnSphere writes into dst a uniform point of the unit sphere of dimension len(dst)-1, the points of
+// norm 1 in R^len(dst): a vector of independent normal values, normalized.
+func OnSphere(r *grand.Rand, dst []float64) {
+	if len(dst) == 0 {
+		panic("invalid argument to OnSphere")
+	}
+
+	for {
+		gaussian.Fill(r, dst)
+		norm := 0.0
+		for _, x := range dst {
+			norm += x * x
+		}
+		if norm == 0 {
+			continue
+		}
+
+		norm = math.Sqrt(norm)
+		for i := range dst {
+			dst[i] /= norm
+		}
+		return
+	}
+}
+
+// InBall writes into dst a uniform point of the unit ball of R^len(dst): a point of the sphere,
+// scaled by U^(1/len(dst)).
+func InBall(r *grand.Rand, dst []float64) {
+	if len(dst) == 0 {
+		panic("invalid argument to InBall")
+	}
+
+	OnSphere(r, dst)
+	radius := math.Pow(r.Float64(), 1/float64(len(dst)))
+	for i := range dst {
+		dst[i] *= radius
+	}
+}
+
+// OnSimplex writes into dst a uniform point of the standard simplex, the points of R^len(dst) with
+// non-negative coordinates summing to 1: normalized exponential values (Dirichlet(1, ..., 1)).
+func OnSimplex(r *grand.Rand, dst []float64) {
+	if len(dst) == 0 {
+		panic("invalid argument to OnSimplex")
+	}
+
+	sum := 0.0
+	for i := range dst {
+		dst[i] = -math.Log(r.Float64Open())
+		sum += dst[i]
+	}
+	for i := range dst {
+		dst[i] /= sum
+	}
+}
@@ -0,0 +1,77 @@
+package multivariate_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/multivariate"
+)
+
+func TestOnSphere(t *testing.T) {
+	r := newRand()
+	x := make([]float64, 4)
+	var sum, sumSq [4]float64
+	for s := 0; s < samples; s++ {
+		multivariate.OnSphere(r, x)
+		norm := 0.0
+		for i, v := range x {
+			norm += v * v
+			sum[i] += v
+			sumSq[i] += v * v
+		}
+		if math.Abs(norm-1) > 1e-12 {
+			t.Fatalf("Mismatch. want: %v, got: %v", 1, norm)
+		}
+	}
+
+	// the coordinates have mean 0 and variance 1/d.
+	for i := range x {
+		checkMean(t, "mean", sum[i], sumSq[i], samples, 0)
+		if v := sumSq[i] / samples; math.Abs(v-0.25) > 0.005 {
+			t.Errorf("Mismatch. want: %v, got: %v", 0.25, v)
+		}
+	}
+}
+
+func TestInBall(t *testing.T) {
+	r := newRand()
+	x := make([]float64, 3)
+	// the fraction of the points in the ball of radius 1/2 is 1/8.
+	inner := 0
+	for s := 0; s < samples; s++ {
+		multivariate.InBall(r, x)
+		norm := x[0]*x[0] + x[1]*x[1] + x[2]*x[2]
+		if norm >= 1 {
+			t.Fatalf("point out of the ball, squared norm: %v", norm)
+		}
+		if norm < 0.25 {
+			inner++
+		}
+	}
+
+	want, sd := samples/8.0, math.Sqrt(samples*(1/8.0)*(7/8.0))
+	if math.Abs(float64(inner)-want) > 5*sd {
+		t.Errorf("Mismatch. want: %v, got: %v", want, inner)
+	}
+}
+
+func TestOnSimplex(t *testing.T) {
+	r := newRand()
+	x := make([]float64, 3)
+	// the first coordinate of a uniform point of the 2-simplex has density 2*(1-x).
+	low := 0
+	for s := 0; s < samples; s++ {
+		multivariate.OnSimplex(r, x)
+		if tot := x[0] + x[1] + x[2]; math.Abs(tot-1) > 1e-12 || x[0] < 0 || x[1] < 0 || x[2] < 0 {
+			t.Fatalf("point out of the simplex: %v", x)
+		}
+		if x[0] < 0.5 {
+			low++
+		}
+	}
+
+	want, sd := samples*0.75, math.Sqrt(samples*0.75*0.25)
+	if math.Abs(float64(low)-want) > 5*sd {
+		t.Errorf("Mismatch. want: %v, got: %v", want, low)
+	}
+}
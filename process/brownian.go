@@ -0,0 +1,84 @@
+package process
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/internal/gaussian"
+)
+
+// Brownian writes into dst a path of the Brownian motion X(t) = x0 + mu*(t-times[0]) + sigma*W(t-times[0]) at
+// the given times: dst[0] = x0 and dst[i] = X(times[i]). The increments are drawn exactly, as normal values
+// of mean mu*dt and variance sigma^2*dt.
+// It panics if the lengths differ, if the times decrease or if sigma < 0.
+func Brownian(r *grand.Rand, times []float64, x0, mu, sigma float64, dst []float64) {
+	if len(times) != len(dst) || !sorted(times) || !(sigma >= 0) {
+		panic("invalid argument to Brownian")
+	}
+	if len(dst) == 0 {
+		return
+	}
+
+	dst[0] = x0
+	gaussian.Fill(r, dst[1:])
+	for i := 1; i < len(dst); i++ {
+		dt := times[i] - times[i-1]
+		dst[i] = dst[i-1] + mu*dt + sigma*math.Sqrt(dt)*dst[i]
+	}
+}
+
+// GeometricBrownian writes into dst a path of the geometric Brownian motion dS = mu*S*dt + sigma*S*dW at the
+// given times, from dst[0] = s0: S(t) = S(s)*exp((mu - sigma^2/2)*(t-s) + sigma*(W(t)-W(s))), exactly.
+// It panics if the lengths differ, if the times decrease or if sigma < 0.
+func GeometricBrownian(r *grand.Rand, times []float64, s0, mu, sigma float64, dst []float64) {
+	if len(times) != len(dst) || !sorted(times) || !(sigma >= 0) {
+		panic("invalid argument to GeometricBrownian")
+	}
+
+	Brownian(r, times, 0, mu-sigma*sigma/2, sigma, dst)
+	for i := range dst {
+		dst[i] = s0 * math.Exp(dst[i])
+	}
+}
+
+// Bridge writes into dst the values at the given times, in (t0,t1), of a Brownian motion of volatility sigma
+// conditioned on X(t0) = x0 and X(t1) = x1 (a Brownian bridge, whatever the drift). Each value is drawn given
+// the previous one, as a normal value of mean x + (t-s)/(t1-s)*(x1-x) and variance sigma^2*(t-s)*(t1-t)/(t1-s).
+// Called on each interval of a coarse path, it refines the path to a finer grid with the same law.
+// It panics if the lengths differ, or if the times decrease or are not in [t0,t1].
+func Bridge(r *grand.Rand, t0, x0, t1, x1, sigma float64, times, dst []float64) {
+	if len(times) != len(dst) || !sorted(times) || !(sigma >= 0) || !(t0 < t1) {
+		panic("invalid argument to Bridge")
+	}
+	if len(times) > 0 && (times[0] < t0 || times[len(times)-1] > t1) {
+		panic("invalid argument to Bridge")
+	}
+
+	gaussian.Fill(r, dst)
+	s, x := t0, x0
+	for i, t := range times {
+		if t == t1 {
+			dst[i] = x1
+			continue
+		}
+
+		mean := x + (t-s)/(t1-s)*(x1-x)
+		sd := sigma * math.Sqrt((t-s)*(t1-t)/(t1-s))
+		dst[i] = mean + sd*dst[i]
+		s, x = t, dst[i]
+	}
+}
+
+// GeometricBridge writes into dst the values at the given times, in (t0,t1), of a geometric Brownian motion of
+// volatility sigma conditioned on S(t0) = s0 and S(t1) = s1: the Bridge of its logarithm.
+// It panics if s0 or s1 is not positive, and as Bridge does.
+func GeometricBridge(r *grand.Rand, t0, s0, t1, s1, sigma float64, times, dst []float64) {
+	if !(s0 > 0 && s1 > 0) {
+		panic("invalid argument to GeometricBridge")
+	}
+
+	Bridge(r, t0, math.Log(s0), t1, math.Log(s1), sigma, times, dst)
+	for i := range dst {
+		dst[i] = math.Exp(dst[i])
+	}
+}
@@ -0,0 +1,64 @@
+package process_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/process"
+)
+
+func TestBrownian(t *testing.T) {
+	r := newRand()
+	times := []float64{0, 0.5, 1, 3}
+	x := make([]float64, len(times))
+
+	// X(3) is normal of mean 1 + 0.3*3 and variance 4*3, and the increments are independent.
+	next := func() { process.Brownian(r, times, 1, 0.3, 2, x) }
+	checkMean(t, "mean", func() float64 { next(); return x[3] }, 1.9)
+	checkMean(t, "variance", func() float64 { next(); return (x[3] - 1.9) * (x[3] - 1.9) }, 12)
+	checkMean(t, "covariance", func() float64 { next(); return (x[1] - 1.15) * (x[2] - x[1] - 0.15) }, 0)
+	if x[0] != 1 {
+		t.Errorf("Mismatch. want: %v, got: %v", 1, x[0])
+	}
+}
+
+func TestGeometricBrownian(t *testing.T) {
+	r := newRand()
+	times := []float64{0, 0.25, 1}
+	s := make([]float64, len(times))
+
+	next := func() { process.GeometricBrownian(r, times, 100, 0.05, 0.2, s) }
+	checkMean(t, "mean", func() float64 { next(); return s[2] }, 100*math.Exp(0.05))
+	checkMean(t, "log mean", func() float64 { next(); return math.Log(s[2] / 100) }, 0.05-0.02)
+	checkMean(t, "log variance", func() float64 { next(); l := math.Log(s[1]/100) - 0.03/4; return l * l }, 0.04/4)
+}
+
+func TestBridge(t *testing.T) {
+	r := newRand()
+	times := []float64{0.25, 0.5, 1}
+	x := make([]float64, len(times))
+
+	// the bridge from (0, 0) to (1, 2) has mean 2t and covariance s(1-t), s <= t.
+	next := func() { process.Bridge(r, 0, 0, 1, 2, 1, times, x) }
+	checkMean(t, "mean", func() float64 { next(); return x[1] }, 1)
+	checkMean(t, "variance", func() float64 { next(); return (x[0] - 0.5) * (x[0] - 0.5) }, 0.1875)
+	checkMean(t, "covariance", func() float64 { next(); return (x[0] - 0.5) * (x[1] - 1) }, 0.125)
+	if x[2] != 2 {
+		t.Errorf("Mismatch. want: %v, got: %v", 2, x[2])
+	}
+
+	// refining a coarse path gives the law of a fine one: the variance of X(1) - X(0.5) is sigma^2/2.
+	coarse := []float64{0, 2}
+	fine := make([]float64, 1)
+	checkMean(t, "refined increment", func() float64 {
+		process.Brownian(r, coarse, 0, 0, 3, x[:2])
+		process.Bridge(r, 0, x[0], 2, x[1], 3, []float64{1.5}, fine)
+		return (x[1] - fine[0]) * (x[1] - fine[0])
+	}, 9*0.5)
+
+	s := make([]float64, 1)
+	checkMean(t, "geometric", func() float64 {
+		process.GeometricBridge(r, 0, 1, 1, math.E, 1, []float64{0.5}, s)
+		return math.Log(s[0])
+	}, 0.5)
+}
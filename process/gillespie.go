@@ -0,0 +1,146 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// Reaction is a reaction of a chemical reaction network of mass-action kinetics: the species of Reactants
+// (listed once per molecule, e.g. {0, 0} for 2A) are consumed and those of Products are produced. Its
+// propensity is Rate times the number of combinations of reactant molecules, e.g. Rate*x0*(x0-1)/2 for 2A.
+type Reaction struct {
+	Rate      float64
+	Reactants []int
+	Products  []int
+}
+
+// Gillespie simulates a chemical reaction network with the direct method of Gillespie's stochastic
+// simulation algorithm (SSA): the time to the next reaction is exponential of rate the sum of the propensities,
+// and the reaction is drawn in proportion to its propensity.
+//
+// Daniel T. Gillespie, Exact stochastic simulation of coupled chemical reactions.
+// The Journal of Physical Chemistry, 1977, 81(25), 2340--2361.
+type Gillespie struct {
+	species    int
+	reactions  []Reaction
+	propensity []float64
+}
+
+// NewGillespie returns the simulation of the reactions between the species [0,species). The reactions are copied.
+func NewGillespie(species int, reactions []Reaction) (*Gillespie, error) {
+	if species <= 0 {
+		return nil, errors.New("The number of species must be positive")
+	}
+	if len(reactions) == 0 {
+		return nil, errors.New("The reactions cannot be empty")
+	}
+
+	ans := &Gillespie{species: species, reactions: make([]Reaction, len(reactions)), propensity: make([]float64, len(reactions))}
+	for k, rc := range reactions {
+		if !(rc.Rate >= 0) || math.IsInf(rc.Rate, 1) {
+			return nil, fmt.Errorf("The rate of reaction %d must be non-negative and finite", k)
+		}
+		for _, s := range append(append([]int{}, rc.Reactants...), rc.Products...) {
+			if s < 0 || s >= species {
+				return nil, fmt.Errorf("The species of reaction %d must be in [0, %d)", k, species)
+			}
+		}
+
+		ans.reactions[k] = Reaction{
+			Rate:      rc.Rate,
+			Reactants: append([]int{}, rc.Reactants...),
+			Products:  append([]int{}, rc.Products...),
+		}
+	}
+
+	return ans, nil
+}
+
+// Species returns the number of species.
+func (g *Gillespie) Species() int {
+	return g.species
+}
+
+// Returns the propensity of the reaction in the state x: Rate times the product of the binomial coefficients
+// C(x[s], m) of the species s of multiplicity m in the reactants.
+func (g *Gillespie) propensityOf(rc *Reaction, x []int) float64 {
+	a := rc.Rate
+	for i, s := range rc.Reactants {
+		// the k-th molecule of s (k counted from 0) contributes (x[s]-k)/(k+1).
+		k := 0
+		for _, t := range rc.Reactants[:i] {
+			if t == s {
+				k++
+			}
+		}
+		if x[s] <= k {
+			return 0
+		}
+		a *= float64(x[s]-k) / float64(k+1)
+	}
+
+	return a
+}
+
+// Simulate runs the network from the counts of molecules x, which it updates in place, from time 0 until the
+// horizon or until no reaction can fire, and returns the number of reactions fired. If record is not nil, it is
+// called after each reaction with its time, the new counts (which must not be modified) and the reaction index.
+// The Gillespie must not be used by several goroutines at the same time.
+// It panics if len(x) != Species(), if a count is negative or if horizon < 0.
+func (g *Gillespie) Simulate(r *grand.Rand, x []int, horizon float64, record func(t float64, x []int, reaction int)) int {
+	if len(x) != g.species || !(horizon >= 0) {
+		panic("invalid argument to Gillespie.Simulate")
+	}
+	for _, c := range x {
+		if c < 0 {
+			panic("invalid argument to Gillespie.Simulate")
+		}
+	}
+
+	t, fired := 0.0, 0
+	for {
+		total := 0.0
+		last := -1
+		for k := range g.reactions {
+			g.propensity[k] = g.propensityOf(&g.reactions[k], x)
+			if g.propensity[k] > 0 {
+				total += g.propensity[k]
+				last = k
+			}
+		}
+		if total == 0 {
+			return fired
+		}
+
+		if t += exponential(r) / total; t >= horizon {
+			return fired
+		}
+
+		// the rounding of the partial sums may leave u above all of them: it then falls to the last
+		// reaction of positive propensity.
+		k := last
+		u := r.Float64() * total
+		for j, a := range g.propensity[:last] {
+			if u < a {
+				k = j
+				break
+			}
+			u -= a
+		}
+
+		rc := &g.reactions[k]
+		for _, s := range rc.Reactants {
+			x[s]--
+		}
+		for _, s := range rc.Products {
+			x[s]++
+		}
+		fired++
+		if record != nil {
+			record(t, x, k)
+		}
+	}
+}
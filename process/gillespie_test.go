@@ -0,0 +1,62 @@
+package process_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/process"
+)
+
+func TestGillespie(t *testing.T) {
+	r := newRand()
+
+	// birth and death: 0 -> X at rate 10, X -> 0 at rate x. X(t) is Poisson of mean 10(1-exp(-t)) from 0.
+	bd, err := process.NewGillespie(1, []process.Reaction{
+		{Rate: 10, Products: []int{0}},
+		{Rate: 1, Reactants: []int{0}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x := make([]int, 1)
+	mean := 10 * (1 - math.Exp(-1))
+	checkMean(t, "birth and death", func() float64 {
+		x[0] = 0
+		bd.Simulate(r, x, 1, nil)
+		return float64(x[0])
+	}, mean)
+	checkMean(t, "birth and death variance", func() float64 {
+		x[0] = 0
+		bd.Simulate(r, x, 1, nil)
+		return (float64(x[0]) - mean) * (float64(x[0]) - mean)
+	}, mean)
+
+	// dimerization 2A -> B: the propensity of 10 A is 45, and A + 2B is conserved.
+	dim, err := process.NewGillespie(2, []process.Reaction{{Rate: 1, Reactants: []int{0, 0}, Products: []int{1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := make([]int, 2)
+	checkMean(t, "first reaction", func() float64 {
+		y[0], y[1] = 10, 0
+		first := math.Inf(1)
+		fired := dim.Simulate(r, y, math.Inf(1), func(tm float64, x []int, k int) {
+			if x[0]+2*x[1] != 10 || k != 0 {
+				t.Fatalf("invalid reaction %d: %v", k, x)
+			}
+			first = math.Min(first, tm)
+		})
+		if fired != 5 || y[0] != 0 || y[1] != 5 {
+			t.Fatalf("Mismatch. want: %v, got: %v", 5, fired)
+		}
+		return first
+	}, 1.0/45)
+
+	if _, err := process.NewGillespie(1, []process.Reaction{{Rate: 1, Reactants: []int{1}}}); err == nil {
+		t.Errorf("NewGillespie should fail on unknown species")
+	}
+	if _, err := process.NewGillespie(1, []process.Reaction{{Rate: -1}}); err == nil {
+		t.Errorf("NewGillespie should fail on negative rates")
+	}
+}
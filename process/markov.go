@@ -0,0 +1,194 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/jtejido/grand"
+)
+
+const (
+	// The tolerance on the sums of the rows of the transition and generator matrices.
+	row_tolerance = 1e-9
+)
+
+// DTMC is a discrete-time Markov chain on the states [0,n), of transition matrix P: P[i*n+j] is the
+// probability of a step from i to j.
+type DTMC struct {
+	n   int
+	cum []float64
+}
+
+// NewDTMC returns the Markov chain of the n*n transition matrix p (row-major), whose rows must be
+// probability distributions (up to rounding). p is copied.
+func NewDTMC(p []float64, n int) (*DTMC, error) {
+	if n <= 0 {
+		return nil, errors.New("The number of states must be positive")
+	}
+	if len(p) != n*n {
+		return nil, fmt.Errorf("The transition matrix must have %d values", n*n)
+	}
+
+	// each row is kept as its partial sums, scaled to end at exactly 1.
+	cum := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		sum := 0.0
+		for j, x := range p[i*n : i*n+n] {
+			if !(x >= 0) {
+				return nil, fmt.Errorf("The transition probabilities of state %d must be non-negative", i)
+			}
+			sum += x
+			cum[i*n+j] = sum
+		}
+		if math.Abs(sum-1) > row_tolerance {
+			return nil, fmt.Errorf("The transition probabilities of state %d must sum to 1", i)
+		}
+		for j := range cum[i*n : i*n+n] {
+			cum[i*n+j] /= sum
+		}
+	}
+
+	return &DTMC{n: n, cum: cum}, nil
+}
+
+// States returns the number of states of the chain.
+func (mc *DTMC) States() int {
+	return mc.n
+}
+
+// Next returns the state following state.
+// It panics if the state is not in [0,States()).
+func (mc *DTMC) Next(r *grand.Rand, state int) int {
+	if state < 0 || state >= mc.n {
+		panic("invalid argument to DTMC.Next")
+	}
+
+	return pick(mc.cum[state*mc.n:(state+1)*mc.n], r.Float64())
+}
+
+// Path writes into dst a path of the chain, from dst[0] = x0.
+// It panics if x0 is not in [0,States()).
+func (mc *DTMC) Path(r *grand.Rand, x0 int, dst []int) {
+	if x0 < 0 || x0 >= mc.n {
+		panic("invalid argument to DTMC.Path")
+	}
+
+	for i := range dst {
+		if i == 0 {
+			dst[0] = x0
+			continue
+		}
+		dst[i] = mc.Next(r, dst[i-1])
+	}
+}
+
+// Returns the first index whose partial sum exceeds u in [0,1), skipping the entries of probability 0.
+func pick(cum []float64, u float64) int {
+	j := sort.Search(len(cum), func(j int) bool { return cum[j] > u })
+	if j == len(cum) {
+		// u is above the rounded last sum: the last state of positive probability.
+		j--
+		for j > 0 && cum[j] == cum[j-1] {
+			j--
+		}
+	}
+
+	return j
+}
+
+// CTMC is a continuous-time Markov chain on the states [0,n), of generator matrix Q: Q[i*n+j], i != j, is the
+// rate of the jumps from i to j, and Q[i*n+i] = -sum of the other rates of row i.
+type CTMC struct {
+	n     int
+	rates []float64
+	jump  *DTMC
+}
+
+// NewCTMC returns the Markov chain of the n*n generator matrix q (row-major), whose off-diagonal values must be
+// non-negative and whose rows must sum to 0 (up to rounding). A state of rate 0 is absorbing. q is copied.
+func NewCTMC(q []float64, n int) (*CTMC, error) {
+	if n <= 0 {
+		return nil, errors.New("The number of states must be positive")
+	}
+	if len(q) != n*n {
+		return nil, fmt.Errorf("The generator matrix must have %d values", n*n)
+	}
+
+	// the jump chain moves from i to j != i with probability q[i*n+j]/rates[i], and stays in an absorbing state.
+	rates := make([]float64, n)
+	p := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j, x := range q[i*n : i*n+n] {
+			if j != i {
+				if !(x >= 0) || math.IsInf(x, 1) {
+					return nil, fmt.Errorf("The rates of state %d must be non-negative and finite", i)
+				}
+				rates[i] += x
+			}
+		}
+		if math.Abs(rates[i]+q[i*n+i]) > row_tolerance*math.Max(1, rates[i]) {
+			return nil, fmt.Errorf("The rates of state %d must sum to 0", i)
+		}
+
+		if rates[i] == 0 {
+			p[i*n+i] = 1
+			continue
+		}
+		for j, x := range q[i*n : i*n+n] {
+			if j != i {
+				p[i*n+j] = x / rates[i]
+			}
+		}
+	}
+
+	jump, err := NewDTMC(p, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CTMC{n: n, rates: rates, jump: jump}, nil
+}
+
+// States returns the number of states of the chain.
+func (mc *CTMC) States() int {
+	return mc.n
+}
+
+// Next returns the state following state, and the holding time in state before the jump, exponential
+// of rate -Q[state*n+state]. An absorbing state is held for an infinite time.
+// It panics if the state is not in [0,States()).
+func (mc *CTMC) Next(r *grand.Rand, state int) (next int, holding float64) {
+	if state < 0 || state >= mc.n {
+		panic("invalid argument to CTMC.Next")
+	}
+	if mc.rates[state] == 0 {
+		return state, math.Inf(1)
+	}
+
+	holding = exponential(r) / mc.rates[state]
+	return mc.jump.Next(r, state), holding
+}
+
+// Path appends to states[:0] and times[:0] the states of a path of the chain from x0 at time 0 and the times
+// they are entered, until the horizon (or an absorbing state), and returns the extended slices.
+// It panics if x0 is not in [0,States()) or horizon < 0.
+func (mc *CTMC) Path(r *grand.Rand, x0 int, horizon float64, states []int, times []float64) ([]int, []float64) {
+	if x0 < 0 || x0 >= mc.n || !(horizon >= 0) {
+		panic("invalid argument to CTMC.Path")
+	}
+
+	states, times = append(states[:0], x0), append(times[:0], 0)
+	t, x := 0.0, x0
+	for {
+		next, holding := mc.Next(r, x)
+		if t += holding; t >= horizon {
+			return states, times
+		}
+
+		x = next
+		states = append(states, x)
+		times = append(times, t)
+	}
+}
@@ -0,0 +1,85 @@
+package process_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/process"
+)
+
+func TestDTMC(t *testing.T) {
+	r := newRand()
+	p := []float64{
+		0.5, 0.5, 0,
+		0.2, 0.3, 0.5,
+		0, 0.6, 0.4,
+	}
+	mc, err := process.NewDTMC(p, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// P^2[0] = {0.35, 0.4, 0.25}.
+	path := make([]int, 3)
+	for j, want := range []float64{0.35, 0.4, 0.25} {
+		j := j
+		checkFrequency(t, "two steps", func() bool { mc.Path(r, 0, path); return path[2] == j }, want)
+	}
+	for i := 0; i < 1000; i++ {
+		if mc.Next(r, 0) == 2 || mc.Next(r, 2) == 0 {
+			t.Fatalf("a transition of probability 0 was drawn")
+		}
+	}
+
+	for _, c := range []struct {
+		p []float64
+		n int
+	}{{p[:8], 3}, {[]float64{0.5, 0.6, 1, 0}, 2}, {[]float64{1.5, -0.5, 0, 1}, 2}, {nil, 0}} {
+		if _, err := process.NewDTMC(c.p, c.n); err == nil {
+			t.Errorf("NewDTMC(%v, %d) should fail", c.p, c.n)
+		}
+	}
+}
+
+func TestCTMC(t *testing.T) {
+	r := newRand()
+	const a, b = 1.0, 2.0
+	mc, err := process.NewCTMC([]float64{-a, a, b, -b}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// P(X(t) = 0 | X(0) = 0) = b/(a+b) + a/(a+b)*exp(-(a+b)t).
+	var states []int
+	var times []float64
+	checkFrequency(t, "transition", func() bool {
+		states, times = mc.Path(r, 0, 0.5, states, times)
+		return states[len(states)-1] == 0
+	}, b/(a+b)+a/(a+b)*math.Exp(-(a+b)*0.5))
+	for i := 1; i < len(times); i++ {
+		if times[i] <= times[i-1] || times[i] >= 0.5 || states[i] == states[i-1] {
+			t.Fatalf("invalid path: %v %v", states, times)
+		}
+	}
+	checkMean(t, "holding", func() float64 { _, h := mc.Next(r, 1); return h }, 1/b)
+
+	// state 2 is absorbing.
+	mc, err = process.NewCTMC([]float64{-1, 0.5, 0.5, 1, -1, 0, 0, 0, 0}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next, h := mc.Next(r, 2); next != 2 || !math.IsInf(h, 1) {
+		t.Errorf("Mismatch. want: %v, got: %v, %v", 2, next, h)
+	}
+	states, _ = mc.Path(r, 0, math.Inf(1), states, times)
+	if states[len(states)-1] != 2 {
+		t.Errorf("Mismatch. want: %v, got: %v", 2, states[len(states)-1])
+	}
+
+	if _, err := process.NewCTMC([]float64{-1, 2, 1, -1}, 2); err == nil {
+		t.Errorf("NewCTMC should fail if the rows do not sum to 0")
+	}
+	if _, err := process.NewCTMC([]float64{1, -1, 1, -1}, 2); err == nil {
+		t.Errorf("NewCTMC should fail on negative rates")
+	}
+}
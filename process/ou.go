@@ -0,0 +1,30 @@
+package process
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/internal/gaussian"
+)
+
+// OrnsteinUhlenbeck writes into dst a path of the Ornstein-Uhlenbeck process dX = theta*(mu - X)*dt + sigma*dW
+// at the given times, from dst[0] = x0. The transitions are drawn exactly: X(t+dt) is normal, of mean
+// mu + (X(t)-mu)*exp(-theta*dt) and variance sigma^2*(1 - exp(-2*theta*dt))/(2*theta).
+// It panics if the lengths differ, if the times decrease, if theta <= 0 or if sigma < 0.
+func OrnsteinUhlenbeck(r *grand.Rand, times []float64, x0, theta, mu, sigma float64, dst []float64) {
+	if len(times) != len(dst) || !sorted(times) || !(theta > 0) || !(sigma >= 0) {
+		panic("invalid argument to OrnsteinUhlenbeck")
+	}
+	if len(dst) == 0 {
+		return
+	}
+
+	dst[0] = x0
+	gaussian.Fill(r, dst[1:])
+	for i := 1; i < len(dst); i++ {
+		dt := times[i] - times[i-1]
+		decay := math.Exp(-theta * dt)
+		sd := sigma * math.Sqrt(-math.Expm1(-2*theta*dt)/(2*theta))
+		dst[i] = mu + (dst[i-1]-mu)*decay + sd*dst[i]
+	}
+}
@@ -0,0 +1,24 @@
+package process_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/process"
+)
+
+func TestOrnsteinUhlenbeck(t *testing.T) {
+	r := newRand()
+	times := []float64{0, 0.7, 3}
+	x := make([]float64, len(times))
+
+	const x0, theta, mu, sigma = 5, 2, 1, 0.5
+	next := func() { process.OrnsteinUhlenbeck(r, times, x0, theta, mu, sigma, x) }
+	for i, dt := range []float64{0.7, 3} {
+		i, dt := i+1, dt
+		mean := mu + (x0-mu)*math.Exp(-theta*dt)
+		variance := sigma * sigma * (1 - math.Exp(-2*theta*dt)) / (2 * theta)
+		checkMean(t, "mean", func() float64 { next(); return x[i] }, mean)
+		checkMean(t, "variance", func() float64 { next(); return (x[i] - mean) * (x[i] - mean) }, variance)
+	}
+}
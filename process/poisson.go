@@ -0,0 +1,76 @@
+package process
+
+import (
+	"github.com/jtejido/grand"
+)
+
+// Poisson appends to dst[:0] the arrival times in [0,horizon) of a homogeneous Poisson process of the given
+// rate, drawn from exponential gaps of mean 1/rate, and returns the extended slice.
+// It panics if rate < 0 or horizon < 0.
+func Poisson(r *grand.Rand, rate, horizon float64, dst []float64) []float64 {
+	if !(rate >= 0) || !(horizon >= 0) {
+		panic("invalid argument to Poisson")
+	}
+
+	dst = dst[:0]
+	if rate == 0 {
+		return dst
+	}
+	for t := exponential(r) / rate; t < horizon; t += exponential(r) / rate {
+		dst = append(dst, t)
+	}
+
+	return dst
+}
+
+// NonHomogeneousPoisson appends to dst[:0] the arrival times in [0,horizon) of a Poisson process of intensity
+// rate(t) <= max, and returns the extended slice. The arrivals of a homogeneous process of rate max are kept
+// with probability rate(t)/max (thinning).
+// It panics if max < 0 or horizon < 0, or if rate(t) is found outside [0,max].
+//
+// P. A. W. Lewis, G. S. Shedler, Simulation of nonhomogeneous Poisson processes by thinning.
+// Naval Research Logistics Quarterly, 1979, 26(3), 403--413.
+func NonHomogeneousPoisson(r *grand.Rand, rate func(t float64) float64, max, horizon float64, dst []float64) []float64 {
+	if !(max >= 0) || !(horizon >= 0) {
+		panic("invalid argument to NonHomogeneousPoisson")
+	}
+
+	dst = dst[:0]
+	if max == 0 {
+		return dst
+	}
+	for t := exponential(r) / max; t < horizon; t += exponential(r) / max {
+		lambda := rate(t)
+		if !(lambda >= 0 && lambda <= max) {
+			panic("invalid argument to NonHomogeneousPoisson")
+		}
+		if r.Float64()*max < lambda {
+			dst = append(dst, t)
+		}
+	}
+
+	return dst
+}
+
+// CompoundPoisson appends to times[:0] the arrival times in [0,horizon) of a Poisson process of the given rate,
+// and to values[:0] the compound process at these times, the sums of the jumps drawn by jump at each arrival.
+// It returns the extended slices.
+// It panics if rate < 0 or horizon < 0.
+func CompoundPoisson(r *grand.Rand, rate, horizon float64, jump func(r *grand.Rand) float64, times, values []float64) ([]float64, []float64) {
+	if !(rate >= 0) || !(horizon >= 0) {
+		panic("invalid argument to CompoundPoisson")
+	}
+
+	times, values = times[:0], values[:0]
+	if rate == 0 {
+		return times, values
+	}
+	sum := 0.0
+	for t := exponential(r) / rate; t < horizon; t += exponential(r) / rate {
+		sum += jump(r)
+		times = append(times, t)
+		values = append(values, sum)
+	}
+
+	return times, values
+}
@@ -0,0 +1,79 @@
+package process_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/process"
+)
+
+func TestPoisson(t *testing.T) {
+	r := newRand()
+	var arrivals []float64
+
+	// N(2) is Poisson of mean 6, and the arrivals are uniform on [0, 2) given their number:
+	// the first one is exponential, truncated to [0, 2).
+	checkMean(t, "count", func() float64 {
+		arrivals = process.Poisson(r, 3, 2, arrivals)
+		for i, a := range arrivals {
+			if a < 0 || a >= 2 || (i > 0 && a < arrivals[i-1]) {
+				t.Fatalf("invalid arrivals: %v", arrivals)
+			}
+		}
+		return float64(len(arrivals))
+	}, 6)
+	checkMean(t, "count variance", func() float64 {
+		n := float64(len(process.Poisson(r, 3, 2, arrivals))) - 6
+		return n * n
+	}, 6)
+	checkMean(t, "arrival", func() float64 {
+		for {
+			if arrivals = process.Poisson(r, 3, 2, arrivals); len(arrivals) > 0 {
+				return arrivals[0]
+			}
+		}
+	}, (1-7*math.Exp(-6))/(3*(1-math.Exp(-6))))
+
+	if got := process.Poisson(r, 0, 10, arrivals); len(got) != 0 {
+		t.Errorf("Mismatch. want: %v, got: %v", 0, len(got))
+	}
+}
+
+func TestNonHomogeneousPoisson(t *testing.T) {
+	r := newRand()
+	var arrivals []float64
+
+	// the intensity 2t on [0, 3) gives 9 arrivals on average, of density 2t/9: their mean is 2.
+	rate := func(t float64) float64 { return 2 * t }
+	total, count := 0.0, 0
+	checkMean(t, "count", func() float64 {
+		arrivals = process.NonHomogeneousPoisson(r, rate, 6, 3, arrivals)
+		for _, a := range arrivals {
+			total += a
+		}
+		count += len(arrivals)
+		return float64(len(arrivals))
+	}, 9)
+	if mean := total / float64(count); mean < 1.99 || mean > 2.01 {
+		t.Errorf("Mismatch. want: %v, got: %v", 2, mean)
+	}
+}
+
+func TestCompoundPoisson(t *testing.T) {
+	r := newRand()
+	var times, values []float64
+
+	// uniform jumps: the mean of the process at 2 is 3*2*0.5.
+	jump := func(r *grand.Rand) float64 { return r.Float64() }
+	checkMean(t, "value", func() float64 {
+		times, values = process.CompoundPoisson(r, 3, 2, jump, times, values)
+		if len(times) != len(values) {
+			t.Fatalf("Mismatch. want: %v, got: %v", len(times), len(values))
+		}
+		if len(values) == 0 {
+			return 0
+		}
+		return values[len(values)-1]
+	}, 3)
+}
@@ -0,0 +1,43 @@
+// Package process simulates stochastic processes over grand.Rand: Brownian motion, geometric Brownian
+// motion and their bridges, the Ornstein-Uhlenbeck process, homogeneous, non-homogeneous and compound
+// Poisson processes, discrete- and continuous-time Markov chains, and chemical reaction networks with
+// Gillespie's stochastic simulation algorithm.
+//
+// The paths are written into slices provided by the caller (or appended to them, when their length is
+// random), so that one buffer can be reused for every path. Paths runs each path on its own substream of a
+// JumpableRand, so that a path can be replayed alone, whatever the number of values the others drew.
+package process
+
+import (
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// Paths runs n paths of a simulation, path i drawing from the i-th substream of r from its current one
+// (the stream jumped i times). The i-th path can thus be replayed alone from a new source, jumped i
+// times. r is left at the start of the n-th substream, for the next batch of paths.
+func Paths(r *grand.JumpableRand, n int, path func(i int, r *grand.Rand)) {
+	for i := 0; i < n; i++ {
+		r.RestartSubstream()
+		path(i, &r.Rand)
+		r.Jump()
+	}
+	r.RestartSubstream()
+}
+
+// Returns a standard exponential value.
+func exponential(r *grand.Rand) float64 {
+	return -math.Log(r.Float64Open())
+}
+
+// Reports whether the times are non-decreasing.
+func sorted(times []float64) bool {
+	for i := 1; i < len(times); i++ {
+		if !(times[i] >= times[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,120 @@
+package process_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/process"
+	"github.com/jtejido/grand/source32"
+	"github.com/jtejido/grand/source64"
+)
+
+const samples = 100000
+
+func newRand() *grand.Rand {
+	return grand.New(source64.NewSplitMix64(1))
+}
+
+// checkMean fails the test if the mean of samples values of next is farther than 5 standard errors from want.
+func checkMean(t *testing.T, what string, next func() float64, want float64) {
+	t.Helper()
+
+	sum, sumSq := 0.0, 0.0
+	for i := 0; i < samples; i++ {
+		x := next()
+		sum += x
+		sumSq += x * x
+	}
+
+	mean := sum / samples
+	se := math.Sqrt((sumSq/samples - mean*mean) / samples)
+	if math.Abs(mean-want) > 5*se+1e-12 {
+		t.Errorf("%s: Mismatch. want: %v, got: %v (standard error %v)", what, want, mean, se)
+	}
+}
+
+// checkFrequency fails the test if the frequency of the event is farther than 5 standard errors from p.
+func checkFrequency(t *testing.T, what string, event func() bool, p float64) {
+	t.Helper()
+
+	checkMean(t, what, func() float64 {
+		if event() {
+			return 1
+		}
+		return 0
+	}, p)
+}
+
+func TestPaths(t *testing.T) {
+	r := grand.NewJumpable(source32.NewMRG32k3A(12345))
+
+	// path i draws i+1 values: the first one only depends on the substream.
+	first := make([]uint64, 8)
+	process.Paths(r, 5, func(i int, r *grand.Rand) {
+		first[i] = r.Uint64()
+		for k := 0; k < i; k++ {
+			r.Uint64()
+		}
+	})
+	process.Paths(r, 3, func(i int, r *grand.Rand) {
+		first[5+i] = r.Uint64()
+	})
+
+	for i, got := range first {
+		src := source32.NewMRG32k3A(12345)
+		for k := 0; k < i; k++ {
+			src.Jump()
+		}
+		if want := grand.New(src).Uint64(); got != want {
+			t.Errorf("path %d: Mismatch. want: %v, got: %v", i, want, got)
+		}
+	}
+
+	// a path replayed alone.
+	times := []float64{0, 1, 2, 3}
+	all := make([][]float64, 4)
+	r = grand.NewJumpable(source32.NewMRG32k3A(12345))
+	process.Paths(r, 4, func(i int, r *grand.Rand) {
+		all[i] = make([]float64, len(times))
+		process.Brownian(r, times, 0, 0, 1, all[i])
+	})
+
+	src := source32.NewMRG32k3A(12345)
+	src.Jump()
+	src.Jump()
+	path := make([]float64, len(times))
+	process.Brownian(grand.New(src), times, 0, 0, 1, path)
+	for k := range path {
+		if path[k] != all[2][k] {
+			t.Errorf("Mismatch. want: %v, got: %v", all[2][k], path[k])
+		}
+	}
+}
+
+func TestPanics(t *testing.T) {
+	r := newRand()
+	times, dst := []float64{0, 1}, make([]float64, 2)
+	cases := map[string]func(){
+		"Brownian(lengths)":     func() { process.Brownian(r, times, 0, 0, 1, dst[:1]) },
+		"Brownian(times)":       func() { process.Brownian(r, []float64{1, 0}, 0, 0, 1, dst) },
+		"GeometricBrownian":     func() { process.GeometricBrownian(r, times, 1, 0, -1, dst) },
+		"Bridge":                func() { process.Bridge(r, 0, 0, 1, 0, 1, []float64{0.5, 2}, dst) },
+		"GeometricBridge":       func() { process.GeometricBridge(r, 0, 0, 1, 1, 1, times, dst) },
+		"OrnsteinUhlenbeck":     func() { process.OrnsteinUhlenbeck(r, times, 0, 0, 0, 1, dst) },
+		"Poisson":               func() { process.Poisson(r, -1, 1, nil) },
+		"NonHomogeneousPoisson": func() { process.NonHomogeneousPoisson(r, func(float64) float64 { return 2 }, 1, 10, nil) },
+		"CompoundPoisson":       func() { process.CompoundPoisson(r, 1, -1, nil, nil, nil) },
+	}
+
+	for name, f := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s should panic", name)
+				}
+			}()
+			f()
+		})
+	}
+}
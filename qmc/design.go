@@ -0,0 +1,137 @@
+package qmc
+
+import (
+	"errors"
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// A sampling design of n points, drawn at once and stored (row-major, n*dim values).
+// The sequence repeats after n points.
+type design struct {
+	dim, n int
+	points []float64
+	index  int
+}
+
+func (d *design) Dim() int {
+	return d.dim
+}
+
+func (d *design) Restart() {
+	d.index = 0
+}
+
+func (d *design) Skip(n uint64) {
+	d.index = int((uint64(d.index) + n%uint64(d.n)) % uint64(d.n))
+}
+
+func (d *design) Next(dst []float64) {
+	if len(dst) != d.dim {
+		panic("invalid argument to Next")
+	}
+
+	copy(dst, d.points[d.index*d.dim:(d.index+1)*d.dim])
+	d.index++
+	if d.index == d.n {
+		d.index = 0
+	}
+}
+
+// Implements Latin hypercube sampling: n points of [0,1)^dim such that each of the n intervals
+// [i/n, (i+1)/n) holds one point in every coordinate. The coordinate j of the point i is
+// (pi_j(i) + U)/n, for independent random permutations pi_j and uniform values U.
+//
+// M. D. McKay, R. J. Beckman and W. J. Conover, A Comparison of Three Methods for Selecting Values of
+// Input Variables in the Analysis of Output from a Computer Code. Technometrics, 1979, 21(2), 239--245.
+type LatinHypercube struct {
+	design
+	// the midpoints of the intervals instead of uniform values.
+	centered bool
+}
+
+// NewLatinHypercube returns a Latin hypercube design of n points of dimension dim, drawn from src.
+// If centered, the points are the midpoints (pi_j(i) + 1/2)/n of their intervals.
+func NewLatinHypercube(n, dim int, centered bool, src grand.Source) (*LatinHypercube, error) {
+	if n <= 0 || dim <= 0 {
+		return nil, errors.New("The number of points and the dimension must be positive")
+	}
+	if src == nil {
+		return nil, errors.New("The design needs a source")
+	}
+
+	ans := &LatinHypercube{design: design{dim: dim, n: n, points: make([]float64, n*dim)}, centered: centered}
+	ans.Randomize(src)
+	return ans, nil
+}
+
+// Randomize draws a new design from src and restarts the sequence.
+func (lh *LatinHypercube) Randomize(src grand.Source) {
+	r := grand.New(src)
+	for j := 0; j < lh.dim; j++ {
+		// the shuffle of the interval numbers, one per point.
+		for i := 0; i < lh.n; i++ {
+			k := r.Intn(i + 1)
+			lh.points[i*lh.dim+j] = lh.points[k*lh.dim+j]
+			lh.points[k*lh.dim+j] = float64(i)
+		}
+	}
+
+	for i, x := range lh.points {
+		u := 0.5
+		if !lh.centered {
+			u = r.Float64()
+		}
+		// (n-1 + U)/n may round up to 1.
+		lh.points[i] = math.Min((x+u)/float64(lh.n), 1-0x1p-53)
+	}
+
+	lh.Restart()
+}
+
+// Implements stratified sampling on a grid: the unit cube is split into strata[j] intervals of equal
+// width in each coordinate j, and each of the prod(strata) cells holds one uniform point. The cells are
+// in lexicographic order, the last coordinate varying fastest.
+type Stratified struct {
+	design
+	strata []int
+}
+
+// NewStratified returns the stratified design of the grid of strata (one count per dimension), drawn from src.
+func NewStratified(strata []int, src grand.Source) (*Stratified, error) {
+	if len(strata) == 0 {
+		return nil, errors.New("The strata cannot be empty")
+	}
+	if src == nil {
+		return nil, errors.New("The design needs a source")
+	}
+
+	n := 1
+	for _, s := range strata {
+		if s <= 0 {
+			return nil, errors.New("The number of strata must be positive")
+		}
+		n *= s
+	}
+
+	dim := len(strata)
+	ans := &Stratified{design: design{dim: dim, n: n, points: make([]float64, n*dim)}, strata: append([]int{}, strata...)}
+	ans.Randomize(src)
+	return ans, nil
+}
+
+// Randomize draws a new point in each cell from src and restarts the sequence.
+func (st *Stratified) Randomize(src grand.Source) {
+	r := grand.New(src)
+	for i := 0; i < st.n; i++ {
+		cell := i
+		for j := st.dim - 1; j >= 0; j-- {
+			s := st.strata[j]
+			st.points[i*st.dim+j] = math.Min((float64(cell%s)+r.Float64())/float64(s), 1-0x1p-53)
+			cell /= s
+		}
+	}
+
+	st.Restart()
+}
@@ -0,0 +1,74 @@
+package qmc_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/qmc"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestLatinHypercube(t *testing.T) {
+	for _, centered := range []bool{false, true} {
+		lh, err := qmc.NewLatinHypercube(100, 4, centered, source64.NewSplitMix64(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		checkStratified(t, lh, 100, 100)
+		checkSkip(t, lh)
+
+		// the same source gives the same design, Randomize() a new one.
+		other, _ := qmc.NewLatinHypercube(100, 4, centered, source64.NewSplitMix64(1))
+		x, y := make([]float64, 4), make([]float64, 4)
+		lh.Restart()
+		lh.Next(x)
+		other.Next(y)
+		if x[0] != y[0] {
+			t.Errorf("Mismatch. want: %v, got: %v", x[0], y[0])
+		}
+
+		other.Randomize(source64.NewSplitMix64(2))
+		checkStratified(t, other, 100, 100)
+		other.Restart()
+		other.Next(y)
+		if x[0] == y[0] && x[1] == y[1] {
+			t.Errorf("The designs should differ.")
+		}
+
+		if centered {
+			if v := y[0] * 100; v-float64(int(v)) != 0.5 {
+				t.Errorf("Mismatch. want: %v, got: %v", 0.5, v-float64(int(v)))
+			}
+		}
+	}
+
+	if _, err := qmc.NewLatinHypercube(10, 2, false, nil); err == nil {
+		t.Errorf("The design needs a source.")
+	}
+}
+
+func TestStratified(t *testing.T) {
+	strata := []int{4, 3, 5}
+	st, err := qmc.NewStratified(strata, source64.NewSplitMix64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the cells in lexicographic order, the last coordinate varying fastest.
+	x := make([]float64, 3)
+	for i := 0; i < 60; i++ {
+		st.Next(x)
+		want := [3]int{i / 15, i / 5 % 3, i % 5}
+		for j := range x {
+			if got := int(x[j] * float64(strata[j])); got != want[j] {
+				t.Errorf("point %d, coordinate %d: Mismatch. want: %v, got: %v", i, j, want[j], got)
+			}
+		}
+	}
+
+	checkSkip(t, st)
+
+	if _, err := qmc.NewStratified([]int{2, 0}, source64.NewSplitMix64(1)); err == nil {
+		t.Errorf("The number of strata must be positive.")
+	}
+}
@@ -0,0 +1,147 @@
+package qmc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jtejido/grand"
+)
+
+// Implements the Halton sequence: the coordinate j of the point i is the radical inverse of i in the base of
+// the j-th prime, whose digits may be permuted (one random permutation per dimension, applied to all the
+// digits). The leaped sequence of Kocis and Whiten uses the indices 0, L, 2L, ... to break the correlations
+// of the high dimensions.
+//
+// J. H. Halton, On the efficiency of certain quasi-random sequences of points in evaluating
+// multi-dimensional integrals. Numerische Mathematik, 1960, 2, 84--90.
+// L. Kocis and W. J. Whiten, Computational Investigations of Low-Discrepancy Sequences.
+// ACM Transactions on Mathematical Software, 1997, 23(2), 266--294.
+type Halton struct {
+	bases []uint32
+	// the number of digits that give the precision of a float64 in each base.
+	digits []int
+	// the digit permutations, nil if not randomized.
+	perms     [][]uint32
+	leap      uint64
+	index     uint64
+	randomize bool
+}
+
+// NewHalton returns the Halton sequence of dimension dim, with random digit permutations drawn from src if
+// it is not nil.
+func NewHalton(dim int, src grand.Source) (*Halton, error) {
+	return NewLeapedHalton(dim, 1, src)
+}
+
+// NewLeapedHalton returns the Halton sequence of dimension dim whose points are the ones of index k*leap.
+// The leap must not be a multiple of any of the bases (Kocis and Whiten use primes, e.g. 409).
+func NewLeapedHalton(dim int, leap uint64, src grand.Source) (*Halton, error) {
+	if dim <= 0 {
+		return nil, errors.New("The dimension must be positive")
+	}
+	if leap == 0 {
+		return nil, errors.New("The leap must be positive")
+	}
+
+	ans := &Halton{bases: primes(dim), digits: make([]int, dim), leap: leap, randomize: src != nil}
+	for j, p := range ans.bases {
+		if leap%uint64(p) == 0 {
+			return nil, fmt.Errorf("The leap must not be a multiple of the base %d", p)
+		}
+		ans.digits[j] = int(math.Ceil(53 / math.Log2(float64(p))))
+	}
+
+	ans.Randomize(src)
+	return ans, nil
+}
+
+// Returns the first n primes.
+func primes(n int) []uint32 {
+	// the n-th prime is less than n*(ln(n) + ln(ln(n))) for n >= 6.
+	limit := 15
+	if n >= 6 {
+		limit = int(float64(n)*(math.Log(float64(n))+math.Log(math.Log(float64(n))))) + 1
+	}
+
+	composite := make([]bool, limit+1)
+	ans := make([]uint32, 0, n)
+	for i := 2; len(ans) < n; i++ {
+		if composite[i] {
+			continue
+		}
+		ans = append(ans, uint32(i))
+		for k := i * i; k <= limit; k += i {
+			composite[k] = true
+		}
+	}
+
+	return ans
+}
+
+// Randomize draws new digit permutations from src (if the sequence is randomized) and restarts the sequence.
+func (h *Halton) Randomize(src grand.Source) {
+	if h.randomize {
+		r := grand.New(src)
+		h.perms = make([][]uint32, len(h.bases))
+		for j, p := range h.bases {
+			perm := make([]uint32, p)
+			for i := range perm {
+				perm[i] = uint32(i)
+			}
+			for i := len(perm) - 1; i > 0; i-- {
+				k := r.Intn(i + 1)
+				perm[i], perm[k] = perm[k], perm[i]
+			}
+			h.perms[j] = perm
+		}
+	}
+
+	h.Restart()
+}
+
+func (h *Halton) Dim() int {
+	return len(h.bases)
+}
+
+func (h *Halton) Restart() {
+	h.index = 0
+}
+
+func (h *Halton) Skip(n uint64) {
+	h.index += n
+}
+
+// Next writes the next point into dst: the digits of the index are summed from the least significant one,
+// with Horner's rule.
+func (h *Halton) Next(dst []float64) {
+	if len(dst) != len(h.bases) {
+		panic("invalid argument to Halton.Next")
+	}
+
+	var digits [64]uint32
+	n := h.index * h.leap
+	for j, p := range h.bases {
+		b := uint64(p)
+		m := n
+		for k := 0; k < h.digits[j]; k++ {
+			digits[k] = uint32(m % b)
+			m /= b
+		}
+
+		x := 0.0
+		fb := float64(p)
+		for k := h.digits[j] - 1; k >= 0; k-- {
+			d := digits[k]
+			if h.perms != nil {
+				d = h.perms[j][d]
+			}
+			x = (float64(d) + x) / fb
+		}
+
+		// (p-1 + x)/p may round up to 1.
+		dst[j] = math.Min(x, 1-0x1p-53)
+	}
+
+	h.index++
+}
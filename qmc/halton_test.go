@@ -0,0 +1,107 @@
+package qmc_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/qmc"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestHalton(t *testing.T) {
+	h, err := qmc.NewHalton(3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the radical inverses in bases 2, 3 and 5.
+	expected := [][]float64{
+		{0, 0, 0},
+		{1.0 / 2, 1.0 / 3, 1.0 / 5},
+		{1.0 / 4, 2.0 / 3, 2.0 / 5},
+		{3.0 / 4, 1.0 / 9, 3.0 / 5},
+		{1.0 / 8, 4.0 / 9, 4.0 / 5},
+		{5.0 / 8, 7.0 / 9, 1.0 / 25},
+		{3.0 / 8, 2.0 / 9, 6.0 / 25},
+		{7.0 / 8, 5.0 / 9, 11.0 / 25},
+	}
+
+	x := make([]float64, 3)
+	for _, want := range expected {
+		h.Next(x)
+		for j := range want {
+			if math.Abs(want[j]-x[j]) > 1e-15 {
+				t.Errorf("Mismatch. want: %v, got: %v", want[j], x[j])
+			}
+		}
+	}
+
+	t.Run("Skip", func(t *testing.T) {
+		checkSkip(t, h)
+	})
+
+	t.Run("Leaped", func(t *testing.T) {
+		const leap = 409
+		lh, err := qmc.NewLeapedHalton(3, leap, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		y := make([]float64, 3)
+		for k := 0; k < 10; k++ {
+			lh.Next(x)
+			h.Restart()
+			h.Skip(uint64(k * leap))
+			h.Next(y)
+			for j := range y {
+				if x[j] != y[j] {
+					t.Errorf("Mismatch. want: %v, got: %v", y[j], x[j])
+				}
+			}
+		}
+
+		if _, err := qmc.NewLeapedHalton(3, 10, nil); err == nil {
+			t.Errorf("The leap 10 is a multiple of the base 2.")
+		}
+	})
+
+	t.Run("Primes", func(t *testing.T) {
+		// the 1000th prime is 7919.
+		h, err := qmc.NewHalton(1000, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		x := make([]float64, 1000)
+		h.Next(x)
+		h.Next(x)
+		if want := 1.0 / 7919; math.Abs(x[999]-want) > 1e-18 {
+			t.Errorf("Mismatch. want: %v, got: %v", want, x[999])
+		}
+	})
+}
+
+// With random digit permutations, the first p^2 points of the coordinate of base p are still one per
+// interval of width p^-2. If the permutation maps 0 to p-1, the trailing digits p-1 add up to the end of the
+// interval (0.0222... = 0.1 in base 3), so the points are compared up to a rounding error.
+func TestHaltonPermutations(t *testing.T) {
+	for j, p := range []int{2, 3, 5, 7, 11} {
+		h, err := qmc.NewHalton(5, source64.NewSplitMix64(uint64(j)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		seen := make([]bool, p*p)
+		x := make([]float64, 5)
+		for i := 0; i < p*p; i++ {
+			h.Next(x)
+			b := int(x[j]*float64(p*p)+1e-9) % (p * p)
+			if seen[b] {
+				t.Fatalf("base %d: two points in [%d/%d, %d/%d)", p, b, p*p, b+1, p*p)
+			}
+			seen[b] = true
+		}
+
+		checkSkip(t, h)
+	}
+}
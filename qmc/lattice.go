@@ -0,0 +1,159 @@
+package qmc
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/jtejido/grand"
+)
+
+// Implements a rank-1 lattice rule: the point i of the n points is frac(i*z/n + shift), for the generating
+// vector z, with a random shift per dimension (Cranley and Patterson) if the rule is randomized.
+// The sequence repeats after n points.
+//
+// I. H. Sloan and S. Joe, Lattice Methods for Multiple Integration. Oxford University Press, 1994.
+// R. Cranley and T. N. L. Patterson, Randomization of Number Theoretic Methods for Multiple Integration.
+// SIAM Journal on Numerical Analysis, 1976, 13(6), 904--914.
+type Lattice struct {
+	gen       []uint64
+	shift     []float64
+	n, index  uint64
+	randomize bool
+}
+
+// NewLattice returns the rank-1 lattice rule of n points and generating vector z, with a random shift drawn
+// from src if it is not nil.
+func NewLattice(n uint64, z []uint64, src grand.Source) (*Lattice, error) {
+	if n == 0 {
+		return nil, errors.New("The number of points must be positive")
+	}
+	if len(z) == 0 {
+		return nil, errors.New("The generating vector cannot be empty")
+	}
+
+	ans := &Lattice{gen: make([]uint64, len(z)), shift: make([]float64, len(z)), n: n, randomize: src != nil}
+	for j, zj := range z {
+		ans.gen[j] = zj % n
+	}
+
+	ans.Randomize(src)
+	return ans, nil
+}
+
+// NewKorobovLattice returns the rank-1 lattice rule of n points and generating vector (1, a, a^2, ...) mod n.
+func NewKorobovLattice(dim int, n, a uint64, src grand.Source) (*Lattice, error) {
+	if dim <= 0 || n == 0 {
+		return nil, errors.New("The dimension and the number of points must be positive")
+	}
+
+	z := make([]uint64, dim)
+	z[0] = 1 % n
+	for j := 1; j < dim; j++ {
+		z[j] = mulMod(z[j-1], a%n, n)
+	}
+
+	return NewLattice(n, z, src)
+}
+
+// GeneratingVector returns a generating vector of a lattice rule of n points, built component by component
+// to minimize the worst-case error in the weighted Korobov space of smoothness 2 with the product weights
+// 1/j^2. This takes O(dim*n^2) operations.
+//
+// I. H. Sloan and A. V. Reztsov, Component-by-component construction of good lattice rules.
+// Mathematics of Computation, 2002, 71(237), 263--273.
+func GeneratingVector(dim int, n uint64) []uint64 {
+	// B2(x) = x^2 - x + 1/6 and the kernel 1 + gamma*2*pi^2*B2({k*z/n}).
+	omega := make([]float64, n)
+	for k := range omega {
+		x := float64(k) / float64(n)
+		omega[k] = 2 * math.Pi * math.Pi * (x*x - x + 1.0/6)
+	}
+
+	prod := make([]float64, n)
+	for k := range prod {
+		prod[k] = 1
+	}
+
+	z := make([]uint64, dim)
+	for j := 0; j < dim; j++ {
+		gamma := 1 / float64((j+1)*(j+1))
+		best, bestErr := uint64(1), math.Inf(1)
+		for c := uint64(1); c < n && j > 0; c++ {
+			if gcd(c, n) != 1 {
+				continue
+			}
+
+			e := 0.0
+			for k := uint64(0); k < n; k++ {
+				e += prod[k] * (1 + gamma*omega[mulMod(k, c, n)])
+			}
+			if e < bestErr {
+				best, bestErr = c, e
+			}
+		}
+
+		z[j] = best % n
+		for k := uint64(0); k < n; k++ {
+			prod[k] *= 1 + gamma*omega[mulMod(k, z[j], n)]
+		}
+	}
+
+	return z
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// Randomize draws a new random shift from src (if the rule is randomized) and restarts the sequence.
+func (l *Lattice) Randomize(src grand.Source) {
+	if l.randomize {
+		r := grand.New(src)
+		for j := range l.shift {
+			l.shift[j] = r.Float64()
+		}
+	}
+
+	l.Restart()
+}
+
+func (l *Lattice) Dim() int {
+	return len(l.gen)
+}
+
+func (l *Lattice) Restart() {
+	l.index = 0
+}
+
+func (l *Lattice) Skip(n uint64) {
+	l.index = (l.index + n%l.n) % l.n
+}
+
+func (l *Lattice) Next(dst []float64) {
+	if len(dst) != len(l.gen) {
+		panic("invalid argument to Lattice.Next")
+	}
+
+	for j, zj := range l.gen {
+		x := float64(mulMod(l.index, zj, l.n))/float64(l.n) + l.shift[j]
+		if x >= 1 {
+			x -= 1
+		}
+		dst[j] = x
+	}
+
+	l.index++
+	if l.index == l.n {
+		l.index = 0
+	}
+}
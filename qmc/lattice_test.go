@@ -0,0 +1,84 @@
+package qmc_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand/qmc"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestLattice(t *testing.T) {
+	// the Fibonacci lattice of 89 points.
+	l, err := qmc.NewLattice(89, []uint64{1, 55}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x := make([]float64, 2)
+	for i := 0; i < 89; i++ {
+		l.Next(x)
+		if want := float64(i) / 89; x[0] != want {
+			t.Errorf("Mismatch. want: %v, got: %v", want, x[0])
+		}
+		if want := float64(i*55%89) / 89; x[1] != want {
+			t.Errorf("Mismatch. want: %v, got: %v", want, x[1])
+		}
+	}
+
+	// the rule repeats after n points.
+	l.Next(x)
+	if x[0] != 0 || x[1] != 0 {
+		t.Errorf("Mismatch. want: %v, got: %v", []float64{0, 0}, x)
+	}
+
+	t.Run("Skip", func(t *testing.T) {
+		checkSkip(t, l)
+	})
+
+	t.Run("Shift", func(t *testing.T) {
+		l, _ := qmc.NewLattice(89, []uint64{1, 55}, source64.NewSplitMix64(1))
+		checkStratified(t, l, 89, 89)
+		checkSkip(t, l)
+	})
+
+	t.Run("Korobov", func(t *testing.T) {
+		l, err := qmc.NewKorobovLattice(3, 1021, 76, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		x := make([]float64, 3)
+		l.Next(x)
+		l.Next(x)
+		for j, want := range []float64{1.0 / 1021, 76.0 / 1021, float64(76*76%1021) / 1021} {
+			if math.Abs(x[j]-want) > 1e-15 {
+				t.Errorf("Mismatch. want: %v, got: %v", want, x[j])
+			}
+		}
+	})
+}
+
+func TestGeneratingVector(t *testing.T) {
+	const n = 1021
+	z := qmc.GeneratingVector(6, n)
+	if z[0] != 1 {
+		t.Errorf("Mismatch. want: %v, got: %v", 1, z[0])
+	}
+
+	// the 2-dimensional projections must not put two points on the same line of slope 1 (z_j != z_k),
+	// and the components must be coprime with n.
+	for j := range z {
+		if z[j] == 0 || z[j] >= n {
+			t.Errorf("component %d out of [1, n): %v", j, z[j])
+		}
+		for k := 0; k < j; k++ {
+			if z[j] == z[k] {
+				t.Errorf("components %d and %d are equal: %v", k, j, z[j])
+			}
+		}
+	}
+
+	l, _ := qmc.NewLattice(n, z, source64.NewSplitMix64(1))
+	checkStratified(t, l, n, n)
+}
@@ -0,0 +1,62 @@
+// Package qmc implements low-discrepancy point sets for quasi-Monte Carlo integration: Sobol sequences
+// (with Owen or linear matrix scrambling), Halton and leaped Halton sequences (with random digit
+// permutations), and rank-1 lattice rules (with random shifts).
+//
+// The randomizations are drawn from a grand.Source when the point set is created (or re-drawn with
+// Randomize), so that the replicates of randomized QMC are reproducible from the seeds of the source,
+// e.g. one substream of a JumpableSource per replicate.
+package qmc
+
+// PointSet is a sequence of points of the unit cube [0,1)^Dim().
+type PointSet interface {
+	// Dim returns the dimension of the points.
+	Dim() int
+	// Next writes the next point into dst, which must have Dim() values.
+	Next(dst []float64)
+	// Skip skips the next n points.
+	Skip(n uint64)
+	// Restart restarts the sequence from its first point (with the same randomization).
+	Restart()
+}
+
+// Source exposes the coordinates of the points of a PointSet as a grand.Source: Uint32() returns the
+// coordinates one after the other, point by point, as fractions of 2^32. Seed(seed) restarts the point
+// set and skips seed points.
+type Source struct {
+	ps    PointSet
+	point []float64
+	pos   int
+	skip  uint64
+}
+
+// NewSource returns the Source of the coordinates of ps, from its current point.
+func NewSource(ps PointSet) *Source {
+	return &Source{ps: ps, point: make([]float64, ps.Dim()), pos: ps.Dim()}
+}
+
+func (s *Source) Uint32() uint32 {
+	if s.pos == len(s.point) {
+		s.ps.Next(s.point)
+		s.pos = 0
+	}
+
+	x := s.point[s.pos]
+	s.pos++
+	return uint32(x * (1 << 32))
+}
+
+// Bool returns whether the next coordinate is in [0.5,1).
+func (s *Source) Bool() bool {
+	return s.Uint32()>>31 == 1
+}
+
+func (s *Source) Seed(seed int64) {
+	s.skip = uint64(seed)
+	s.Restart()
+}
+
+func (s *Source) Restart() {
+	s.ps.Restart()
+	s.ps.Skip(s.skip)
+	s.pos = len(s.point)
+}
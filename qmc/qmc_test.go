@@ -0,0 +1,128 @@
+package qmc_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/qmc"
+	"github.com/jtejido/grand/source64"
+)
+
+// checkSkip compares Skip() and Restart() with the points drawn one by one.
+func checkSkip(t *testing.T, ps qmc.PointSet) {
+	t.Helper()
+
+	d := ps.Dim()
+	ps.Restart()
+	want := make([]float64, 100*d)
+	for i := 0; i < 100; i++ {
+		ps.Next(want[i*d : (i+1)*d])
+	}
+
+	x := make([]float64, d)
+	for _, n := range []uint64{0, 1, 17, 64, 99} {
+		ps.Restart()
+		ps.Skip(n)
+		ps.Next(x)
+		for j := range x {
+			if want[int(n)*d+j] != x[j] {
+				t.Fatalf("Skip(%d): Mismatch. want: %v, got: %v", n, want[int(n)*d+j], x[j])
+			}
+		}
+	}
+
+	ps.Restart()
+	ps.Skip(10)
+	ps.Skip(20)
+	ps.Next(x)
+	if want[30*d] != x[0] {
+		t.Errorf("Skip(10), Skip(20): Mismatch. want: %v, got: %v", want[30*d], x[0])
+	}
+}
+
+// checkStratified checks that the first n points of each coordinate are one per interval of width 1/k.
+func checkStratified(t *testing.T, ps qmc.PointSet, n, k int) {
+	t.Helper()
+
+	d := ps.Dim()
+	seen := make([][]bool, d)
+	for j := range seen {
+		seen[j] = make([]bool, k)
+	}
+
+	ps.Restart()
+	x := make([]float64, d)
+	for i := 0; i < n; i++ {
+		ps.Next(x)
+		for j, v := range x {
+			if v < 0 || v >= 1 {
+				t.Fatalf("coordinate %d out of [0, 1): %v", j, v)
+			}
+			b := int(v * float64(k))
+			if seen[j][b] {
+				t.Fatalf("coordinate %d: two points in [%d/%d, %d/%d)", j, b, k, b+1, k)
+			}
+			seen[j][b] = true
+		}
+	}
+}
+
+func TestSource(t *testing.T) {
+	factories := map[string]func() qmc.PointSet{
+		"Sobol": func() qmc.PointSet {
+			ps, _ := qmc.NewSobol(3, qmc.OwenScrambling, source64.NewSplitMix64(1))
+			return ps
+		},
+		"Halton": func() qmc.PointSet {
+			ps, _ := qmc.NewHalton(3, source64.NewSplitMix64(1))
+			return ps
+		},
+		"Lattice": func() qmc.PointSet {
+			ps, _ := qmc.NewKorobovLattice(3, 1021, 76, source64.NewSplitMix64(1))
+			return ps
+		},
+	}
+
+	for name, factory := range factories {
+		t.Run(name, func(t *testing.T) {
+			grandtest.RunSourceTests(t, func() grand.Source { return qmc.NewSource(factory()) })
+		})
+	}
+}
+
+// A randomized QMC estimate of the integral of prod(x_j) over [0,1)^5, 1/32, is much more accurate than
+// a Monte Carlo one with the same number of points.
+func TestIntegration(t *testing.T) {
+	const dim, n = 5, 1 << 12
+	sobol, _ := qmc.NewSobol(dim, qmc.OwenScrambling, source64.NewSplitMix64(1))
+	halton, _ := qmc.NewHalton(dim, source64.NewSplitMix64(1))
+	lattice, _ := qmc.NewLattice(4093, qmc.GeneratingVector(dim, 4093), source64.NewSplitMix64(1))
+	cases := map[string]struct {
+		ps  qmc.PointSet
+		n   int
+		tol float64
+	}{
+		"Sobol":   {sobol, n, 2e-4},
+		"Halton":  {halton, n, 5e-4},
+		"Lattice": {lattice, 4093, 5e-4},
+	}
+
+	for name, c := range cases {
+		x := make([]float64, dim)
+		sum := 0.0
+		for i := 0; i < c.n; i++ {
+			c.ps.Next(x)
+			p := 1.0
+			for _, v := range x {
+				p *= v
+			}
+			sum += p
+		}
+
+		if err := math.Abs(sum/float64(c.n) - 1.0/32); err > c.tol {
+			t.Errorf("%s: error %v, tolerance %v", name, err, c.tol)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package qmc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/jtejido/grand"
+)
+
+const (
+	// The number of bits of the coordinates, which allows 2^sobol_bits points.
+	sobol_bits = 32
+	// 2^-32
+	sobol_scale = 1.0 / (1 << sobol_bits)
+)
+
+// SobolDirection holds the direction numbers of a dimension of a Sobol sequence, as in the files of
+// Joe and Kuo: the degree S of the primitive polynomial, its inner coefficients A (the coefficient of
+// x^(S-i) is bit S-1-i), and the S initial direction numbers M (M[k-1] odd, less than 2^k).
+type SobolDirection struct {
+	S, A uint32
+	M    []uint32
+}
+
+// Scrambling selects the randomization of a Sobol sequence.
+type Scrambling int
+
+const (
+	// The points of the Sobol sequence, the first of which is 0.
+	NoScrambling Scrambling = iota
+	// Nested uniform (Owen) scrambling, with the hash-based permutation of Laine and Karras, as in
+	// Burley's "Practical Hash-based Owen Scrambling" (JCGT, 2020).
+	OwenScrambling
+	// Linear matrix scrambling (random lower triangular matrices) and a random digital shift.
+	LMSScrambling
+)
+
+// Implements the Sobol sequence in base 2 with the direction numbers of Joe and Kuo, in Gray code order:
+// the first 2^m points are a (t, m, s)-net for all m.
+//
+// I. M. Sobol, On the distribution of points in a cube and the approximate evaluation of integrals.
+// USSR Computational Mathematics and Mathematical Physics, 1967, 7(4), 86--112.
+// S. Joe and F. Y. Kuo, Constructing Sobol sequences with better two-dimensional projections.
+// SIAM Journal on Scientific Computing, 2008, 30(5), 2635--2654.
+// https://web.maths.unsw.edu.au/~fkuo/sobol/
+type Sobol struct {
+	dim        int
+	scrambling Scrambling
+	// the direction numbers (scrambled by LMS), sobol_bits per dimension.
+	dirs, v []uint32
+	// the digital shift of LMS and the seeds of Owen's scrambling.
+	shift, seed []uint32
+	// the current point (before Owen's scrambling) and the index of the next point.
+	x     []uint32
+	index uint64
+}
+
+// NewSobol returns the Sobol sequence of dimension dim with the built-in direction numbers, which cover
+// the first 53 dimensions: use NewSobolFromDirections with ParseJoeKuo for more. The scrambling is drawn
+// from src, which may be nil with NoScrambling.
+func NewSobol(dim int, scrambling Scrambling, src grand.Source) (*Sobol, error) {
+	if dim > len(joeKuoDirections)+1 {
+		return nil, fmt.Errorf("The built-in direction numbers have %d dimensions, use NewSobolFromDirections", len(joeKuoDirections)+1)
+	}
+
+	return NewSobolFromDirections(dim, joeKuoDirections, scrambling, src)
+}
+
+// NewSobolFromDirections returns the Sobol sequence of dimension dim, whose dimensions 2 to dim use
+// dirs[0] to dirs[dim-2] (the first dimension is the van der Corput sequence).
+func NewSobolFromDirections(dim int, dirs []SobolDirection, scrambling Scrambling, src grand.Source) (*Sobol, error) {
+	if dim <= 0 {
+		return nil, errors.New("The dimension must be positive")
+	}
+	if dim-1 > len(dirs) {
+		return nil, fmt.Errorf("The direction numbers have %d dimensions", len(dirs)+1)
+	}
+	if scrambling != NoScrambling && src == nil {
+		return nil, errors.New("The scrambling needs a source")
+	}
+
+	ans := &Sobol{
+		dim:        dim,
+		scrambling: scrambling,
+		dirs:       make([]uint32, dim*sobol_bits),
+		v:          make([]uint32, dim*sobol_bits),
+		shift:      make([]uint32, dim),
+		seed:       make([]uint32, dim),
+		x:          make([]uint32, dim),
+	}
+
+	for k := 0; k < sobol_bits; k++ {
+		ans.dirs[k] = 1 << (sobol_bits - 1 - k)
+	}
+	for j := 1; j < dim; j++ {
+		err := sobolDirections(ans.dirs[j*sobol_bits:(j+1)*sobol_bits], dirs[j-1])
+		if err != nil {
+			return nil, fmt.Errorf("dimension %d: %v", j+1, err)
+		}
+	}
+
+	ans.Randomize(src)
+	return ans, nil
+}
+
+// Computes the direction numbers v[k] = m[k]/2^(k+1) of a dimension, with the recurrence of its polynomial.
+func sobolDirections(v []uint32, d SobolDirection) error {
+	s := int(d.S)
+	if s == 0 || len(d.M) != s || d.A>>(d.S-1) != 0 {
+		return errors.New("invalid direction numbers")
+	}
+
+	for k := 0; k < sobol_bits; k++ {
+		if k < s {
+			m := d.M[k]
+			if m&1 == 0 || m>>(k+1) != 0 {
+				return errors.New("invalid direction numbers")
+			}
+			v[k] = m << (sobol_bits - 1 - k)
+			continue
+		}
+
+		v[k] = v[k-s] ^ (v[k-s] >> s)
+		for i := 1; i < s; i++ {
+			if (d.A>>(s-1-i))&1 != 0 {
+				v[k] ^= v[k-i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// Randomize draws a new scrambling from src (with OwenScrambling or LMSScrambling) and restarts the sequence.
+func (sb *Sobol) Randomize(src grand.Source) {
+	copy(sb.v, sb.dirs)
+	for j := range sb.shift {
+		sb.shift[j], sb.seed[j] = 0, 0
+	}
+
+	switch sb.scrambling {
+	case OwenScrambling:
+		for j := range sb.seed {
+			sb.seed[j] = src.Uint32()
+		}
+	case LMSScrambling:
+		var rows [sobol_bits]uint32
+		for j := 0; j < sb.dim; j++ {
+			// row i gives the digit i (of weight 2^-(i+1)): 1 on the diagonal, random on the left.
+			for i := range rows {
+				diag := uint32(1) << (sobol_bits - 1 - i)
+				rows[i] = diag | src.Uint32()&^(diag<<1-1)
+			}
+
+			v := sb.v[j*sobol_bits : (j+1)*sobol_bits]
+			for k, d := range v {
+				var ans uint32
+				for i, row := range rows {
+					ans |= uint32(bits.OnesCount32(row&d)&1) << (sobol_bits - 1 - i)
+				}
+				v[k] = ans
+			}
+			sb.shift[j] = src.Uint32()
+		}
+	}
+
+	sb.Restart()
+}
+
+func (sb *Sobol) Dim() int {
+	return sb.dim
+}
+
+func (sb *Sobol) Restart() {
+	copy(sb.x, sb.shift)
+	sb.index = 0
+}
+
+// Skip moves to the point index+n, whose digits are those of its Gray code.
+func (sb *Sobol) Skip(n uint64) {
+	sb.index += n
+	if sb.index > 1<<sobol_bits {
+		panic("qmc: the Sobol sequence has 2^32 points")
+	}
+
+	g := sb.index ^ (sb.index >> 1)
+	for j := range sb.x {
+		x := sb.shift[j]
+		v := sb.v[j*sobol_bits : (j+1)*sobol_bits]
+		for k := 0; k < sobol_bits; k++ {
+			if g>>k&1 != 0 {
+				x ^= v[k]
+			}
+		}
+		sb.x[j] = x
+	}
+}
+
+// Next writes the next point into dst. Consecutive points differ by the direction numbers of the
+// lowest 0 bit of the index (Antonov and Saleev's Gray code method).
+func (sb *Sobol) Next(dst []float64) {
+	if len(dst) != sb.dim {
+		panic("invalid argument to Sobol.Next")
+	}
+	if sb.index >= 1<<sobol_bits {
+		panic("qmc: the Sobol sequence has 2^32 points")
+	}
+
+	for j, x := range sb.x {
+		if sb.scrambling == OwenScrambling {
+			x = owenScramble(x, sb.seed[j])
+		}
+		dst[j] = float64(x) * sobol_scale
+	}
+
+	sb.index++
+	if sb.index < 1<<sobol_bits {
+		c := bits.TrailingZeros64(sb.index)
+		for j := range sb.x {
+			sb.x[j] ^= sb.v[j*sobol_bits+c]
+		}
+	}
+}
+
+// Returns the nested uniform scrambling of x: reversed, each bit is flipped according to the bits below it.
+func owenScramble(x, seed uint32) uint32 {
+	x = bits.Reverse32(x)
+	x += seed
+	x ^= x * 0x6c50b47c
+	x ^= x * 0xb82f1e52
+	x ^= x * 0xc7afe638
+	x ^= x * 0x8d22f6e6
+	return bits.Reverse32(x)
+}
+
+// JoeKuoDirections returns a copy of the built-in direction numbers, those of dimensions 2 to 53.
+func JoeKuoDirections() []SobolDirection {
+	ans := make([]SobolDirection, len(joeKuoDirections))
+	for i, d := range joeKuoDirections {
+		ans[i] = SobolDirection{S: d.S, A: d.A, M: append([]uint32{}, d.M...)}
+	}
+
+	return ans
+}
+
+// ParseJoeKuo reads direction numbers in the format of the files of Joe and Kuo, e.g. new-joe-kuo-6.21201:
+// a header line, then one line "d s a m_1 ... m_s" per dimension d >= 2.
+func ParseJoeKuo(r io.Reader) ([]SobolDirection, error) {
+	var ans []SobolDirection
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		fields := strings.Fields(sc.Text())
+		if n == 1 || len(fields) == 0 {
+			continue
+		}
+
+		vals := make([]uint32, len(fields))
+		for i, f := range fields {
+			v, err := strconv.ParseUint(f, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", n, err)
+			}
+			vals[i] = uint32(v)
+		}
+
+		if len(vals) < 3 || len(vals) != 3+int(vals[1]) {
+			return nil, fmt.Errorf("line %d: expected d, s, a and s direction numbers", n)
+		}
+		ans = append(ans, SobolDirection{S: vals[1], A: vals[2], M: vals[3:]})
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
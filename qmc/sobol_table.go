@@ -0,0 +1,58 @@
+package qmc
+
+// The direction numbers of dimensions 2 to 53 of new-joe-kuo-6.21201, the first rows of the file of
+// Joe and Kuo (all the primitive polynomials up to degree 8); ParseJoeKuo reads the complete file.
+var joeKuoDirections = []SobolDirection{
+	{S: 1, A: 0, M: []uint32{1}},
+	{S: 2, A: 1, M: []uint32{1, 3}},
+	{S: 3, A: 1, M: []uint32{1, 3, 1}},
+	{S: 3, A: 2, M: []uint32{1, 1, 1}},
+	{S: 4, A: 1, M: []uint32{1, 1, 3, 3}},
+	{S: 4, A: 4, M: []uint32{1, 3, 5, 13}},
+	{S: 5, A: 2, M: []uint32{1, 1, 5, 5, 17}},
+	{S: 5, A: 4, M: []uint32{1, 1, 5, 5, 5}},
+	{S: 5, A: 7, M: []uint32{1, 1, 7, 11, 19}},
+	{S: 5, A: 11, M: []uint32{1, 1, 5, 1, 1}},
+	{S: 5, A: 13, M: []uint32{1, 1, 1, 3, 11}},
+	{S: 5, A: 14, M: []uint32{1, 3, 5, 5, 31}},
+	{S: 6, A: 1, M: []uint32{1, 3, 3, 9, 7, 49}},
+	{S: 6, A: 13, M: []uint32{1, 1, 1, 15, 21, 21}},
+	{S: 6, A: 16, M: []uint32{1, 3, 1, 13, 27, 49}},
+	{S: 6, A: 19, M: []uint32{1, 1, 1, 15, 7, 5}},
+	{S: 6, A: 22, M: []uint32{1, 3, 1, 15, 13, 25}},
+	{S: 6, A: 25, M: []uint32{1, 1, 5, 5, 19, 61}},
+	{S: 7, A: 1, M: []uint32{1, 3, 7, 11, 23, 15, 103}},
+	{S: 7, A: 4, M: []uint32{1, 3, 7, 13, 13, 15, 69}},
+	{S: 7, A: 7, M: []uint32{1, 1, 3, 13, 7, 35, 63}},
+	{S: 7, A: 8, M: []uint32{1, 3, 5, 9, 1, 25, 53}},
+	{S: 7, A: 14, M: []uint32{1, 3, 1, 13, 9, 35, 107}},
+	{S: 7, A: 19, M: []uint32{1, 3, 1, 5, 27, 61, 31}},
+	{S: 7, A: 21, M: []uint32{1, 1, 5, 11, 19, 41, 61}},
+	{S: 7, A: 28, M: []uint32{1, 3, 5, 3, 3, 13, 69}},
+	{S: 7, A: 31, M: []uint32{1, 1, 7, 13, 1, 19, 1}},
+	{S: 7, A: 32, M: []uint32{1, 3, 7, 5, 13, 19, 59}},
+	{S: 7, A: 37, M: []uint32{1, 1, 3, 9, 25, 29, 41}},
+	{S: 7, A: 41, M: []uint32{1, 3, 5, 13, 23, 1, 55}},
+	{S: 7, A: 42, M: []uint32{1, 3, 7, 3, 13, 59, 17}},
+	{S: 7, A: 50, M: []uint32{1, 3, 1, 3, 5, 53, 69}},
+	{S: 7, A: 55, M: []uint32{1, 1, 5, 5, 23, 33, 13}},
+	{S: 7, A: 56, M: []uint32{1, 1, 7, 7, 1, 61, 123}},
+	{S: 7, A: 59, M: []uint32{1, 1, 7, 9, 13, 61, 49}},
+	{S: 7, A: 62, M: []uint32{1, 3, 3, 5, 3, 55, 33}},
+	{S: 8, A: 14, M: []uint32{1, 3, 1, 15, 31, 13, 49, 245}},
+	{S: 8, A: 21, M: []uint32{1, 3, 5, 15, 31, 59, 63, 97}},
+	{S: 8, A: 22, M: []uint32{1, 3, 1, 11, 11, 11, 77, 249}},
+	{S: 8, A: 38, M: []uint32{1, 3, 1, 11, 27, 43, 71, 9}},
+	{S: 8, A: 47, M: []uint32{1, 1, 7, 15, 21, 11, 81, 45}},
+	{S: 8, A: 49, M: []uint32{1, 3, 7, 3, 25, 31, 65, 79}},
+	{S: 8, A: 50, M: []uint32{1, 3, 1, 1, 19, 11, 3, 205}},
+	{S: 8, A: 52, M: []uint32{1, 1, 5, 9, 19, 21, 29, 157}},
+	{S: 8, A: 56, M: []uint32{1, 3, 7, 11, 1, 33, 89, 185}},
+	{S: 8, A: 67, M: []uint32{1, 3, 3, 3, 15, 9, 79, 71}},
+	{S: 8, A: 70, M: []uint32{1, 3, 7, 11, 15, 39, 119, 27}},
+	{S: 8, A: 84, M: []uint32{1, 1, 3, 1, 11, 31, 97, 225}},
+	{S: 8, A: 97, M: []uint32{1, 1, 1, 3, 23, 43, 57, 177}},
+	{S: 8, A: 103, M: []uint32{1, 3, 7, 7, 17, 17, 37, 71}},
+	{S: 8, A: 115, M: []uint32{1, 3, 1, 5, 27, 63, 123, 213}},
+	{S: 8, A: 122, M: []uint32{1, 1, 3, 5, 11, 43, 53, 133}},
+}
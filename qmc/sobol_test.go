@@ -0,0 +1,186 @@
+package qmc_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jtejido/grand/internal/gf2"
+	"github.com/jtejido/grand/qmc"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestSobol(t *testing.T) {
+	sb, err := qmc.NewSobol(3, qmc.NoScrambling, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/*
+	 * Data from the Sobol sequence of new-joe-kuo-6.21201 (dimensions 1 to 3), in Gray code order.
+	 */
+	expected := [][]float64{
+		{0, 0, 0},
+		{0.5, 0.5, 0.5},
+		{0.75, 0.25, 0.25},
+		{0.25, 0.75, 0.75},
+		{0.375, 0.375, 0.625},
+		{0.875, 0.875, 0.125},
+		{0.625, 0.125, 0.875},
+		{0.125, 0.625, 0.375},
+	}
+
+	x := make([]float64, 3)
+	for _, want := range expected {
+		sb.Next(x)
+		for j := range want {
+			if want[j] != x[j] {
+				t.Errorf("Mismatch. want: %v, got: %v", want[j], x[j])
+			}
+		}
+	}
+
+	t.Run("Skip", func(t *testing.T) {
+		checkSkip(t, sb)
+	})
+
+	t.Run("Dimensions", func(t *testing.T) {
+		if _, err := qmc.NewSobol(54, qmc.NoScrambling, nil); err == nil {
+			t.Errorf("The built-in direction numbers have 53 dimensions.")
+		}
+		if _, err := qmc.NewSobol(2, qmc.OwenScrambling, nil); err == nil {
+			t.Errorf("The scrambling needs a source.")
+		}
+	})
+}
+
+// The first 2^m points of each coordinate are one per interval of width 2^-m, scrambled or not.
+func TestSobolStratification(t *testing.T) {
+	for _, s := range []qmc.Scrambling{qmc.NoScrambling, qmc.OwenScrambling, qmc.LMSScrambling} {
+		sb, err := qmc.NewSobol(53, s, source64.NewSplitMix64(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		checkStratified(t, sb, 1<<10, 1<<10)
+	}
+}
+
+// The first two dimensions are a (0, 2)-sequence: the first 2^m points are one per elementary interval
+// of area 2^-m.
+func TestSobolNet(t *testing.T) {
+	for _, s := range []qmc.Scrambling{qmc.NoScrambling, qmc.OwenScrambling, qmc.LMSScrambling} {
+		sb, _ := qmc.NewSobol(2, s, source64.NewSplitMix64(2))
+		const m = 8
+		pts := make([][2]float64, 1<<m)
+		x := make([]float64, 2)
+		for i := range pts {
+			sb.Next(x)
+			pts[i] = [2]float64{x[0], x[1]}
+		}
+
+		for a := 0; a <= m; a++ {
+			seen := make(map[[2]int]bool)
+			for _, p := range pts {
+				box := [2]int{int(p[0] * float64(int(1)<<a)), int(p[1] * float64(int(1)<<(m-a)))}
+				if seen[box] {
+					t.Fatalf("scrambling %d: two points in the box %v of shape 2^-%d x 2^-%d", s, box, a, m-a)
+				}
+				seen[box] = true
+			}
+		}
+	}
+}
+
+func TestSobolRandomize(t *testing.T) {
+	for _, s := range []qmc.Scrambling{qmc.OwenScrambling, qmc.LMSScrambling} {
+		a, _ := qmc.NewSobol(5, s, source64.NewSplitMix64(7))
+		b, _ := qmc.NewSobol(5, s, source64.NewSplitMix64(7))
+		x, y := make([]float64, 5), make([]float64, 5)
+		for i := 0; i < 100; i++ {
+			a.Next(x)
+			b.Next(y)
+			for j := range x {
+				if x[j] != y[j] {
+					t.Fatalf("Mismatch. want: %v, got: %v", x[j], y[j])
+				}
+			}
+		}
+
+		a.Randomize(source64.NewSplitMix64(8))
+		a.Next(x)
+		b.Restart()
+		b.Next(y)
+		if x[0] == y[0] && x[1] == y[1] {
+			t.Errorf("scrambling %d: the replicates should differ", s)
+		}
+
+		sb, _ := qmc.NewSobol(5, s, source64.NewSplitMix64(7))
+		checkSkip(t, sb)
+	}
+}
+
+func TestParseJoeKuo(t *testing.T) {
+	file := `d       s       a       m_i
+2       1       0       1
+3       2       1       1 3
+4       3       1       1 3 1
+5       3       2       1 1 1
+`
+	dirs, err := qmc.ParseJoeKuo(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := qmc.NewSobolFromDirections(5, dirs, qmc.NoScrambling, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builtin, _ := qmc.NewSobol(5, qmc.NoScrambling, nil)
+	x, y := make([]float64, 5), make([]float64, 5)
+	for i := 0; i < 100; i++ {
+		parsed.Next(x)
+		builtin.Next(y)
+		for j := range x {
+			if x[j] != y[j] {
+				t.Fatalf("Mismatch. want: %v, got: %v", y[j], x[j])
+			}
+		}
+	}
+
+	if _, err := qmc.ParseJoeKuo(strings.NewReader("d s a m\n2 2 1 1\n")); err == nil {
+		t.Errorf("A missing direction number should be detected.")
+	}
+	if _, err := qmc.NewSobolFromDirections(2, []qmc.SobolDirection{{S: 2, A: 1, M: []uint32{1, 2}}}, qmc.NoScrambling, nil); err == nil {
+		t.Errorf("An even direction number should be rejected.")
+	}
+}
+
+// The polynomials of the built-in direction numbers must be primitive, as NewSobol checks the m values.
+func TestSobolPolynomials(t *testing.T) {
+	dirs := qmc.JoeKuoDirections()
+	for _, d := range dirs {
+		s := int(d.S)
+		f := gf2.Add(gf2.Add(gf2.Monomial(s), gf2.Monomial(0)), gf2.Poly{uint64(d.A) << 1})
+		order := uint64(1)<<uint(s) - 1
+		one := gf2.Mod(gf2.Monomial(0), f)
+		if !gf2.PowMod(gf2.Monomial(1), order, f).Equal(one) {
+			t.Errorf("x^%d+...: not primitive", s)
+			continue
+		}
+		for q := uint64(2); q <= order; q++ {
+			if order%q == 0 && isPrime(q) && gf2.PowMod(gf2.Monomial(1), order/q, f).Equal(one) {
+				t.Errorf("x^%d+... (a = %d): not primitive", s, d.A)
+			}
+		}
+	}
+}
+
+func isPrime(n uint64) bool {
+	for q := uint64(2); q*q <= n; q++ {
+		if n%q == 0 {
+			return false
+		}
+	}
+
+	return n > 1
+}
@@ -50,6 +50,7 @@ func (bx *baseXoShiRo128) RestartSubstream() {
 }
 
 func (bx *baseXoShiRo128) Jump() {
+	bx.RestartSubstream()
 	s := make([]uint32, xoshiro128_r)
 	for i := 0; i < len(xoshiro128_pw); i++ {
 		var b uint32
@@ -0,0 +1,141 @@
+package source32_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+	"testing"
+)
+
+var sources = []struct {
+	name string
+	src  func() grand.Source
+}{
+	{"CMWC4096", func() grand.Source { return source32.NewCMWC4096(1) }},
+	{"DSFMT19937", func() grand.Source { return source32.NewDSFMT19937(1) }},
+	{"GlibcRandom", func() grand.Source { return source32.NewGlibcRandom(1) }},
+	{"JavaRandom", func() grand.Source { return source32.NewJavaRandom(1) }},
+	{"JSF", func() grand.Source { return source32.NewJSF(1) }},
+	{"KISS", func() grand.Source { return source32.NewKISS(1) }},
+	{"LFSR113", func() grand.Source { return source32.NewLFSR113(1) }},
+	{"LFSR88", func() grand.Source { return source32.NewLFSR88(1) }},
+	{"MinStdRand", func() grand.Source { return source32.NewMinStdRand(1) }},
+	{"MinStdRand0", func() grand.Source { return source32.NewMinStdRand0(1) }},
+	{"MRG32k3A", func() grand.Source { return source32.NewMRG32k3A(1) }},
+	{"MRG32k3P", func() grand.Source { return source32.NewMRG32k3P(1) }},
+	{"MT19937", func() grand.Source { return source32.NewMT19937(1) }},
+	{"MultiplyWithCarry256", func() grand.Source { return source32.NewMultiplyWithCarry256(1) }},
+	{"NumPyRandomState", func() grand.Source { return source32.NewNumPyRandomState(1) }},
+	{"PcgMcgXshRr32", func() grand.Source { return source32.NewPcgMcgXshRr32(1) }},
+	{"PcgMcgXshRs32", func() grand.Source { return source32.NewPcgMcgXshRs32(1) }},
+	{"PcgXshRr32", func() grand.Source { return source32.NewPcgXshRr32(1) }},
+	{"PcgXshRs32", func() grand.Source { return source32.NewPcgXshRs32(1) }},
+	{"RANLUX24", func() grand.Source {
+		src, _ := source32.NewRANLUX24(source32.RANLUX24Luxury[3], 1)
+		return src
+	}},
+	{"RMersenneTwister", func() grand.Source { return source32.NewRMersenneTwister(1) }},
+	{"RomuQuad32", func() grand.Source { return source32.NewRomuQuad32(1) }},
+	{"RomuTrio32", func() grand.Source { return source32.NewRomuTrio32(1) }},
+	{"SFC", func() grand.Source { return source32.NewSFC(1) }},
+	{"SFMT19937", func() grand.Source {
+		src, _ := source32.NewSFMT(source32.SFMT19937Params, 1)
+		return src
+	}},
+	{"TinyMT32", func() grand.Source {
+		src, _ := source32.NewTinyMT32(source32.TinyMT32DefaultParams, 1)
+		return src
+	}},
+	{"Tyche", func() grand.Source { return source32.NewTyche(1) }},
+	{"TycheI", func() grand.Source { return source32.NewTycheI(1) }},
+	{"WELL512A", func() grand.Source { return source32.NewWELL512A(1) }},
+	{"WELL1024A", func() grand.Source { return source32.NewWELL1024A(1) }},
+	{"WELL19937A", func() grand.Source { return source32.NewWELL19937A(1) }},
+	{"WELL19937C", func() grand.Source { return source32.NewWELL19937C(1) }},
+	{"WELL44497A", func() grand.Source { return source32.NewWELL44497A(1) }},
+	{"WELL44497B", func() grand.Source { return source32.NewWELL44497B(1) }},
+	{"XoRoShiRo64Star", func() grand.Source { return source32.NewXoRoShiRo64Star(1) }},
+	{"XoRoShiRo64StarStar", func() grand.Source { return source32.NewXoRoShiRo64StarStar(1) }},
+	{"XORWOW", func() grand.Source { return source32.NewXORWOW(1) }},
+	{"XoShiRo128Plus", func() grand.Source { return source32.NewXoShiRo128Plus(1) }},
+	{"XoShiRo128PlusPlus", func() grand.Source { return source32.NewXoShiRo128PlusPlus(1) }},
+	{"XoShiRo128StarStar", func() grand.Source { return source32.NewXoShiRo128StarStar(1) }},
+}
+
+// The results are accumulated here so the compiler can't optimize the calls away.
+var (
+	sink32   uint32
+	sink64   uint64
+	sinkF64  float64
+	sinkBool bool
+	sinkInt  int
+)
+
+func BenchmarkUint32(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sink32 = r.Uint32()
+			}
+		})
+	}
+}
+
+func BenchmarkUint64(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sink64 = r.Uint64()
+			}
+		})
+	}
+}
+
+func BenchmarkFloat64(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sinkF64 = r.Float64()
+			}
+		})
+	}
+}
+
+func BenchmarkBool(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sinkBool = r.Bool()
+			}
+		})
+	}
+}
+
+func BenchmarkIntn(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sinkInt = r.Intn(1000)
+			}
+		})
+	}
+}
+
+func BenchmarkJump(b *testing.B) {
+	for _, s := range sources {
+		js, ok := s.src().(grand.JumpableSource)
+		if !ok {
+			continue
+		}
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.NewJumpable(js)
+			for i := 0; i < b.N; i++ {
+				r.Jump()
+			}
+		})
+	}
+}
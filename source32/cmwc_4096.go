@@ -0,0 +1,106 @@
+package source32
+
+import (
+	"fmt"
+)
+
+const (
+	cmwc_r                = 4096
+	cmwc_seed_size        = cmwc_r + 1
+	cmwc_a         uint32 = 18782
+	cmwc_m         uint32 = 0xfffffffe
+)
+
+// Port from Marsaglia's "Complementary Multiply-With-Carry" CMWC4096 generator, with the base
+// b = 2^32-1 and the multiplier a = 18782. Its period is about 2^131086.
+//
+// Implementation is based on the C code posted by George Marsaglia on comp.lang.c (2003):
+// https://groups.google.com/g/comp.lang.c/c/qZFQgKRCQGg
+//
+type CMWC4096 struct {
+	baseSource32
+	state        [4096]uint32
+	index, carry uint32
+	// initialCarry stores the carry at the starting point for the stream.
+	initialCarry uint32
+}
+
+func NewCMWC4096FromStream(seeds []uint32) (*CMWC4096, error) {
+	err := checkEmptySeed(seeds)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(CMWC4096)
+	ans.spi = ans
+	err = ans.setSeed(seeds)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewCMWC4096(seed int64) *CMWC4096 {
+	ans := new(CMWC4096)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+// The first value of the stream is the carry, which must be less than the multiplier, followed by the
+// lag values. With such a carry, the generator has no degenerate state.
+func (cmwc *CMWC4096) setSeed(seed []uint32) error {
+	seeds := make([]uint32, cmwc_seed_size)
+	fillState(seeds, seed)
+	c := seeds[0]
+	if c >= cmwc_a {
+		return fmt.Errorf("The carry must be less than %d", cmwc_a)
+	}
+
+	cmwc.initialCarry = c
+	cmwc.stream = append([]uint32{}, seeds[1:1+cmwc_r]...)
+
+	cmwc.Restart()
+	return nil
+}
+
+func (cmwc *CMWC4096) Restart() {
+	copy(cmwc.state[:], cmwc.stream)
+	cmwc.index = cmwc_r - 1
+	cmwc.carry = cmwc.initialCarry
+	cmwc.resetState()
+}
+
+func (cmwc *CMWC4096) Seed(seed int64) {
+	seeds := make([]uint32, cmwc_seed_size)
+	seeder.Seed(seed)
+	var i int
+	for i < cmwc_seed_size {
+		v := seeder.Uint32()
+		seeds[i] = v
+		i++
+	}
+
+	seeds[0] %= cmwc_a
+	cmwc.setSeed(seeds)
+}
+
+func (cmwc *CMWC4096) Uint32() uint32 {
+	cmwc.index = (cmwc.index + 1) & (cmwc_r - 1)
+	t := uint64(cmwc_a)*uint64(cmwc.state[cmwc.index]) + uint64(cmwc.carry)
+	cmwc.carry = uint32(t >> 32)
+	x := uint32(t) + cmwc.carry
+	if x < cmwc.carry {
+		x++
+		cmwc.carry++
+	}
+
+	cmwc.state[cmwc.index] = cmwc_m - x
+	return cmwc.state[cmwc.index]
+}
+
+func (cmwc *CMWC4096) String() string {
+	return "CMWC4096"
+}
@@ -0,0 +1,59 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+)
+
+func TestCMWC4096(t *testing.T) {
+	// the carry, followed by the first lag values (the remaining ones are filled)
+	seed := []uint32{0x1234, 0x9e3779b9, 0x7f4a7c15, 0xf39cc060}
+	rng, err := source32.NewCMWC4096FromStream(seed)
+	if err != nil {
+		t.Fatalf("Error occured: %v", err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data generated from the C code posted by George Marsaglia (comp.lang.c, 2003),
+	 * with the same filled state.
+	 */
+	expected := []uint32{
+		0x15eb4d85, 0x05442177, 0xd9959273, 0x8647e182, 0x8605203e, 0xe5aa4316, 0x92d6712e, 0x9fa2f8ce,
+		0x8a8f9fad, 0x7e6428e8, 0x5f8189f4, 0x6b43d978, 0xd0f21957, 0x28d3ff80, 0x98a3207a, 0x87817b51,
+		0x7adaa529, 0x54309ec6, 0xfc40a00d, 0x651a6210, 0x403853cd, 0x6cc729db, 0x243d4642, 0x8c93b63a,
+		0x011855b0, 0x6dc7873e, 0x819d335b, 0x01cfa9a2, 0x63f8751d, 0x580d6762, 0xb4411e07, 0x96112ba3,
+		0x15b1077b, 0xcc20fc69, 0xcd9b7ca4, 0xb7fb21bf, 0xa933553e, 0x9cb71135, 0xabb93e5e, 0x56fd6b02,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("AfterWrap", func(t *testing.T) {
+		// the values 10000 to 10007, after the lag has been used twice.
+		expected := []uint32{0xbda08817, 0x5393a75b, 0x87c502a0, 0xe1a80fc0, 0x404fc4cb, 0x34669c13, 0x5cf2a975, 0x3594c7e4}
+		r.Restart()
+		for i := 0; i < 10000; i++ {
+			r.Uint32()
+		}
+
+		for i := 0; i < len(expected); i++ {
+			rg := r.Uint32()
+			if expected[i] != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+			}
+		}
+	})
+
+	t.Run("InvalidCarry", func(t *testing.T) {
+		if _, err := source32.NewCMWC4096FromStream([]uint32{18782, 1, 2, 3}); err == nil {
+			t.Errorf("A carry equal to the multiplier should be rejected.")
+		}
+	})
+}
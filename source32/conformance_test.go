@@ -0,0 +1,27 @@
+package source32_test
+
+import (
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	for _, s := range sources {
+		t.Run(s.name, func(t *testing.T) {
+			grandtest.RunSourceTests(t, s.src)
+		})
+	}
+}
+
+func TestReferenceVectors(t *testing.T) {
+	t.Run("XoShiRo128PlusJump", func(t *testing.T) {
+		rng, err := source32.NewXoShiRo128PlusFromStream([]uint32{0x012de1ba, 0xa5a818b8, 0xb124ea2b, 0x18e03749})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/xoshiro128plus_jump.txt")
+	})
+}
@@ -0,0 +1,238 @@
+package source32
+
+import (
+	"math"
+)
+
+const (
+	dsfmt19937_mexp = 19937
+	dsfmt19937_n    = (dsfmt19937_mexp-128)/104 + 1
+	dsfmt19937_pos1 = 117
+	dsfmt19937_sl1  = 19
+	dsfmt19937_sr   = 12
+
+	dsfmt19937_msk1 uint64 = 0x000ffafffffffb3f
+	dsfmt19937_msk2 uint64 = 0x000ffdfffc90fffd
+	dsfmt19937_fix1 uint64 = 0x90014964b32f4329
+	dsfmt19937_fix2 uint64 = 0x3b8d12ac548a7c7a
+	dsfmt19937_pcv1 uint64 = 0x3d84e1ac0dc82880
+	dsfmt19937_pcv2 uint64 = 0x0000000000000001
+
+	// The lanes hold doubles in [1, 2), i.e. 52 random bits below a constant exponent.
+	dsfmt_low_mask   uint64 = 0x000fffffffffffff
+	dsfmt_high_const uint64 = 0x3ff0000000000000
+)
+
+// d128 is a 128-bit lane of dSFMT, stored as two 64-bit words with the least significant word first.
+type d128 [2]uint64
+
+// Implements the double precision SIMD-oriented Fast Mersenne Twister (dSFMT), developed by
+// Mutsuo Saito and Makoto Matsumoto.
+//
+// dSFMT generates doubles in [1, 2) directly (52-bit mantissas), so Float64() and FillFloat64()
+// are much faster than building floats from Uint32(). Uint32() returns the low 32-bits of the
+// next mantissa, as genrand_uint32() does in the reference code.
+//
+// The period is a multiple of 2^19937-1. The recursion is implemented on portable 128-bit lanes.
+// On amd64 it runs in SSE2 assembly, unless the purego build tag is set.
+//
+// Mutsuo Saito and Makoto Matsumoto, A PRNG specialized in double precision floating point numbers
+// using an affine transition. Monte Carlo and Quasi-Monte Carlo Methods 2008, Springer, 2009, pp. 589--602
+// http://www.math.sci.hiroshima-u.ac.jp/~m-mat/MT/SFMT/index.html
+type DSFMT19937 struct {
+	baseJumpableSource32
+	// the last lane is the "lung" of the recursion.
+	state [dsfmt19937_n + 1]d128
+	index int
+}
+
+// The stream is used as the key of init_by_array().
+func NewDSFMT19937FromStream(seed []uint32) (*DSFMT19937, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(DSFMT19937)
+	ans.spi = ans
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewDSFMT19937(seed int64) *DSFMT19937 {
+	ans := new(DSFMT19937)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+// This implements init_by_array() of the reference code.
+func (dsfmt *DSFMT19937) setSeed(key []uint32) {
+	psfmt32 := make([]uint32, 4*(dsfmt19937_n+1))
+	initByArray(psfmt32, key)
+
+	// initial mask
+	for i := 0; i < 2*dsfmt19937_n; i++ {
+		x := (uint64(psfmt32[2*i+1])<<32 | uint64(psfmt32[2*i])) & dsfmt_low_mask
+		x |= dsfmt_high_const
+		psfmt32[2*i], psfmt32[2*i+1] = uint32(x), uint32(x>>32)
+	}
+
+	// period certification
+	lung := 4 * dsfmt19937_n
+	tmp0 := (uint64(psfmt32[lung+1])<<32 | uint64(psfmt32[lung])) ^ dsfmt19937_fix1
+	tmp1 := (uint64(psfmt32[lung+3])<<32 | uint64(psfmt32[lung+2])) ^ dsfmt19937_fix2
+	inner := tmp0&dsfmt19937_pcv1 ^ tmp1&dsfmt19937_pcv2
+	for i := 32; i > 0; i >>= 1 {
+		inner ^= inner >> uint(i)
+	}
+
+	if inner&1 == 0 {
+		psfmt32[lung+2] ^= 1
+	}
+
+	dsfmt.stream = psfmt32
+	dsfmt.Restart()
+}
+
+func (dsfmt *DSFMT19937) Seed(seed int64) {
+	size := 4 * (dsfmt19937_n + 1)
+	seeds := make([]uint32, size)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < size {
+		v := seeder.Uint32()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	dsfmt.setSeed(seeds)
+}
+
+func (dsfmt *DSFMT19937) Restart() {
+	dsfmt.substream = append([]uint32{}, dsfmt.stream...)
+	dsfmt.RestartSubstream()
+}
+
+func (dsfmt *DSFMT19937) RestartSubstream() {
+	s := dsfmt.substream
+	for i := range dsfmt.state {
+		dsfmt.state[i][0] = uint64(s[4*i+1])<<32 | uint64(s[4*i])
+		dsfmt.state[i][1] = uint64(s[4*i+3])<<32 | uint64(s[4*i+2])
+	}
+
+	dsfmt.index = 2 * dsfmt19937_n
+	dsfmt.resetState()
+}
+
+// The jump size is the equivalent of 2^128 steps of the recursion, i.e. 2^129 calls to Float64().
+// The jump polynomial is x^(2^128) mod the minimal polynomial of the (affine) recursion.
+func (dsfmt *DSFMT19937) Jump() {
+	dsfmt.RestartSubstream()
+
+	const n = dsfmt19937_n
+	var win [n]d128
+	var acc [n + 1]d128
+	copy(win[:], dsfmt.state[:n])
+	lung := dsfmt.state[n]
+	var start int
+	horner(dsfmt19937_jump, func(add bool) {
+		if add {
+			for j := 0; j < n; j++ {
+				k := start + j
+				if k >= n {
+					k -= n
+				}
+				acc[j][0] ^= win[k][0]
+				acc[j][1] ^= win[k][1]
+			}
+			acc[n][0] ^= lung[0]
+			acc[n][1] ^= lung[1]
+		}
+
+		b := start + dsfmt19937_pos1
+		if b >= n {
+			b -= n
+		}
+		win[start] = dsfmtRecursion(&win[start], &win[b], &lung)
+		start++
+		if start == n {
+			start = 0
+		}
+	})
+
+	for i := range acc {
+		s := dsfmt.substream[4*i:]
+		s[0], s[1] = uint32(acc[i][0]), uint32(acc[i][0]>>32)
+		s[2], s[3] = uint32(acc[i][1]), uint32(acc[i][1]>>32)
+	}
+
+	dsfmt.RestartSubstream()
+}
+
+func (dsfmt *DSFMT19937) next() uint64 {
+	if dsfmt.index >= 2*dsfmt19937_n {
+		dsfmt.genRandAll()
+		dsfmt.index = 0
+	}
+
+	r := dsfmt.state[dsfmt.index>>1][dsfmt.index&1]
+	dsfmt.index++
+	return r
+}
+
+func (dsfmt *DSFMT19937) Uint32() uint32 {
+	return uint32(dsfmt.next())
+}
+
+// Returns a float64 in [0, 1), the same as genrand_close_open() of the reference code.
+func (dsfmt *DSFMT19937) Float64() float64 {
+	return math.Float64frombits(dsfmt.next()) - 1
+}
+
+// FillFloat64 fills buf with the next len(buf) values of the stream, the same as calling
+// Float64() len(buf) times.
+func (dsfmt *DSFMT19937) FillFloat64(buf []float64) {
+	for i := range buf {
+		if dsfmt.index >= 2*dsfmt19937_n {
+			dsfmt.genRandAll()
+			dsfmt.index = 0
+		}
+
+		buf[i] = math.Float64frombits(dsfmt.state[dsfmt.index>>1][dsfmt.index&1]) - 1
+		dsfmt.index++
+	}
+}
+
+func (dsfmt *DSFMT19937) String() string {
+	return "DSFMT19937"
+}
+
+// Generates the next N lanes, the same as dsfmt_gen_rand_all() of the reference code.
+func (dsfmt *DSFMT19937) genRandAllGeneric() {
+	const n = dsfmt19937_n
+	st := &dsfmt.state
+	lung := st[n]
+	var i int
+	for ; i < n-dsfmt19937_pos1; i++ {
+		st[i] = dsfmtRecursion(&st[i], &st[i+dsfmt19937_pos1], &lung)
+	}
+	for ; i < n; i++ {
+		st[i] = dsfmtRecursion(&st[i], &st[i+dsfmt19937_pos1-n], &lung)
+	}
+	st[n] = lung
+}
+
+// The recursion of dSFMT, which also updates the lung.
+func dsfmtRecursion(a, b, lung *d128) (r d128) {
+	t0, t1 := a[0], a[1]
+	l0, l1 := lung[0], lung[1]
+	lung[0] = (t0 << dsfmt19937_sl1) ^ (l1 >> 32) ^ (l1 << 32) ^ b[0]
+	lung[1] = (t1 << dsfmt19937_sl1) ^ (l0 >> 32) ^ (l0 << 32) ^ b[1]
+	r[0] = (lung[0] >> dsfmt19937_sr) ^ (lung[0] & dsfmt19937_msk1) ^ t0
+	r[1] = (lung[1] >> dsfmt19937_sr) ^ (lung[1] & dsfmt19937_msk2) ^ t1
+	return
+}
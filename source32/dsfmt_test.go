@@ -0,0 +1,69 @@
+package source32_test
+
+import (
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+	"testing"
+)
+
+func TestDSFMT19937(t *testing.T) {
+	/*
+	 * Data from running a C build of the reference algorithm (dSFMT 2.2), seeded with dsfmt_init_by_array().
+	 */
+	rng, err := source32.NewDSFMT19937FromStream([]uint32{1, 2, 3, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grandtest.CheckVectors(t, rng, "testdata/dsfmt19937.txt")
+
+	t.Run("Float64", func(t *testing.T) {
+		rng.Restart()
+
+		// dsfmt_genrand_close_open()
+		expected := []float64{
+			0.42683407684592445, 0.66957357522698357, 0.16134894337663219, 0.21879031352245071,
+			0.40985981974183661, 0.50758506892872468, 0.15766516087023885, 0.76332016643296763,
+			0.24411603772732016, 0.47163144337320917, 0.54895078501209538, 0.63171210586731341,
+			0.6468544152485165, 0.44150507042799081, 0.779880230398589, 0.26525025994811569,
+		}
+
+		for i := 0; i < len(expected); i++ {
+			rg := rng.Float64()
+			if expected[i] != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+			}
+		}
+	})
+
+	t.Run("FillFloat64", func(t *testing.T) {
+		rng.Restart()
+		want := make([]float64, 10000)
+		for i := range want {
+			want[i] = rng.Float64()
+		}
+
+		rng.Restart()
+		got := make([]float64, len(want))
+		for i, n := 0, 1; i < len(got); n += 1000 {
+			if i+n > len(got) {
+				n = len(got) - i
+			}
+			rng.FillFloat64(got[i : i+n])
+			i += n
+		}
+
+		for i := range want {
+			if want[i] != got[i] {
+				t.Fatalf("Mismatch at index %d. want: %v, got: %v", i, want[i], got[i])
+			}
+		}
+	})
+
+	t.Run("Jump", func(t *testing.T) {
+		rng.Restart()
+		rng.Float64()
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/dsfmt19937_jump.txt")
+	})
+}
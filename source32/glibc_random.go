@@ -0,0 +1,123 @@
+package source32
+
+const (
+	// the degree and the separation of the TYPE_3 generator of glibc's random().
+	glibcrandom_deg = 31
+	glibcrandom_sep = 3
+	glibcrandom_w   = 31
+)
+
+// Implements random() of the GNU C library with its default state size of 128 bytes (TYPE_3), the additive
+// feedback generator x(n) = x(n-31) + x(n-3) mod 2^32 of which the high 31 bits are returned, with glibc's
+// seeding: srandom(seed) fills the state with the LCG x(n+1) = 16807*x(n) mod (2^31-1) and discards 310 values.
+//
+// Next() returns the 31-bit values of random(), while Uint32() packs them into 32-bit values without dropping
+// any bit (32 values for 31 calls).
+//
+// The state size is 124 bytes; the period is about 16*(2^31-1).
+//
+// https://sourceware.org/git/?p=glibc.git;a=blob;f=stdlib/random_r.c
+type GlibcRandom struct {
+	baseSource32
+	r          [glibcrandom_deg]uint32
+	fptr, rptr int
+	// pool holds the npool bits of the last value that were not returned by Uint32().
+	pool  uint32
+	npool uint
+}
+
+// The first value is the seed of srandom().
+func NewGlibcRandomFromStream(seed []uint32) (*GlibcRandom, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(GlibcRandom)
+	ans.spi = ans
+	ans.stream = []uint32{seed[0]}
+	ans.Restart()
+	return ans, nil
+}
+
+// NewGlibcRandom returns the generator of srandom(seed), the seed is truncated to 32 bits as the unsigned int of srandom().
+func NewGlibcRandom(seed int64) *GlibcRandom {
+	ans := new(GlibcRandom)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+// Seed uses the seed (truncated to 32 bits) as srandom() does.
+func (gr *GlibcRandom) Seed(seed int64) {
+	gr.stream = []uint32{uint32(seed)}
+	gr.Restart()
+}
+
+func (gr *GlibcRandom) Restart() {
+	seed := gr.stream[0]
+	// glibc takes 1 for a zero seed.
+	if seed == 0 {
+		seed = 1
+	}
+
+	// glibc keeps the seed word as an int32_t: seeds of 2^31 and above go through the recurrence as negative numbers.
+	gr.r[0] = seed
+	word := int64(int32(seed))
+	for i := 1; i < glibcrandom_deg; i++ {
+		// r[i] = 16807*r[i-1] mod (2^31-1), without overflowing 31 bits.
+		hi := word / 127773
+		lo := word % 127773
+		word = 16807*lo - 2836*hi
+		if word < 0 {
+			word += 2147483647
+		}
+		gr.r[i] = uint32(word)
+	}
+
+	gr.fptr = glibcrandom_sep
+	gr.rptr = 0
+	for i := 0; i < 10*glibcrandom_deg; i++ {
+		gr.Next()
+	}
+
+	gr.pool = 0
+	gr.npool = 0
+	gr.resetState()
+}
+
+// Next returns the next 31-bit value of the generator, the output of random().
+func (gr *GlibcRandom) Next() uint32 {
+	gr.r[gr.fptr] += gr.r[gr.rptr]
+	ans := gr.r[gr.fptr] >> 1
+
+	gr.fptr++
+	if gr.fptr == glibcrandom_deg {
+		gr.fptr = 0
+	}
+
+	gr.rptr++
+	if gr.rptr == glibcrandom_deg {
+		gr.rptr = 0
+	}
+
+	return ans
+}
+
+func (gr *GlibcRandom) Uint32() uint32 {
+	ans, n := gr.pool, gr.npool
+	for {
+		x := gr.Next()
+		ans |= x << n
+		if n+glibcrandom_w >= 32 {
+			gr.pool = x >> (32 - n)
+			gr.npool = n + glibcrandom_w - 32
+			return ans
+		}
+		n += glibcrandom_w
+	}
+}
+
+func (gr *GlibcRandom) String() string {
+	return "GlibcRandom"
+}
@@ -0,0 +1,79 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/source32"
+)
+
+func TestGlibcRandom(t *testing.T) {
+	rng, err := source32.NewGlibcRandomFromStream([]uint32{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/*
+	 * Data from random() of glibc 2.36 after srandom(42).
+	 */
+	expected := []uint32{
+		71876166, 708592740, 1483128881, 907283241, 442951012, 537146758, 1366999021, 1854614940,
+		647800535, 53523743, 783815874, 1643643143, 682599717, 291474504, 229233696, 1633529762,
+		175389892, 1183169448, 1212580698, 1596161259, 2108313867, 469976352, 975807809, 1113801033,
+		1232315727, 1192349579, 1564541169, 1350496504, 1709672141, 1253520176, 590056433, 1781548307,
+		1962112916, 2073185314, 541347900, 257580280, 462848424, 1908346921, 2112195221, 1110648960,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := rng.Next()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("SeedOne", func(t *testing.T) {
+		// the first values of random() without seeding, or after srandom(0) or srandom(1).
+		expected := []uint32{1804289383, 846930886, 1681692777, 1714636915, 1957747793}
+		for _, seed := range []int64{0, 1} {
+			rng := source32.NewGlibcRandom(seed)
+			for i := 0; i < len(expected); i++ {
+				rg := rng.Next()
+				if expected[i] != rg {
+					t.Errorf("Mismatch for seed %d. want: %v, got: %v", seed, expected[i], rg)
+				}
+			}
+		}
+	})
+
+	t.Run("LargeSeed", func(t *testing.T) {
+		// random() of glibc 2.36 after srandom(123456789), srandom(3000000000) and srandom(0xffffffff), and
+		// after srandom((unsigned)-1294967296), the truncation of the int64 seed -1294967296.
+		cases := []struct {
+			seed     int64
+			expected []uint32
+		}{
+			{123456789, []uint32{1965102536, 1639725855, 706684578, 1926601937, 71238646, 1147998030, 1038816544, 940714160}},
+			{3000000000, []uint32{2058147116, 854483408, 922419988, 286396165, 2068523933, 1172167191, 573677598, 1899216469}},
+			{0xffffffff, []uint32{254925627, 1205188300, 366127624, 1401405153, 76053476, 1604170158, 1302235366, 362229243}},
+			{-1294967296, []uint32{2058147116, 854483408, 922419988, 286396165, 2068523933, 1172167191, 573677598, 1899216469}},
+		}
+
+		for _, c := range cases {
+			rng := source32.NewGlibcRandom(c.seed)
+			for i := 0; i < len(c.expected); i++ {
+				rg := rng.Next()
+				if c.expected[i] != rg {
+					t.Errorf("Mismatch for seed %d. want: %v, got: %v", c.seed, c.expected[i], rg)
+				}
+			}
+		}
+	})
+
+	t.Run("Uint32", func(t *testing.T) {
+		rng.Restart()
+		values := source32.NewGlibcRandom(42)
+		x0, x1 := values.Next(), values.Next()
+		if want, got := x0|x1<<31, rng.Uint32(); want != got {
+			t.Errorf("Mismatch. want: %v, got: %v", want, got)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package source32
+
+import "math"
+
+const (
+	javarandom_mult uint64 = 0x5DEECE66D
+	javarandom_add  uint64 = 0xB
+	javarandom_mask uint64 = 1<<48 - 1
+	// 2^-53 and 2^-24
+	javarandom_double_unit float64 = 1.0 / (1 << 53)
+	javarandom_float_unit  float32 = 1.0 / (1 << 24)
+)
+
+// Implements java.util.Random, the 48-bit LCG x(n+1) = 0x5DEECE66D*x(n) + 11 mod 2^48 of which the high bits
+// are returned, with Java's seeding: the seed is scrambled as (seed ^ 0x5DEECE66D) & (2^48-1).
+// NewJavaRandom(seed) and Seed(seed) behave as new Random(seed) and setSeed(seed), the Next* methods return the
+// values of the Java methods of the same name (NextGaussian() uses a port of fdlibm's log, as StrictMath.log).
+// Uint32() is nextInt().
+//
+// The state size is 48 bits; the period is 2^48.
+//
+// https://docs.oracle.com/javase/8/docs/api/java/util/Random.html
+type JavaRandom struct {
+	baseSource32
+	seed uint64
+	// nextNextGaussian is the second value of the last pair of NextGaussian().
+	nextNextGaussian     float64
+	haveNextNextGaussian bool
+}
+
+// A single value is the seed of new Random(seed), longer streams hold the low and high 32 bits of the seed.
+func NewJavaRandomFromStream(seed []uint32) (*JavaRandom, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	s := uint64(seed[0])
+	if len(seed) > 1 {
+		s = uint64(seed[1])<<32 | uint64(seed[0])
+	}
+
+	ans := new(JavaRandom)
+	ans.spi = ans
+	ans.Seed(int64(s))
+	return ans, nil
+}
+
+// NewJavaRandom returns the generator of new Random(seed).
+func NewJavaRandom(seed int64) *JavaRandom {
+	ans := new(JavaRandom)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+// Seed uses the seed as setSeed() does.
+func (jr *JavaRandom) Seed(seed int64) {
+	jr.stream = []uint32{uint32(seed), uint32(uint64(seed) >> 32)}
+	jr.Restart()
+}
+
+func (jr *JavaRandom) Restart() {
+	seed := uint64(jr.stream[1])<<32 | uint64(jr.stream[0])
+	jr.seed = (seed ^ javarandom_mult) & javarandom_mask
+	jr.haveNextNextGaussian = false
+	jr.resetState()
+}
+
+// Advances the LCG and returns its given number (at most 32) of high bits, as next(bits) in Java.
+func (jr *JavaRandom) next(bits uint) uint32 {
+	jr.seed = (jr.seed*javarandom_mult + javarandom_add) & javarandom_mask
+	return uint32(jr.seed >> (48 - bits))
+}
+
+func (jr *JavaRandom) Uint32() uint32 {
+	return jr.next(32)
+}
+
+// NextInt returns the value of nextInt().
+func (jr *JavaRandom) NextInt() int32 {
+	return int32(jr.next(32))
+}
+
+// NextIntn returns the value of nextInt(bound), a value in [0, bound). It panics if bound <= 0.
+func (jr *JavaRandom) NextIntn(bound int32) int32 {
+	if bound <= 0 {
+		panic("invalid argument to NextIntn")
+	}
+
+	r := int32(jr.next(31))
+	m := bound - 1
+	if bound&m == 0 {
+		// bound is a power of 2
+		return int32((int64(bound) * int64(r)) >> 31)
+	}
+
+	// the int arithmetic of Java wraps around, as the one of Go.
+	for u := r; ; u = int32(jr.next(31)) {
+		r = u % bound
+		if u-r+m >= 0 {
+			return r
+		}
+	}
+}
+
+// NextLong returns the value of nextLong().
+func (jr *JavaRandom) NextLong() int64 {
+	hi := int64(int32(jr.next(32)))
+	return hi<<32 + int64(int32(jr.next(32)))
+}
+
+// NextBoolean returns the value of nextBoolean().
+func (jr *JavaRandom) NextBoolean() bool {
+	return jr.next(1) != 0
+}
+
+// NextFloat returns the value of nextFloat(), in [0, 1).
+func (jr *JavaRandom) NextFloat() float32 {
+	return float32(jr.next(24)) * javarandom_float_unit
+}
+
+// NextDouble returns the value of nextDouble(), in [0, 1).
+func (jr *JavaRandom) NextDouble() float64 {
+	hi := uint64(jr.next(26))
+	return float64(hi<<27+uint64(jr.next(27))) * javarandom_double_unit
+}
+
+// NextGaussian returns the value of nextGaussian(), with Marsaglia's polar method:
+// the values are computed by pairs and the second one is returned by the next call.
+func (jr *JavaRandom) NextGaussian() float64 {
+	if jr.haveNextNextGaussian {
+		jr.haveNextNextGaussian = false
+		return jr.nextNextGaussian
+	}
+
+	var v1, v2, s float64
+	for {
+		v1 = 2*jr.NextDouble() - 1
+		v2 = 2*jr.NextDouble() - 1
+		s = float64(v1*v1) + float64(v2*v2)
+		if s < 1 && s != 0 {
+			break
+		}
+	}
+
+	multiplier := math.Sqrt(-2 * fdlibmLog(s) / s)
+	jr.nextNextGaussian = v2 * multiplier
+	jr.haveNextNextGaussian = true
+	return v1 * multiplier
+}
+
+func (jr *JavaRandom) String() string {
+	return "JavaRandom"
+}
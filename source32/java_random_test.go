@@ -0,0 +1,77 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/source32"
+)
+
+func TestJavaRandom(t *testing.T) {
+	rng, err := source32.NewJavaRandomFromStream([]uint32{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/*
+	 * Data from new java.util.Random(42).nextInt().
+	 */
+	expected := []int32{
+		-1170105035, 234785527, -1360544799, 205897768, 1325939940, -248792245, 1190043011, -1255373459,
+		-1436456258, 392236186, -415012931, 1938135004, 1583910553, 1639144584, 1184328952, -1329611232,
+		1991376913, -1021814956, -932429809, -7822877, -346484495, 652655727, 1874714453, 1888925827,
+		-1074144810, -297936814, 1660292060, -866352379, 761834774, 646596492, -1742253836, 1453021513,
+		900944861, 1076582170, -747470862, 1568355455, 739670425, 681416186, -1771985870, 1177562329,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := rng.NextInt()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("NextLong", func(t *testing.T) {
+		rng := source32.NewJavaRandom(42)
+		for _, want := range []int64{-5025562857975149833, -5843495416241995736} {
+			if got := rng.NextLong(); want != got {
+				t.Errorf("Mismatch. want: %v, got: %v", want, got)
+			}
+		}
+	})
+
+	t.Run("NextIntn", func(t *testing.T) {
+		rng := source32.NewJavaRandom(42)
+		bounds := []int32{10, 100, 1000, 16, 7, 1<<30 + 1}
+		expected := []int32{0, 63, 248, 0, 5, 595021505}
+		for i, bound := range bounds {
+			if got := rng.NextIntn(bound); expected[i] != got {
+				t.Errorf("Mismatch for bound %d. want: %v, got: %v", bound, expected[i], got)
+			}
+		}
+	})
+
+	t.Run("NextDouble", func(t *testing.T) {
+		if got := source32.NewJavaRandom(0).NextDouble(); got != 0.730967787376657 {
+			t.Errorf("Mismatch. want: %v, got: %v", 0.730967787376657, got)
+		}
+	})
+
+	t.Run("NextGaussian", func(t *testing.T) {
+		seeds := []int64{42, 0}
+		expected := []float64{1.1419053154730547, 0.8025330637390305}
+		for i, seed := range seeds {
+			if got := source32.NewJavaRandom(seed).NextGaussian(); expected[i] != got {
+				t.Errorf("Mismatch for seed %d. want: %v, got: %v", seed, expected[i], got)
+			}
+		}
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		rng := source32.NewJavaRandom(42)
+		rng.NextGaussian()
+		rng.Restart()
+		if got := rng.NextGaussian(); got != 1.1419053154730547 {
+			t.Errorf("Restart() should discard the pending Gaussian value, got: %v", got)
+		}
+	})
+}
@@ -0,0 +1,186 @@
+package source32
+
+import "math"
+
+// The floating-point code of the legacy generators must round as their reference implementations do:
+// the explicit conversions to float64 below prevent the compiler from fusing a multiplication and an
+// addition into a single FMA instruction.
+
+const (
+	fdlibm_ln2_hi = 6.93147180369123816490e-01 // 3fe62e42 fee00000
+	fdlibm_ln2_lo = 1.90821492927058770002e-10 // 3dea39ef 35793c76
+	fdlibm_two54  = 1.80143985094819840000e+16 // 43500000 00000000
+	fdlibm_lg1    = 6.666666666666735130e-01   // 3FE55555 55555593
+	fdlibm_lg2    = 3.999999999940941908e-01   // 3FD99999 9997FA04
+	fdlibm_lg3    = 2.857142874366239149e-01   // 3FD24924 94229359
+	fdlibm_lg4    = 2.222219843214978396e-01   // 3FCC71C5 1D8E78AF
+	fdlibm_lg5    = 1.818357216161805012e-01   // 3FC74664 96CB03DE
+	fdlibm_lg6    = 1.531383769920937332e-01   // 3FC39A09 D078C69F
+	fdlibm_lg7    = 1.479819860511658591e-01   // 3FC2F112 DF3E5244
+)
+
+// polyEval returns c[0]*x^(n-1) + c[1]*x^(n-2) + ... + c[n-1], evaluated with Horner's rule.
+func polyEval(x float64, c ...float64) float64 {
+	ans := c[0]
+	for _, v := range c[1:] {
+		ans = float64(ans*x) + v
+	}
+
+	return ans
+}
+
+// Returns the natural logarithm of x, as __ieee754_log() of fdlibm 5.3 (and thus Java's StrictMath.log()).
+// Go's math.Log is not bit-compatible with it.
+func fdlibmLog(x float64) float64 {
+	bits := math.Float64bits(x)
+	hx := int32(bits >> 32)
+	lx := uint32(bits)
+
+	k := int32(0)
+	if hx < 0x00100000 { // x < 2^-1022
+		if (hx&0x7fffffff)|int32(lx) == 0 {
+			return math.Inf(-1)
+		}
+		if hx < 0 {
+			return math.NaN()
+		}
+		// subnormal number, scale up x
+		k -= 54
+		x *= fdlibm_two54
+		hx = int32(math.Float64bits(x) >> 32)
+	}
+	if hx >= 0x7ff00000 {
+		return x + x
+	}
+
+	k += (hx >> 20) - 1023
+	hx &= 0x000fffff
+	i := (hx + 0x95f64) & 0x100000
+	// normalize x or x/2
+	x = math.Float64frombits(uint64(uint32(hx|(i^0x3ff00000)))<<32 | math.Float64bits(x)&0xffffffff)
+	k += i >> 20
+	f := x - 1.0
+	dk := float64(k)
+	if (0x000fffff & (2 + hx)) < 3 { // |f| < 2^-20
+		if f == 0 {
+			if k == 0 {
+				return 0
+			}
+
+			return float64(dk*fdlibm_ln2_hi) + float64(dk*fdlibm_ln2_lo)
+		}
+
+		r := float64(f*f) * (0.5 - float64(0.33333333333333333*f))
+		if k == 0 {
+			return f - r
+		}
+
+		return float64(dk*fdlibm_ln2_hi) - ((r - float64(dk*fdlibm_ln2_lo)) - f)
+	}
+
+	s := f / (2.0 + f)
+	z := s * s
+	i = hx - 0x6147a
+	w := z * z
+	j := 0x6b851 - hx
+	t1 := w * polyEval(w, fdlibm_lg6, fdlibm_lg4, fdlibm_lg2)
+	t2 := z * polyEval(w, fdlibm_lg7, fdlibm_lg5, fdlibm_lg3, fdlibm_lg1)
+	i |= j
+	r := float64(t2) + float64(t1)
+	if i > 0 {
+		hfsq := float64(0.5*f) * f
+		if k == 0 {
+			return f - (hfsq - float64(s*(hfsq+r)))
+		}
+
+		return float64(dk*fdlibm_ln2_hi) - ((hfsq - (float64(s*(hfsq+r)) + float64(dk*fdlibm_ln2_lo))) - f)
+	}
+
+	if k == 0 {
+		return f - float64(s*(f-r))
+	}
+
+	return float64(dk*fdlibm_ln2_hi) - ((float64(s*(f-r)) - float64(dk*fdlibm_ln2_lo)) - f)
+}
+
+// A double-double number hi + lo, with |lo| <= ulp(hi)/2.
+type ddouble struct {
+	hi, lo float64
+}
+
+var (
+	// log(2) as a double-double.
+	dd_ln2 = ddouble{6.93147180559945286227e-01, 2.31904681384629955842e-17}
+)
+
+func ddTwoSum(a, b float64) ddouble {
+	s := a + b
+	bb := s - a
+	return ddouble{s, (a - (s - bb)) + (b - bb)}
+}
+
+func ddAdd(a, b ddouble) ddouble {
+	s := ddTwoSum(a.hi, b.hi)
+	t := ddTwoSum(a.lo, b.lo)
+	s = ddTwoSum(s.hi, s.lo+t.hi)
+	return ddTwoSum(s.hi, s.lo+t.lo)
+}
+
+func ddMul(a, b ddouble) ddouble {
+	p := a.hi * b.hi
+	e := math.FMA(a.hi, b.hi, -p)
+	e += float64(a.hi*b.lo) + float64(a.lo*b.hi)
+	return ddTwoSum(p, e)
+}
+
+func ddDiv(a, b ddouble) ddouble {
+	q1 := a.hi / b.hi
+	r := ddAdd(a, ddMul(ddouble{-q1, 0}, b))
+	q2 := r.hi / b.hi
+	r = ddAdd(r, ddMul(ddouble{-q2, 0}, b))
+	q3 := r.hi / b.hi
+	return ddAdd(ddTwoSum(q1, q2), ddouble{q3, 0})
+}
+
+// Returns the natural logarithm of x correctly rounded (but in cases closer to a tie than 2^-100 ulp),
+// as the log() of the C libraries used by NumPy and R, but for the values where these are not correctly
+// rounded (about 0.04% of them for glibc, while math.Log differs for about 4% of them).
+// It is computed in double-double arithmetic as k*log(2) + 2*atanh(s), with s = (m-1)/(m+1) and
+// x = m*2^k, sqrt(2)/2 <= m < sqrt(2).
+func crLog(x float64) float64 {
+	switch {
+	case math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	case math.IsInf(x, 1):
+		return x
+	}
+
+	m, k := math.Frexp(x)
+	if m < math.Sqrt2/2 {
+		m *= 2
+		k--
+	}
+	if m == 1 {
+		return float64(k) * dd_ln2.hi
+	}
+
+	// m-1 is exact for m in [1/2, 2].
+	s := ddDiv(ddouble{m - 1, 0}, ddTwoSum(m, 1))
+	z := ddMul(s, s)
+
+	// atanh(s)/s = sum of z^i/(2i+1), |z| < 0.0295 and 22 terms give more than 106 bits.
+	const terms = 22
+	var p ddouble
+	for i := terms - 1; i >= 0; i-- {
+		d := float64(2*i + 1)
+		c := 1 / d
+		inv := ddouble{c, -math.FMA(c, d, -1) / d}
+		p = ddAdd(ddMul(p, z), inv)
+	}
+
+	ans := ddMul(ddouble{2 * s.hi, 2 * s.lo}, p)
+	ans = ddAdd(ddMul(ddouble{float64(k), 0}, dd_ln2), ans)
+	return ans.hi + ans.lo
+}
@@ -0,0 +1,163 @@
+package source32
+
+const (
+	minstd_m uint64 = 1<<31 - 1
+	minstd_w        = 31
+	// the multipliers of std::minstd_rand and std::minstd_rand0.
+	minstd_a  uint64 = 48271
+	minstd_a0 uint64 = 16807
+)
+
+// This serves as the base struct of the Lehmer generators x(n+1) = a*x(n) mod 2^31-1 of the C++ standard library.
+type baseMinStd struct {
+	baseSource32
+	a, x uint64
+	// pool holds the npool bits of the last value that were not returned by Uint32().
+	pool  uint32
+	npool uint
+}
+
+// Seeds the generator as seed(value) of std::linear_congruential_engine: the seed is reduced modulo 2^31-1,
+// and 1 replaces 0. The seed is a 64-bit unsigned integer, as uint_fast32_t of libstdc++ on 64-bit platforms.
+// The stream holds the seed reduced modulo 2^31-1.
+func (ms *baseMinStd) setSeed(seed uint64) {
+	ms.stream = []uint32{uint32(seed % minstd_m)}
+	ms.restart()
+}
+
+// Returns the seed given by the stream of the constructors.
+func (ms *baseMinStd) streamSeed(seed []uint32) uint64 {
+	if len(seed) == 1 {
+		return uint64(seed[0])
+	}
+
+	return uint64(seed[1])<<32 | uint64(seed[0])
+}
+
+func (ms *baseMinStd) restart() {
+	ms.x = uint64(ms.stream[0])
+	if ms.x == 0 {
+		ms.x = 1
+	}
+
+	ms.pool = 0
+	ms.npool = 0
+	ms.resetState()
+}
+
+// Next returns the next value of the generator, in [1, 2^31-2].
+func (ms *baseMinStd) Next() uint32 {
+	ms.x = ms.a * ms.x % minstd_m
+	return uint32(ms.x)
+}
+
+// The values are uniform on [1, 2^31-2], so the 31 bits packed by Uint32() are very slightly biased.
+func (ms *baseMinStd) Uint32() uint32 {
+	ans, n := ms.pool, ms.npool
+	for {
+		x := ms.Next()
+		ans |= x << n
+		if n+minstd_w >= 32 {
+			ms.pool = x >> (32 - n)
+			ms.npool = n + minstd_w - 32
+			return ans
+		}
+		n += minstd_w
+	}
+}
+
+// Implements std::minstd_rand of the C++ standard library, the Lehmer generator x(n+1) = 48271*x(n) mod 2^31-1
+// of Park, Miller and Stockmeyer, with its seeding: the seed is reduced modulo 2^31-1 and 0 is replaced by 1.
+// Next() returns the values of std::minstd_rand, while Uint32() packs their 31 bits into 32-bit values.
+//
+// The state size is 31 bits; the period is 2^31-2.
+//
+// S. K. Park, K. W. Miller and P. K. Stockmeyer, Another Test for Randomness: Response.
+// Communications of the ACM, 1993, 36-7, 108--110.
+type MinStdRand struct {
+	baseMinStd
+}
+
+// A single value is the seed of std::minstd_rand(seed), longer streams hold the low and high 32 bits of the seed.
+func NewMinStdRandFromStream(seed []uint32) (*MinStdRand, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(MinStdRand)
+	ans.spi = ans
+	ans.a = minstd_a
+	ans.setSeed(ans.streamSeed(seed))
+	return ans, nil
+}
+
+// NewMinStdRand returns the generator of std::minstd_rand(seed).
+func NewMinStdRand(seed int64) *MinStdRand {
+	ans := new(MinStdRand)
+	ans.spi = ans
+	ans.a = minstd_a
+	ans.Seed(seed)
+	return ans
+}
+
+// Seed uses the seed as seed(value) does (negative seeds wrap around to 64-bit unsigned integers).
+func (ms *MinStdRand) Seed(seed int64) {
+	ms.setSeed(uint64(seed))
+}
+
+func (ms *MinStdRand) Restart() {
+	ms.restart()
+}
+
+func (ms *MinStdRand) String() string {
+	return "MinStdRand"
+}
+
+// Implements std::minstd_rand0 of the C++ standard library, the original "minimal standard" Lehmer generator
+// x(n+1) = 16807*x(n) mod 2^31-1 of Park and Miller, with the seeding of std::minstd_rand.
+// Next() returns the values of std::minstd_rand0, while Uint32() packs their 31 bits into 32-bit values.
+//
+// The state size is 31 bits; the period is 2^31-2.
+//
+// S. K. Park and K. W. Miller, Random number generators: good ones are hard to find.
+// Communications of the ACM, 1988, 31-10, 1192--1201.
+type MinStdRand0 struct {
+	baseMinStd
+}
+
+// A single value is the seed of std::minstd_rand0(seed), longer streams hold the low and high 32 bits of the seed.
+func NewMinStdRand0FromStream(seed []uint32) (*MinStdRand0, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(MinStdRand0)
+	ans.spi = ans
+	ans.a = minstd_a0
+	ans.setSeed(ans.streamSeed(seed))
+	return ans, nil
+}
+
+// NewMinStdRand0 returns the generator of std::minstd_rand0(seed).
+func NewMinStdRand0(seed int64) *MinStdRand0 {
+	ans := new(MinStdRand0)
+	ans.spi = ans
+	ans.a = minstd_a0
+	ans.Seed(seed)
+	return ans
+}
+
+// Seed uses the seed as seed(value) does (negative seeds wrap around to 64-bit unsigned integers).
+func (ms *MinStdRand0) Seed(seed int64) {
+	ms.setSeed(uint64(seed))
+}
+
+func (ms *MinStdRand0) Restart() {
+	ms.restart()
+}
+
+func (ms *MinStdRand0) String() string {
+	return "MinStdRand0"
+}
@@ -0,0 +1,90 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/source32"
+)
+
+func TestMinStdRand(t *testing.T) {
+	t.Run("TenThousandth", func(t *testing.T) {
+		// the values required by the C++ standard for default-constructed engines.
+		rng, rng0 := source32.NewMinStdRand(1), source32.NewMinStdRand0(1)
+		var got, got0 uint32
+		for i := 0; i < 10000; i++ {
+			got, got0 = rng.Next(), rng0.Next()
+		}
+		if got != 399268537 {
+			t.Errorf("Mismatch. want: %v, got: %v", 399268537, got)
+		}
+		if got0 != 1043618065 {
+			t.Errorf("Mismatch. want: %v, got: %v", 1043618065, got0)
+		}
+	})
+
+	rng, err := source32.NewMinStdRandFromStream([]uint32{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/*
+	 * Data from std::minstd_rand(42) of libstdc++.
+	 */
+	expected := []uint32{
+		2027382, 1226992407, 551494037, 961371815, 1404753842, 2076553157, 1350734175, 1538354858,
+		90320905, 488601845, 1634248641, 1151860813, 974199846, 3864260, 1848100818, 1056405651,
+		1757981406, 1704137821, 1075659156, 1283502110, 1027135860, 1920139771, 1672681421, 914713185,
+		1856370815, 825472496, 1971267978, 76167468, 187844164, 749682810, 691985913, 891360985,
+		2051239290, 1343255361, 1305776960, 367113063, 2027092676, 1845671288, 1892163606, 2002434669,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := rng.Next()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("LargeSeed", func(t *testing.T) {
+		// std::minstd_rand(0x123456789abc), the seed is reduced modulo 2^31-1.
+		rng, err := source32.NewMinStdRandFromStream([]uint32{0x56789abc, 0x1234})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := rng.Next(); got != 2043786365 {
+			t.Errorf("Mismatch. want: %v, got: %v", 2043786365, got)
+		}
+	})
+
+	t.Run("ZeroSeed", func(t *testing.T) {
+		if want, got := source32.NewMinStdRand(1).Next(), source32.NewMinStdRand(0).Next(); want != got {
+			t.Errorf("The seed 0 should be replaced by 1. want: %v, got: %v", want, got)
+		}
+	})
+}
+
+func TestMinStdRand0(t *testing.T) {
+	rng, err := source32.NewMinStdRand0FromStream([]uint32{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/*
+	 * Data from std::minstd_rand0(42) of libstdc++.
+	 */
+	expected := []uint32{
+		705894, 1126542223, 1579310009, 565444343, 807934826, 421520601, 2095673201, 1100194760,
+		1139130650, 552121545, 229968128, 1751246343, 1933904666, 970724117, 526968160, 531304892,
+		404315618, 694332618, 222172928, 1733822410, 1147638727, 1813450982, 1582736250, 168218361,
+		1157513875, 281338952, 1852259217, 997713207, 1013554273, 966378307, 501383488, 26451988,
+		49447387, 2133545567, 1965890610, 1687573175, 1225826296, 1651931201, 1339106791, 739215777,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := rng.Next()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package source32
 
+import (
+	"math"
+
+	"github.com/jtejido/grand/internal/modmath"
+)
+
 const (
 	mrg32k3a_m1   uint32 = 4294967087
 	mrg32k3a_m2   uint32 = 4294944443
@@ -8,15 +14,21 @@ const (
 	mrg32k3a_a21  uint32 = 527612
 	mrg32k3a_a23n uint32 = 1370589
 	mrg32k3a_r           = 6
+	// 1/(m1 + 1), used by L'Ecuyer to normalize the output to (0,1).
+	mrg32k3a_norm float64 = 2.328306549295727688e-10
+	// 1/m1, used by cuRAND to normalize the output to (0,1].
+	mrg32k3a_curand_norm float64 = 2.3283065498378288e-10
+	// MRG32K3A_BITS_NORM of curand_kernel.h, which scales the output to 32 bits for curand().
+	mrg32k3a_curand_bits_norm float64 = 1.000000048662
 )
 
 var (
-	a1p76 = [][]uint32{
+	a1p76 = modmath.Matrix{
 		{82758667, 1871391091, 4127413238},
 		{3672831523, 69195019, 1871391091},
 		{3672091415, 3528743235, 69195019},
 	}
-	a2p76 = [][]uint32{
+	a2p76 = modmath.Matrix{
 		{1511326704, 3759209742, 1610795712},
 		{4292754251, 1511326704, 3889917532},
 		{3859662829, 4292754251, 3708466080},
@@ -75,6 +87,47 @@ func NewMRG32k3AFromStream(seed []uint32) (ans *MRG32k3A, err error) {
 	return
 }
 
+// NewCuRANDMRG32k3A returns the generator of curand_init(seed, subsequence, offset) for cuRAND's MRG32k3a:
+// the seed is mixed into L'Ecuyer's default seed (12345 for every value, kept for a zero seed), which is
+// skipped ahead by subsequence*2^76 + offset values, i.e. the subsequences are the substreams of Jump().
+// An error is returned in the unlikely case where the seed makes the state of a component all 0.
+// CurandUint32(), CurandFloat32() and CurandFloat64() return the values of curand(), curand_uniform()
+// and curand_uniform_double().
+//
+// https://docs.nvidia.com/cuda/curand/device-api-overview.html
+func NewCuRANDMRG32k3A(seed, subsequence, offset uint64) (*MRG32k3A, error) {
+	s := []uint32{12345, 12345, 12345, 12345, 12345, 12345}
+	if seed != 0 {
+		x1 := uint64(uint32(seed) ^ 0x55555555)
+		x2 := uint64(uint32(seed>>32) ^ 0xAAAAAAAA)
+		m1, m2 := uint64(mrg32k3a_m1), uint64(mrg32k3a_m2)
+		s[0] = uint32(x1 * uint64(s[0]) % m1)
+		s[1] = uint32(x2 * uint64(s[1]) % m1)
+		s[2] = uint32(x1 * uint64(s[2]) % m1)
+		s[3] = uint32(x2 * uint64(s[3]) % m2)
+		s[4] = uint32(x1 * uint64(s[4]) % m2)
+		s[5] = uint32(x2 * uint64(s[5]) % m2)
+	}
+
+	if subsequence != 0 {
+		multMatVect(s, a1p76.Pow(subsequence, uint64(mrg32k3a_m1)), mrg32k3a_m1, a2p76.Pow(subsequence, uint64(mrg32k3a_m2)), mrg32k3a_m2)
+	}
+	if offset != 0 {
+		a1 := modmath.Companion([]uint64{0, uint64(mrg32k3a_a12), uint64(mrg32k3a_m1 - mrg32k3a_a13n)}, uint64(mrg32k3a_m1))
+		a2 := modmath.Companion([]uint64{uint64(mrg32k3a_a21), 0, uint64(mrg32k3a_m2 - mrg32k3a_a23n)}, uint64(mrg32k3a_m2))
+		multMatVect(s, a1.Pow(offset, uint64(mrg32k3a_m1)), mrg32k3a_m1, a2.Pow(offset, uint64(mrg32k3a_m2)), mrg32k3a_m2)
+	}
+
+	ans := new(MRG32k3A)
+	ans.spi = ans
+	err := ans.setSeed(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
 // this builds the seed slice from a split_mx64 generator using the seed provided
 func NewMRG32k3A(seed int64) *MRG32k3A {
 	ans := new(MRG32k3A)
@@ -85,7 +138,7 @@ func NewMRG32k3A(seed int64) *MRG32k3A {
 
 func (mrg *MRG32k3A) setSeed(seed []uint32) (err error) {
 
-	err = checkMRGSeed(seed, mrg32k3a_r, mrg32k3a_m1, mrg32k3a_m2)
+	err = checkMRGSeed(seed, []int{3, 3}, []uint32{mrg32k3a_m1, mrg32k3a_m2})
 	if err != nil {
 		return
 	}
@@ -117,35 +170,66 @@ func (mrg *MRG32k3A) Jump() {
 	mrg.RestartSubstream()
 }
 
+// Returns the next value of the combined recurrence, which lies in [1, m1].
+//
+// The products are at most 2^53, so the recurrence is computed exactly in int64 (as the
+// double-precision reference implementation does) and the residue is brought back to [0, m).
 func (mrg *MRG32k3A) Uint32() uint32 {
 
 	/* Component 1 */
-	p1 := (mrg32k3a_a12*mrg.s[0][1] - mrg32k3a_a13n*mrg.s[0][0]) % mrg32k3a_m1
-
-	if p1&0x80000000 == 1 {
-		p1 += mrg32k3a_m1
+	p1 := (int64(mrg32k3a_a12)*int64(mrg.s[0][1]) - int64(mrg32k3a_a13n)*int64(mrg.s[0][0])) % int64(mrg32k3a_m1)
+	if p1 < 0 {
+		p1 += int64(mrg32k3a_m1)
 	}
 	mrg.s[0][0] = mrg.s[0][1]
 	mrg.s[0][1] = mrg.s[0][2]
-	mrg.s[0][2] = p1
+	mrg.s[0][2] = uint32(p1)
 
 	/* Component 2 */
-	p2 := (mrg32k3a_a21*mrg.s[1][2] - mrg32k3a_a23n*mrg.s[1][0]) % mrg32k3a_m2
-	if p2&0x80000000 == 1 {
-		p2 += mrg32k3a_m2
+	p2 := (int64(mrg32k3a_a21)*int64(mrg.s[1][2]) - int64(mrg32k3a_a23n)*int64(mrg.s[1][0])) % int64(mrg32k3a_m2)
+	if p2 < 0 {
+		p2 += int64(mrg32k3a_m2)
 	}
 	mrg.s[1][0] = mrg.s[1][1]
 	mrg.s[1][1] = mrg.s[1][2]
-	mrg.s[1][2] = p2
+	mrg.s[1][2] = uint32(p2)
 
 	/* Combination */
-	r := p1 - p2
-
 	if p1 > p2 {
-		return r
+		return uint32(p1 - p2)
+	}
+
+	return uint32(p1 - p2 + int64(mrg32k3a_m1))
+}
+
+// Returns L'Ecuyer's normalized output, a float64 in the open interval (0,1).
+// This advances the same stream as Uint32().
+func (mrg *MRG32k3A) Float64() float64 {
+	return float64(mrg.Uint32()) * mrg32k3a_norm
+}
+
+// CurandUint32 returns the value of curand() for cuRAND's MRG32k3a, the output scaled by MRG32K3A_BITS_NORM
+// (about 2^32/m1). This advances the same stream as Uint32().
+func (mrg *MRG32k3A) CurandUint32() uint32 {
+	x := float64(mrg.Uint32()) * mrg32k3a_curand_bits_norm
+	// m1 is scaled slightly above 2^32, and the conversion of the GPU saturates.
+	if x >= 1<<32 {
+		return math.MaxUint32
 	}
 
-	return r + mrg32k3a_m1
+	return uint32(x)
+}
+
+// CurandFloat32 returns the value of curand_uniform() for cuRAND's MRG32k3a, in (0,1].
+// This advances the same stream as Uint32().
+func (mrg *MRG32k3A) CurandFloat32() float32 {
+	return float32(mrg.CurandFloat64())
+}
+
+// CurandFloat64 returns the value of curand_uniform_double() for cuRAND's MRG32k3a, the output divided by m1
+// (a float64 in (0,1]). This advances the same stream as Uint32().
+func (mrg *MRG32k3A) CurandFloat64() float64 {
+	return float64(mrg.Uint32()) * mrg32k3a_curand_norm
 }
 
 func (mrg *MRG32k3A) Seed(seed int64) {
@@ -153,19 +237,19 @@ func (mrg *MRG32k3A) Seed(seed int64) {
 	seeder.Seed(seed)
 	for j := 0; j < 3; j++ {
 	again0:
-		f := seeder.Intn(int(mrg32k3a_m1))
+		f := seeder.Uint32n(mrg32k3a_m1)
 		if f == 0 {
 			goto again0
 		}
-		seeds[j] = uint32(f)
+		seeds[j] = f
 	}
 	for j := 3; j < 6; j++ {
 	again1:
-		f := seeder.Intn(int(mrg32k3a_m2))
+		f := seeder.Uint32n(mrg32k3a_m2)
 		if f == 0 {
 			goto again1
 		}
-		seeds[j] = uint32(f)
+		seeds[j] = f
 	}
 
 	// Initialize the pool content.
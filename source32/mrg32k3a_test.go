@@ -2,11 +2,16 @@ package source32_test
 
 import (
 	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
 	"github.com/jtejido/grand/source32"
 	"testing"
 )
 
 func TestMRG32k3A(t *testing.T) {
+	/*
+	 * Data from running L'Ecuyer's RngStreams (the unnormalized values of U01()):
+	 *   http://www.iro.umontreal.ca/~lecuyer/myftp/streams00/c/RngStream.c
+	 */
 	rng, err := source32.NewMRG32k3AFromStream([]uint32{12345, 12345, 12345, 12345, 12345, 12345})
 
 	if err != nil {
@@ -16,106 +21,106 @@ func TestMRG32k3A(t *testing.T) {
 	r := grand.New(rng)
 
 	expected := []uint32{
-		545439821,
-		2217652485,
-		775547001,
-		2821471974,
-		3536978150,
-		2115975878,
-		715171321,
-		850386448,
-		3275228368,
-		304070151,
-		3857586390,
-		397621607,
-		3362674056,
-		3559857824,
-		2169451089,
-		3431565287,
-		1070718423,
-		2016234167,
-		591105721,
-		2335831984,
-		2326567728,
-		1131218503,
-		2003341703,
-		1829109302,
-		464671897,
-		3632591168,
-		1978174432,
-		1059356967,
-		2731181030,
-		3282610566,
-		3516908328,
-		4233196368,
-		1480040336,
-		1167104950,
-		508632726,
-		1341784022,
-		2424207368,
-		2068334048,
-		1367822912,
-		3884104598,
-		757999814,
-		3864712486,
-		566742632,
-		3551416560,
-		3640721392,
-		2038498294,
-		75025014,
-		470162806,
-		543351368,
-		3991772288,
-		594273440,
-		1685805270,
-		2318699430,
-		825945286,
-		2713423784,
-		1722699920,
-		411507792,
-		3297271350,
-		717771862,
-		3182327574,
-		1875997320,
-		2464134432,
-		3615204096,
-		3676993558,
-		3827657862,
-		1720062054,
-		1891559144,
-		2633052720,
-		2217104560,
-		1570556534,
-		3663625270,
-		2753113270,
-		3157788872,
-		3967504320,
-		4076465504,
-		430455126,
-		1198426982,
-		163274758,
-		2508305448,
-		2878911952,
-		1161365776,
-		3716180150,
-		4126147094,
-		4258160214,
-		643859720,
-		2133797984,
-		1934107584,
-		2435366038,
-		1954785350,
-		475790246,
-		3984578408,
-		3915946864,
-		2664929648,
-		31545590,
-		1302832630,
-		444083190,
-		1490077512,
-		3483110144,
-		1386587680,
-		35398102,
+		545508589,
+		1368065410,
+		1327943761,
+		3546985096,
+		951893194,
+		2290915636,
+		2064909380,
+		1527117980,
+		584065747,
+		3246360482,
+		2471991152,
+		1761211786,
+		1401575233,
+		1032415833,
+		2620200431,
+		3883427286,
+		1284087542,
+		146692441,
+		4150763877,
+		616308052,
+		3236436203,
+		779673408,
+		345711528,
+		935114453,
+		2915576190,
+		826147332,
+		2253073562,
+		885541393,
+		2667245921,
+		3304099183,
+		3816648694,
+		3775460403,
+		3622838331,
+		3119591926,
+		2164138301,
+		341092268,
+		178975783,
+		4009171755,
+		4199436995,
+		2056367222,
+		2521964816,
+		2892389204,
+		8651181,
+		4044215823,
+		1680767086,
+		2366854540,
+		3103543227,
+		2859575710,
+		583940869,
+		2309901160,
+		330336414,
+		3398476862,
+		1104047647,
+		684234631,
+		1995745446,
+		380601977,
+		728799265,
+		2888398583,
+		3310355184,
+		2715886728,
+		1837278219,
+		3807053686,
+		413105720,
+		4111851265,
+		1570595827,
+		3340274250,
+		3241853183,
+		1087761775,
+		3638144502,
+		3021983840,
+		2639958596,
+		2968006787,
+		3959608290,
+		3424012499,
+		3134868129,
+		2719382973,
+		2669304947,
+		1960508274,
+		2218901675,
+		3813319994,
+		3565528527,
+		2176812879,
+		2219740712,
+		1338474516,
+		2808327387,
+		789972412,
+		2557488726,
+		2972264262,
+		3719533356,
+		559634188,
+		576794933,
+		3899709831,
+		657997689,
+		1031631974,
+		3629727910,
+		3377485727,
+		4038818051,
+		177135894,
+		1309481774,
+		3260904806,
 	}
 
 	for i := 0; i < len(expected); i++ {
@@ -126,3 +131,218 @@ func TestMRG32k3A(t *testing.T) {
 	}
 
 }
+
+func TestMRG32k3AFloat64(t *testing.T) {
+	rng, err := source32.NewMRG32k3AFromStream([]uint32{12345, 12345, 12345, 12345, 12345, 12345})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	// Data from running RngStream_RandU01() of L'Ecuyer's RngStreams.
+	expected := []float64{
+		0.12701112204657714,
+		0.3185275653967945,
+		0.30918601558327008,
+		0.82584686292711362,
+		0.2216299157820229,
+		0.53339538791827878,
+		0.4807742033156181,
+		0.35555987943812623,
+		0.13598841039594017,
+		0.75585223716154359,
+		0.57555531890026912,
+		0.4100640936040626,
+		0.32632967943245861,
+		0.24037805455705044,
+		0.61006298239647894,
+		0.90418091837075343,
+		0.2989749433907653,
+		0.034154497111247718,
+		0.96642507193992278,
+		0.14349540738552921,
+		0.75354156078226975,
+		0.18153187021581202,
+		0.080492241480943347,
+		0.21772331052609922,
+		0.67883551381476859,
+		0.19235242437787922,
+		0.52458459304496541,
+		0.20618118249943621,
+		0.62101661464466162,
+		0.76929557673015636,
+		0.88863281506011871,
+		0.87904291829115888,
+		0.84350782131069046,
+		0.72633663124358738,
+		0.5038777379800029,
+		0.079416736149853367,
+		0.0416710487724231,
+		0.93345808544179476,
+		0.97775766588132706,
+		0.4787853270739662,
+		0.58719071981861959,
+		0.67343687267854568,
+		0.0020142601381442763,
+		0.94161741874563121,
+		0.39133410141744962,
+		0.5510762926712327,
+		0.72260000214464981,
+		0.66579688537999815,
+		0.13595933496941387,
+		0.53781579990537987,
+		0.0769124436187065,
+		0.7912695935424594,
+		0.25705613672446381,
+		0.15931079726122457,
+		0.46467071926489234,
+		0.088615807572400201,
+		0.16968681018214127,
+		0.67250773377754003,
+		0.77075216554022641,
+		0.63234168559477455,
+		0.42777469101760907,
+		0.88639880306342411,
+		0.096183675342752711,
+		0.95736502300294235,
+		0.36568285503006398,
+		0.7777182412718876,
+		0.75480279978341025,
+		0.25326428648060462,
+		0.84707156712908449,
+		0.70361047665378529,
+		0.61466328889363542,
+		0.69104296405262711,
+		0.92191819142526577,
+		0.79721507262921321,
+		0.72989339959291444,
+		0.63315571860791875,
+		0.62149601901675855,
+		0.45646642543026633,
+		0.5166283302145761,
+		0.88785779165905454,
+		0.83016434211148493,
+		0.50682876827669887,
+		0.51682368374879617,
+		0.31163789816682297,
+		0.65386470477186587,
+		0.18392979406225432,
+		0.59546177504957876,
+		0.6920342347452233,
+		0.86602138730987188,
+		0.13029999451301966,
+		0.13429554201044905,
+		0.90797199398702366,
+		0.15320203287201536,
+		0.24019554815270802,
+		0.8451119265014494,
+		0.78638221383269424,
+		0.94036065195571072,
+		0.041242666211555382,
+		0.30488749905875884,
+		0.7592386016439715,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := rng.Float64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+}
+
+func TestMRG32k3ASubstreams(t *testing.T) {
+	rng, err := source32.NewMRG32k3AFromStream([]uint32{12345, 12345, 12345, 12345, 12345, 12345})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	rng.Jump()
+	grandtest.CheckVectors(t, rng, "testdata/mrg32k3a_substream1.txt")
+	rng.Jump()
+	grandtest.CheckVectors(t, rng, "testdata/mrg32k3a_substream2.txt")
+}
+
+func TestMRG32k3ACuRAND(t *testing.T) {
+	rng, err := source32.NewCuRANDMRG32k3A(1234, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/*
+	 * Data from curand_init(1234, 0, 0, &state) and curand(&state) of curandStateMRG32k3a_t, computed by an independent
+	 * model of curand_kernel.h (jumps by matrix powers), not captured on a device.
+	 */
+	expected := []uint32{
+		0xc96891f0, 0x9650c684, 0x0cbc7881, 0x5fa111cd, 0x864a3897, 0xfa61def9, 0x6661c54a, 0xcd100fe0,
+		0x52d6b4c6, 0x85e4dd5b, 0x733c8bf4, 0x9136254f, 0x66a8232d, 0x327966e5, 0x7983efd2, 0xcc7477f4,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := rng.CurandUint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("BitsNorm", func(t *testing.T) {
+		// the value 2025 of curand_init(1234, 0, 0), 1171348470*1.000000048662, is one more than its value
+		// scaled by 2^32/m1.
+		rng, err := source32.NewCuRANDMRG32k3A(1234, 0, 2025)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want, got := uint32(0x45d15c2f), rng.CurandUint32(); got != want {
+			t.Errorf("Mismatch. want: %v, got: %v", want, got)
+		}
+	})
+
+	t.Run("Saturation", func(t *testing.T) {
+		// Both components give 527612, so the output is m1, which MRG32K3A_BITS_NORM scales above 2^32.
+		rng, err := source32.NewMRG32k3AFromStream([]uint32{0, 4173190979, 0, 0, 0, 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want, got := uint32(4294967087), rng.Uint32(); got != want {
+			t.Errorf("Mismatch. want: %v, got: %v", want, got)
+		}
+
+		rng.Restart()
+		if want, got := uint32(0xffffffff), rng.CurandUint32(); got != want {
+			t.Errorf("Mismatch. want: %v, got: %v", want, got)
+		}
+	})
+
+	t.Run("Subsequence", func(t *testing.T) {
+		// curand_init(1234, 2, 100).
+		want := []uint32{0xc7225809, 0x72400364, 0x8f36f2b8, 0xf8b0a2d4, 0x5b7f989b, 0x2e25aff4, 0xdb56fcba, 0xe24f319c}
+		rng, err := source32.NewCuRANDMRG32k3A(1234, 2, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range want {
+			if rg := rng.CurandUint32(); want[i] != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", want[i], rg)
+			}
+		}
+
+		// a subsequence of cuRAND is a substream of L'Ecuyer's package.
+		rng, _ = source32.NewCuRANDMRG32k3A(1234, 0, 100)
+		rng.Jump()
+		rng.Jump()
+		if got := rng.CurandUint32(); got != want[0] {
+			t.Errorf("Mismatch. want: %v, got: %v", want[0], got)
+		}
+	})
+
+	t.Run("ZeroSeed", func(t *testing.T) {
+		// the seed 0 keeps the default state of L'Ecuyer's package.
+		rng, _ := source32.NewCuRANDMRG32k3A(0, 0, 0)
+		ref, _ := source32.NewMRG32k3AFromStream([]uint32{12345, 12345, 12345, 12345, 12345, 12345})
+		if want, got := ref.Uint32(), rng.Uint32(); want != got {
+			t.Errorf("Mismatch. want: %v, got: %v", want, got)
+		}
+	})
+}
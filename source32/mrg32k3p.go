@@ -1,5 +1,9 @@
 package source32
 
+import (
+	"github.com/jtejido/grand/internal/modmath"
+)
+
 const (
 	mrg32k3p_m1     uint32 = 2147483647 //2^31 - 1
 	mrg32k3p_m2     uint32 = 2147462579 //2^31 - 21069
@@ -8,15 +12,17 @@ const (
 	mrg32k3p_mask2  uint32 = 65535      //2^16 - 1
 	mrg32k3p_mult2  uint32 = 21069
 	mrg32k3p_r             = 6
+	// 1/2^31, used to normalize the output to (0,1).
+	mrg32k3p_norm float64 = 4.656612873077392578125e-10
 )
 
 var (
-	a1p72 = [][]uint32{
+	a1p72 = modmath.Matrix{
 		{1516919229, 758510237, 499121365},
 		{1884998244, 1516919229, 335398200},
 		{601897748, 1884998244, 358115744},
 	}
-	a2p72 = [][]uint32{
+	a2p72 = modmath.Matrix{
 		{1228857673, 1496414766, 954677935},
 		{1133297478, 1407477216, 1496414766},
 		{2002613992, 1639496704, 1407477216},
@@ -31,7 +37,8 @@ var (
 // Fast Combined Multiple Recursive Generators with Multipliers of the Form a=±2q±2r.
 // Proceedings of the 2000 Winter Simulation Conference, Dec. 2000, 683--689
 // https://github.com/clMathLibraries/clRNG
-// TODO: unit test
+//
+// All the values are less than 2^31, so the recurrence is computed exactly in uint32.
 type MRG32k3P struct {
 	baseJumpableSource32
 	s [2][3]uint32
@@ -71,7 +78,7 @@ func NewMRG32k3P(seed int64) *MRG32k3P {
 
 func (mrg *MRG32k3P) setSeed(seed []uint32) (err error) {
 
-	err = checkMRGSeed(seed, mrg32k3p_r, mrg32k3p_m1, mrg32k3p_m2)
+	err = checkMRGSeed(seed, []int{3, 3}, []uint32{mrg32k3p_m1, mrg32k3p_m2})
 	if err != nil {
 		return
 	}
@@ -99,7 +106,7 @@ func (mrg *MRG32k3P) RestartSubstream() {
 }
 
 func (mrg *MRG32k3P) Jump() {
-	multMatVect(mrg.substream, a1p72, mrg32k3p_m1, a1p72, mrg32k3p_m2)
+	multMatVect(mrg.substream, a1p72, mrg32k3p_m1, a2p72, mrg32k3p_m2)
 	mrg.RestartSubstream()
 }
 
@@ -107,11 +114,11 @@ func (mrg *MRG32k3P) Uint32() uint32 {
 
 	//first component
 	y1 := ((mrg.s[0][1] & mrg32k3p_mask12) << 22) + (mrg.s[0][1] >> 9) + ((mrg.s[0][2] & mrg32k3p_mask13) << 7) + (mrg.s[0][2] >> 24)
-	if y1&0x80000000 == 1 || y1 >= mrg32k3p_m1 {
+	if y1 >= mrg32k3p_m1 {
 		y1 -= mrg32k3p_m1
 	}
 	y1 += mrg.s[0][2]
-	if y1&0x80000000 == 1 || y1 >= mrg32k3p_m1 {
+	if y1 >= mrg32k3p_m1 {
 		y1 -= mrg32k3p_m1
 	}
 
@@ -121,19 +128,19 @@ func (mrg *MRG32k3P) Uint32() uint32 {
 
 	//second component
 	y1 = ((mrg.s[1][0] & mrg32k3p_mask2) << 15) + (mrg32k3p_mult2 * (mrg.s[1][0] >> 16))
-	if y1&0x80000000 == 1 || y1 >= mrg32k3p_m2 {
+	if y1 >= mrg32k3p_m2 {
 		y1 -= mrg32k3p_m2
 	}
 	y2 := ((mrg.s[1][2] & mrg32k3p_mask2) << 15) + (mrg32k3p_mult2 * (mrg.s[1][2] >> 16))
-	if y2&0x80000000 == 1 || y2 >= mrg32k3p_m2 {
+	if y2 >= mrg32k3p_m2 {
 		y2 -= mrg32k3p_m2
 	}
 	y2 += mrg.s[1][2]
-	if y2&0x80000000 == 1 || y2 >= mrg32k3p_m2 {
+	if y2 >= mrg32k3p_m2 {
 		y2 -= mrg32k3p_m2
 	}
 	y2 += y1
-	if y2&0x80000000 == 1 || y2 >= mrg32k3p_m2 {
+	if y2 >= mrg32k3p_m2 {
 		y2 -= mrg32k3p_m2
 	}
 
@@ -151,6 +158,12 @@ func (mrg *MRG32k3P) Uint32() uint32 {
 	return r + mrg32k3p_m1
 }
 
+// Returns the normalized output, a float64 in the open interval (0,1).
+// This advances the same stream as Uint32().
+func (mrg *MRG32k3P) Float64() float64 {
+	return float64(mrg.Uint32()) * mrg32k3p_norm
+}
+
 func (mrg *MRG32k3P) Seed(seed int64) {
 	seeds := make([]uint32, mrg32k3p_r)
 	seeder.Seed(seed)
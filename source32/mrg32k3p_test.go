@@ -0,0 +1,144 @@
+package source32_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+	"testing"
+)
+
+func TestMRG32k3P(t *testing.T) {
+	/*
+	 * Data from running the mrg32k3p generator of clRNG:
+	 *   https://github.com/clMathLibraries/clRNG/blob/master/src/include/clRNG/private/mrg32k3p.c.h
+	 */
+	rng, err := source32.NewMRG32k3PFromStream([]uint32{12345, 12345, 12345, 12345, 12345, 12345})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint32{
+		1579097239,
+		1319000434,
+		236390836,
+		1393231922,
+		786396556,
+		233695487,
+		1144726451,
+		2101054529,
+		1965213364,
+		1827453938,
+		946624942,
+		120118548,
+		504350427,
+		1331013470,
+		1655418328,
+		1715581751,
+		2042367271,
+		775737916,
+		1028535884,
+		865667116,
+		1256109004,
+		1075659047,
+		525124846,
+		369014098,
+		16285717,
+		1145956219,
+		1475529124,
+		287527039,
+		126947727,
+		1945877504,
+		872399620,
+		1953420248,
+		1662867630,
+		340008515,
+		1507357647,
+		248640483,
+		491667592,
+		1877080008,
+		158693966,
+		1601711921,
+		16523434,
+		904640422,
+		1131698402,
+		795551326,
+		306220614,
+		1373278359,
+		260884076,
+		1253113586,
+		1109975727,
+		658141122,
+		514865671,
+		1119051871,
+		2040019872,
+		1570647921,
+		1211698024,
+		1638746759,
+		1789460146,
+		983792202,
+		2062782040,
+		529613948,
+		2084385472,
+		1162292063,
+		734312941,
+		1627987613,
+		348975890,
+		76361695,
+		802533553,
+		1739675687,
+		947948763,
+		61400301,
+		113458313,
+		862619781,
+		887141734,
+		1320174081,
+		1234320912,
+		1531005472,
+		779287160,
+		1388055233,
+		1823893412,
+		1733955505,
+		2115249042,
+		2012771911,
+		909491416,
+		2112155041,
+		275587888,
+		740992414,
+		1960625752,
+		1178219548,
+		97612813,
+		711069791,
+		2106805865,
+		1418890046,
+		1114510607,
+		467901285,
+		1392162597,
+		214819410,
+		1329376517,
+		444194955,
+		1268283267,
+		1124979818,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+}
+
+func TestMRG32k3PSubstreams(t *testing.T) {
+	rng, err := source32.NewMRG32k3PFromStream([]uint32{12345, 12345, 12345, 12345, 12345, 12345})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	rng.Jump()
+	grandtest.CheckVectors(t, rng, "testdata/mrg32k3p_substream1.txt")
+}
@@ -63,6 +63,27 @@ func NewMT19937(seed int64) *MT19937 {
 	return ans
 }
 
+// Returns a generator starting from the given 624 state words, of which the first call twists the state
+// (as after init_genrand() in the reference code). This is used by the generators of other ecosystems.
+func newMT19937FromState(state []uint32) *MT19937 {
+	ans := new(MT19937)
+	ans.spi = ans
+	ans.stream = append([]uint32{}, state...)
+	ans.Restart()
+	return ans
+}
+
+// Returns the state words set by init_genrand(s) of the reference code.
+func mt19937InitGenrand(s uint32) []uint32 {
+	state := make([]uint32, mt19937_n)
+	state[0] = s
+	for i := 1; i < mt19937_n; i++ {
+		state[i] = 1812433253*(state[i-1]^(state[i-1]>>30)) + uint32(i)
+	}
+
+	return state
+}
+
 func (mt *MT19937) setSeed(stream []uint32) {
 	seed := stream
 	if len(stream) == 0 {
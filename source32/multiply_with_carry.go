@@ -1,5 +1,10 @@
 package source32
 
+import (
+	"errors"
+	"fmt"
+)
+
 const (
 	mwc_r                = 256
 	mwc_seed_size        = mwc_r + 1
@@ -16,6 +21,8 @@ type MultiplyWithCarry256 struct {
 	baseSource32
 	state        [256]uint32
 	index, carry uint32
+	// initialCarry stores the carry at the starting point for the stream.
+	initialCarry uint32
 }
 
 func NewMultiplyWithCarry256FromStream(seeds []uint32) (*MultiplyWithCarry256, error) {
@@ -26,7 +33,11 @@ func NewMultiplyWithCarry256FromStream(seeds []uint32) (*MultiplyWithCarry256, e
 
 	ans := new(MultiplyWithCarry256)
 	ans.spi = ans
-	ans.setSeed(seeds)
+	err = ans.setSeed(seeds)
+	if err != nil {
+		return nil, err
+	}
+
 	return ans, nil
 }
 
@@ -38,14 +49,36 @@ func NewMultiplyWithCarry256(seed int64) *MultiplyWithCarry256 {
 	return ans
 }
 
-func (mwc256 *MultiplyWithCarry256) setSeed(seed []uint32) {
+// The first value of the stream is the carry, which must be less than the multiplier, followed by the
+// lag values. The two degenerate states (the carry and all lag values 0, or the carry a-1 and all lag
+// values 2^32-1) are rejected.
+func (mwc256 *MultiplyWithCarry256) setSeed(seed []uint32) error {
 	seeds := make([]uint32, mwc_seed_size)
 	fillState(seeds, seed)
 	c := seeds[0]
-	mwc256.carry = c % mwc_a
+	if c >= mwc_a {
+		return fmt.Errorf("The carry must be less than %d", mwc_a)
+	}
+
+	if c == 0 || c == mwc_a-1 {
+		degenerate := true
+		for _, v := range seeds[1:] {
+			if (c == 0 && v != 0) || (c != 0 && v != 0xffffffff) {
+				degenerate = false
+				break
+			}
+		}
+
+		if degenerate {
+			return errors.New("The seed is a degenerate state of the generator")
+		}
+	}
+
+	mwc256.initialCarry = c
 	mwc256.stream = append([]uint32{}, seeds[1:1+mwc_r]...)
 
 	mwc256.Restart()
+	return nil
 }
 
 func (mwc256 *MultiplyWithCarry256) Restart() {
@@ -53,6 +86,7 @@ func (mwc256 *MultiplyWithCarry256) Restart() {
 		mwc256.state[i] = mwc256.stream[i]
 	}
 	mwc256.index = mwc_r
+	mwc256.carry = mwc256.initialCarry
 	mwc256.resetState()
 }
 
@@ -66,6 +100,7 @@ func (mwc256 *MultiplyWithCarry256) Seed(seed int64) {
 		i++
 	}
 
+	seeds[0] %= mwc_a
 	mwc256.setSeed(seeds)
 }
 
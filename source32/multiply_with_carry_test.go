@@ -187,4 +187,23 @@ func TestMultiplyWithCarry256(t *testing.T) {
 		}
 	}
 
+	t.Run("InvalidSeed", func(t *testing.T) {
+		if _, err := source32.NewMultiplyWithCarry256FromStream([]uint32{809430660, 1, 2, 3}); err == nil {
+			t.Errorf("A carry equal to the multiplier should be rejected.")
+		}
+
+		zero := make([]uint32, 257)
+		if _, err := source32.NewMultiplyWithCarry256FromStream(zero); err == nil {
+			t.Errorf("The all-zero state should be rejected.")
+		}
+
+		ones := make([]uint32, 257)
+		ones[0] = 809430660 - 1
+		for i := 1; i < len(ones); i++ {
+			ones[i] = 0xffffffff
+		}
+		if _, err := source32.NewMultiplyWithCarry256FromStream(ones); err == nil {
+			t.Errorf("The all-ones state should be rejected.")
+		}
+	})
 }
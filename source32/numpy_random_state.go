@@ -0,0 +1,104 @@
+package source32
+
+import "math"
+
+// Implements the legacy numpy.random.RandomState of NumPy, MT19937 with NumPy's seeding:
+// np.random.seed(seed) uses init_genrand(seed) for an integer seed, and init_by_array(seed) for an array.
+// Uint32() returns the values of MT19937, RandomSample() the ones of random_sample() (and rand()),
+// StandardNormal() the ones of standard_normal() (and randn()), the legacy polar method.
+//
+// The state size is 2496 bytes (and the pending normal value); the period is 2^19937-1.
+//
+// https://numpy.org/doc/stable/reference/random/legacy.html
+type NumPyRandomState struct {
+	baseSource32
+	mt *MT19937
+	// gauss is the second value of the last pair of StandardNormal().
+	gauss    float64
+	hasGauss bool
+}
+
+// A single value is the integer seed of np.random.seed(), longer streams are the array seed of np.random.seed().
+func NewNumPyRandomStateFromStream(seed []uint32) (*NumPyRandomState, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(NumPyRandomState)
+	ans.spi = ans
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// NewNumPyRandomState returns the generator of np.random.RandomState(seed), the seed is truncated to 32 bits.
+func NewNumPyRandomState(seed int64) *NumPyRandomState {
+	ans := new(NumPyRandomState)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (rs *NumPyRandomState) setSeed(seed []uint32) {
+	rs.stream = append([]uint32{}, seed...)
+	if len(seed) == 1 {
+		rs.mt = newMT19937FromState(mt19937InitGenrand(seed[0]))
+	} else {
+		rs.mt, _ = NewMT19937FromStream(seed)
+	}
+
+	rs.Restart()
+}
+
+// Seed uses the seed (truncated to 32 bits) as np.random.seed(seed) does for an integer.
+func (rs *NumPyRandomState) Seed(seed int64) {
+	rs.setSeed([]uint32{uint32(seed)})
+}
+
+func (rs *NumPyRandomState) Restart() {
+	rs.mt.Restart()
+	rs.hasGauss = false
+	rs.gauss = 0
+	rs.resetState()
+}
+
+func (rs *NumPyRandomState) Uint32() uint32 {
+	return rs.mt.Uint32()
+}
+
+// RandomSample returns the value of random_sample(), in [0, 1) with 53 bits of precision.
+func (rs *NumPyRandomState) RandomSample() float64 {
+	a := rs.mt.Uint32() >> 5
+	b := rs.mt.Uint32() >> 6
+	return (float64(a)*67108864.0 + float64(b)) / 9007199254740992.0
+}
+
+// StandardNormal returns the value of standard_normal(), with Marsaglia's polar method:
+// the values are computed by pairs and the second one is returned by the next call.
+func (rs *NumPyRandomState) StandardNormal() float64 {
+	if rs.hasGauss {
+		rs.hasGauss = false
+		ans := rs.gauss
+		rs.gauss = 0
+		return ans
+	}
+
+	var x1, x2, r2 float64
+	for {
+		x1 = 2.0*rs.RandomSample() - 1.0
+		x2 = 2.0*rs.RandomSample() - 1.0
+		r2 = float64(x1*x1) + float64(x2*x2)
+		if r2 < 1.0 && r2 != 0.0 {
+			break
+		}
+	}
+
+	f := math.Sqrt(-2.0 * crLog(r2) / r2)
+	rs.gauss = f * x1
+	rs.hasGauss = true
+	return f * x2
+}
+
+func (rs *NumPyRandomState) String() string {
+	return "NumPyRandomState"
+}
@@ -0,0 +1,73 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+)
+
+func TestNumPyRandomState(t *testing.T) {
+	rng, err := source32.NewNumPyRandomStateFromStream([]uint32{0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the reference MT19937 code after init_genrand(0), as np.random.seed(0).
+	 */
+	expected := []uint32{
+		0x8c7f0aac, 0x97c4aa2f, 0xb716a675, 0xd821ccc0, 0x9a4eb343, 0xdba252fb, 0x8b7d76c3, 0xd8e57d67,
+		0x6c74a409, 0x9fa1ded3, 0xa5595115, 0x6266d6f2, 0x7005b724, 0x4c2b3a57, 0xe44b3c46, 0x0e84bdd8,
+		0xf6b29a58, 0x45cccd8c, 0x6229393a, 0x7a4842c1, 0xcaae7de6, 0xcfea4a27, 0x8765a857, 0x7adfc8ae,
+		0x916b5e58, 0x648d8b51, 0xecf3e6a5, 0xd6094219, 0x122f6b4d, 0x565f9848, 0x164e1b09, 0xa5ee9794,
+		0x052d0873, 0x5e4513d0, 0xd52692f3, 0xf5081ec5, 0xc73547fe, 0x23ee074f, 0xdeb91daf, 0xdebe09c0,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("RandomSample", func(t *testing.T) {
+		// np.random.seed(0); np.random.rand(5)
+		expected := []float64{0.5488135039273248, 0.7151893663724195, 0.6027633760716439, 0.5448831829968969, 0.4236547993389047}
+		rng.Restart()
+		for i := 0; i < len(expected); i++ {
+			if got := rng.RandomSample(); expected[i] != got {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], got)
+			}
+		}
+	})
+
+	t.Run("StandardNormal", func(t *testing.T) {
+		// np.random.seed(0); np.random.randn(6)
+		expected := []float64{
+			1.764052345967664, 0.4001572083672233, 0.9787379841057392, 2.240893199201458, 1.8675579901499675, -0.977277879876411,
+		}
+		rng.Restart()
+		for i := 0; i < len(expected); i++ {
+			if got := rng.StandardNormal(); expected[i] != got {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], got)
+			}
+		}
+	})
+
+	t.Run("ArraySeed", func(t *testing.T) {
+		// the reference MT19937 code after init_by_array({0x123, 0x234, 0x345, 0x456}).
+		rng, err := source32.NewNumPyRandomStateFromStream([]uint32{0x123, 0x234, 0x345, 0x456})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []uint32{0x3fa23623, 0x38fa935f, 0x1c72dc38, 0xf4cf2f5f}
+		for i := 0; i < len(expected); i++ {
+			if got := rng.Uint32(); expected[i] != got {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], got)
+			}
+		}
+	})
+}
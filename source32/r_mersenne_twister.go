@@ -0,0 +1,167 @@
+package source32
+
+import "math"
+
+const (
+	// 1/(2^32-1), used by R to keep the uniform values away from 0 and 1, and 2^27, the scale of the
+	// Inversion method of norm_rand().
+	rmt_i2_32m1  float64 = 2.328306437080797e-10
+	rmt_norm_big float64 = 134217728
+)
+
+// Implements R's default generators, the "Mersenne-Twister" of RNGkind() (MT19937) with the "Inversion"
+// method for normal values, with R's seeding: set.seed(seed) scrambles the seed by 50 steps of the LCG
+// x(n+1) = 69069*x(n) + 1 mod 2^32, of which the next 625 values fill .Random.seed.
+// Uint32() returns the values of MT19937, UnifRand() the ones of runif(), NormRand() the ones of rnorm().
+//
+// The state size is 2496 bytes; the period is 2^19937-1.
+//
+// https://stat.ethz.ch/R-manual/R-devel/library/base/html/Random.html
+type RMersenneTwister struct {
+	baseSource32
+	mt *MT19937
+}
+
+// The first value is the seed of set.seed().
+func NewRMersenneTwisterFromStream(seed []uint32) (*RMersenneTwister, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(RMersenneTwister)
+	ans.spi = ans
+	ans.setSeed(seed[0])
+	return ans, nil
+}
+
+// NewRMersenneTwister returns the generator of set.seed(seed), the seed is truncated to 32 bits
+// (negative integers of R wrap around).
+func NewRMersenneTwister(seed int64) *RMersenneTwister {
+	ans := new(RMersenneTwister)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (rmt *RMersenneTwister) setSeed(seed uint32) {
+	rmt.stream = []uint32{seed}
+	// Initial scrambling
+	for j := 0; j < 50; j++ {
+		seed = 69069*seed + 1
+	}
+
+	// the first value is the position in .Random.seed, which is set to 624.
+	state := make([]uint32, mt19937_n+1)
+	for j := range state {
+		seed = 69069*seed + 1
+		state[j] = seed
+	}
+
+	rmt.mt = newMT19937FromState(state[1:])
+	rmt.Restart()
+}
+
+// Seed uses the seed (truncated to 32 bits) as set.seed(seed) does.
+func (rmt *RMersenneTwister) Seed(seed int64) {
+	rmt.setSeed(uint32(seed))
+}
+
+func (rmt *RMersenneTwister) Restart() {
+	rmt.mt.Restart()
+	rmt.resetState()
+}
+
+func (rmt *RMersenneTwister) Uint32() uint32 {
+	return rmt.mt.Uint32()
+}
+
+// UnifRand returns the value of unif_rand() (and runif()), in (0, 1) with 32 bits of precision.
+func (rmt *RMersenneTwister) UnifRand() float64 {
+	x := float64(rmt.mt.Uint32()) * 2.3283064365386963e-10
+	// ensures 0 and 1 are never returned
+	if x <= 0.0 {
+		return 0.5 * rmt_i2_32m1
+	}
+	if 1.0-x <= 0.0 {
+		return 1.0 - 0.5*rmt_i2_32m1
+	}
+
+	return x
+}
+
+// NormRand returns the value of norm_rand() (and rnorm()) with the Inversion method: two values of
+// UnifRand() give the 2^27 bits of precision of the uniform value passed to qnorm().
+func (rmt *RMersenneTwister) NormRand() float64 {
+	u := rmt.UnifRand()
+	u = float64(int32(rmt_norm_big*u)) + rmt.UnifRand()
+	return rQnorm(u / rmt_norm_big)
+}
+
+// Returns the quantile of the standard normal distribution, as qnorm(p, 0, 1) of R,
+// with Wichura's algorithm AS 241.
+//
+// M. J. Wichura, Algorithm AS 241: The Percentage Points of the Normal Distribution.
+// Applied Statistics, 1988, 37, 477--484.
+func rQnorm(p float64) float64 {
+	if math.IsNaN(p) || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	if p == 0 {
+		return math.Inf(-1)
+	}
+	if p == 1 {
+		return math.Inf(1)
+	}
+
+	q := p - 0.5
+	if math.Abs(q) <= .425 {
+		// 0.075 <= p <= 0.925
+		r := .180625 - float64(q*q)
+		return q * polyEval(r,
+			2509.0809287301226727, 33430.575583588128105, 67265.770927008700853, 45921.953931549871457,
+			13731.693765509461125, 1971.5909503065514427, 133.14166789178437745, 3.387132872796366608) /
+			polyEval(r,
+				5226.495278852545925, 28729.085735721942674, 39307.89580009271061, 21213.794301586595867,
+				5394.1960214247511077, 687.1870074920579083, 42.313330701600911252, 1.)
+	}
+
+	// r = min(p, 1-p) < 0.075
+	var r float64
+	if q < 0 {
+		r = p
+	} else {
+		r = 0.5 - p + 0.5
+	}
+	r = math.Sqrt(-crLog(r))
+
+	var val float64
+	if r <= 5. {
+		r += -1.6
+		val = polyEval(r,
+			7.7454501427834140764e-4, .0227238449892691845833, .24178072517745061177, 1.27045825245236838258,
+			3.64784832476320460504, 5.7694972214606914055, 4.6303378461565452959, 1.42343711074968357734) /
+			polyEval(r,
+				1.05075007164441684324e-9, 5.475938084995344946e-4, .0151986665636164571966, .14810397642748007459,
+				.68976733498510000455, 1.6763848301838038494, 2.05319162663775882187, 1.)
+	} else {
+		// very close to 0 or 1
+		r += -5.
+		val = polyEval(r,
+			2.01033439929228813265e-7, 2.71155556874348757815e-5, .0012426609473880784386, .026532189526576123093,
+			.29656057182850489123, 1.7848265399172913358, 5.4637849111641143699, 6.6579046435011037772) /
+			polyEval(r,
+				2.04426310338993978564e-15, 1.4215117583164458887e-7, 1.8463183175100546818e-5, 7.868691311456132591e-4,
+				.0148753612908506148525, .13692988092273580531, .59983220655588793769, 1.)
+	}
+
+	if q < 0.0 {
+		val = -val
+	}
+
+	return val
+}
+
+func (rmt *RMersenneTwister) String() string {
+	return "RMersenneTwister"
+}
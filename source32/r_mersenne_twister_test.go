@@ -0,0 +1,63 @@
+package source32_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+)
+
+func TestRMersenneTwister(t *testing.T) {
+	rng, err := source32.NewRMersenneTwisterFromStream([]uint32{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the reference MT19937 code with the state set as R's set.seed(42).
+	 */
+	expected := []uint32{
+		0xea30ba97, 0xefe42c9e, 0x494070c8, 0xd4983733, 0xa4496f30, 0x84e378dd, 0xbc910d42, 0x22798297,
+		0xa830a591, 0xb47f202d, 0x752e90a7, 0xb817bd93, 0xef46ae2e, 0x4163c88f, 0x7658d28a, 0xf0a4cab1,
+		0xfa6d0c16, 0x1e13a6d8, 0x799968a3, 0x8f71f784, 0xe76e99db, 0x23828272, 0xfd280223, 0xf258d96b,
+		0x151aa0b8, 0x83a3622d, 0x63e45fda, 0xe7de7443, 0x726c99ff, 0xd604600c, 0xbcd31100, 0xcfa14f4b,
+		0x635b107e, 0xaf67488c, 0x0102c222, 0xd539fcfd, 0x01e0a691, 0x35292371, 0xe81707a5, 0x9c9d8672,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("UnifRand", func(t *testing.T) {
+		// set.seed(42); print(runif(3), digits = 17)
+		expected := []float64{0.9148060434963554, 0.9370754132978618, 0.2861395347863436}
+		rng.Restart()
+		for i := 0; i < len(expected); i++ {
+			if got := rng.UnifRand(); expected[i] != got {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], got)
+			}
+		}
+	})
+
+	t.Run("NormRand", func(t *testing.T) {
+		// set.seed(42); rnorm(5) and set.seed(123); rnorm(5), as printed by R.
+		seeds := []int64{42, 123}
+		expected := [][]float64{
+			{1.37095845, -0.56469817, 0.36312841, 0.63286260, 0.40426832},
+			{-0.56047565, -0.23017749, 1.55870831, 0.07050839, 0.12928774},
+		}
+		for j, seed := range seeds {
+			rng := source32.NewRMersenneTwister(seed)
+			for i := 0; i < len(expected[j]); i++ {
+				if got := rng.NormRand(); math.Abs(expected[j][i]-got) > 5e-9 {
+					t.Errorf("Mismatch for seed %d. want: %v, got: %v", seed, expected[j][i], got)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,217 @@
+package source32
+
+import (
+	"fmt"
+
+	"github.com/jtejido/grand/internal/ranlux"
+)
+
+const (
+	ranlux24_w           = 24
+	ranlux24_s           = 10
+	ranlux24_r           = 24
+	ranlux24_used        = 23
+	ranlux24_mask uint32 = 1<<ranlux24_w - 1
+	// Jump() skips 2^ranlux_jump blocks.
+	ranlux_jump = 128
+)
+
+// RANLUX24Luxury holds the block sizes p of Lüscher's luxury levels 0 to 4.
+// Level 3 (p = 223) is std::ranlux24, level 4 (p = 389) is the one with full decorrelation.
+var RANLUX24Luxury = [5]int{24, 48, 97, 223, 389}
+
+// Implements RANLUX24, Lüscher's RANLUX on the 24-bit subtract-with-borrow generator of Marsaglia and Zaman,
+// as std::ranlux24 does: x(n) = x(n-10) - x(n-24) - c(n-1) mod 2^24, of which only the first 23 values of
+// each block of p values are used. The larger p (the luxury level), the better the decorrelation.
+//
+// Next() returns the 24-bit values of std::ranlux24 (with p = 223), while Uint32() packs them into 32-bit
+// values without dropping any bit (4 values for 3 calls).
+//
+// The recursion is equivalent to a LCG modulo 2^576 - 2^240 + 1, so Jump() is a modular multiplication.
+//
+// M. Lüscher, A portable high-quality random number generator for lattice field theory simulations.
+// Computer Physics Communications, 1994, 79, 100--110.
+type RANLUX24 struct {
+	baseJumpableSource32
+	p    int
+	jump ranlux.Number
+	// x is a ring buffer of the lag values, x[i] is the oldest one.
+	x [ranlux24_r]uint32
+	i int
+	c uint32
+	// n is the number of values used in the current block.
+	n int
+	// pool holds the npool bits of the last value that were not returned by Uint32().
+	pool  uint32
+	npool uint
+}
+
+// A single value is used as in std::ranlux24's seed(value), longer streams hold the 24 lag values
+// (the oldest first, filled if shorter and truncated to 24 bits), the carry is set as in std::ranlux24.
+func NewRANLUX24FromStream(p int, seed []uint32) (*RANLUX24, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans, err := newRANLUX24(p)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewRANLUX24(p int, seed int64) (*RANLUX24, error) {
+	ans, err := newRANLUX24(p)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.Seed(seed)
+	return ans, nil
+}
+
+func newRANLUX24(p int) (*RANLUX24, error) {
+	if p < ranlux24_used {
+		return nil, fmt.Errorf("The block size must be at least %d", ranlux24_used)
+	}
+
+	ans := new(RANLUX24)
+	ans.spi = ans
+	ans.p = p
+	ans.jump = ranlux.PowMod(&ranlux.A, uint64(p))
+	ans.jump = ranlux.Pow2Mod(&ans.jump, ranlux_jump)
+	return ans, nil
+}
+
+func (rl *RANLUX24) setSeed(seed []uint32) {
+	x := make([]uint64, ranlux24_r)
+	var c uint64
+	if len(seed) == 1 {
+		c = ranlux.SeedSequence(uint64(seed[0]), ranlux24_w, x)
+	} else {
+		tmp := make([]uint32, ranlux24_r)
+		fillState(tmp, seed)
+		for i, v := range tmp {
+			x[i] = uint64(v & ranlux24_mask)
+		}
+		if x[ranlux24_r-1] == 0 {
+			c = 1
+		}
+	}
+
+	rl.stream = make([]uint32, ranlux24_r+1)
+	for i, v := range x {
+		rl.stream[i] = uint32(v)
+	}
+	rl.stream[ranlux24_r] = uint32(c)
+
+	rl.Restart()
+}
+
+func (rl *RANLUX24) Seed(seed int64) {
+	seeds := make([]uint32, ranlux24_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < len(seeds) {
+		v := seeder.Uint32()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	rl.setSeed(seeds)
+}
+
+func (rl *RANLUX24) Restart() {
+	rl.substream = append([]uint32{}, rl.stream...)
+	rl.RestartSubstream()
+}
+
+func (rl *RANLUX24) RestartSubstream() {
+	copy(rl.x[:], rl.substream)
+	rl.c = rl.substream[ranlux24_r]
+	rl.i = 0
+	rl.n = 0
+	rl.pool = 0
+	rl.npool = 0
+	rl.resetState()
+}
+
+// Jump advances the start of the current substream by 2^128 blocks of p steps of the recursion,
+// i.e. 2^128*23 calls to Next().
+func (rl *RANLUX24) Jump() {
+	x := make([]uint64, ranlux24_r)
+	for i := range x {
+		x[i] = uint64(rl.substream[i])
+	}
+
+	c := ranlux.Advance(x, uint64(rl.substream[ranlux24_r]), ranlux24_w, &rl.jump)
+	for i, v := range x {
+		rl.substream[i] = uint32(v)
+	}
+	rl.substream[ranlux24_r] = uint32(c)
+
+	rl.RestartSubstream()
+}
+
+// Returns the block size p.
+func (rl *RANLUX24) Luxury() int {
+	return rl.p
+}
+
+// Returns the next value of the subtract-with-borrow recursion.
+func (rl *RANLUX24) step() uint32 {
+	// x[i] is x(n-24) and x[j] is x(n-10).
+	j := rl.i + ranlux24_r - ranlux24_s
+	if j >= ranlux24_r {
+		j -= ranlux24_r
+	}
+
+	// a borrow wraps around and sets the most significant bit.
+	y := rl.x[j] - rl.x[rl.i] - rl.c
+	rl.c = y >> 31
+	y &= ranlux24_mask
+	rl.x[rl.i] = y
+	rl.i++
+	if rl.i == ranlux24_r {
+		rl.i = 0
+	}
+
+	return y
+}
+
+// Next returns the next 24-bit value of the generator (the output of std::ranlux24 when p = 223).
+func (rl *RANLUX24) Next() uint32 {
+	if rl.n >= ranlux24_used {
+		for ; rl.n < rl.p; rl.n++ {
+			rl.step()
+		}
+		rl.n = 0
+	}
+
+	rl.n++
+	return rl.step()
+}
+
+func (rl *RANLUX24) Uint32() uint32 {
+	ans, n := rl.pool, rl.npool
+	for {
+		x := rl.Next()
+		ans |= x << n
+		if n+ranlux24_w >= 32 {
+			rl.pool = x >> (32 - n)
+			rl.npool = n + ranlux24_w - 32
+			return ans
+		}
+		n += ranlux24_w
+	}
+}
+
+func (rl *RANLUX24) String() string {
+	return "RANLUX24"
+}
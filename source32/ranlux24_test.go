@@ -0,0 +1,64 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+)
+
+func TestRANLUX24(t *testing.T) {
+	t.Run("Std", func(t *testing.T) {
+		// The 10000th value of a default-constructed std::ranlux24 is 9901578 ([rand.predef]).
+		rng, err := source32.NewRANLUX24FromStream(source32.RANLUX24Luxury[3], []uint32{19780503})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got uint32
+		for i := 0; i < 10000; i++ {
+			got = rng.Next()
+		}
+		if got != 9901578 {
+			t.Errorf("Mismatch. want: %v, got: %v", 9901578, got)
+		}
+	})
+
+	rng, err := source32.NewRANLUX24FromStream(source32.RANLUX24Luxury[4], []uint32{12345})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the values of std::ranlux24_base seeded with 12345, discarded as in std::ranlux24 with p = 389
+	 * and packed in 32-bit values.
+	 */
+	expected := []uint32{
+		0xa5fafb6b, 0x0bc2af6b, 0xb4a1491c, 0x2f3380d0, 0x97ad29cc, 0x607f4a10, 0x2cda6e70, 0x2489ecb2,
+		0x96584b18, 0x8ca11a96, 0x18071983, 0x39d5e0c7, 0x17c27f86, 0x10a7b419, 0x04201406, 0x91329bf6,
+		0x8d756ac3, 0xbf712ca7, 0x8426a2f7, 0x76b72b56, 0x2406fdfb, 0x9c0b2f85, 0xe4a1194a, 0xcfc6e6a9,
+		0x39e2d417, 0xeb5fc25a, 0xdc253a45, 0x77bfacbe, 0x3b8f3f4b, 0xef6d8055, 0xbb5988c5, 0xab3af04a,
+		0xdaf55477, 0x0ae199e3, 0xb2ff7724, 0x206cb63c, 0x18e6e507, 0xc8da57ca, 0x2e2185b6, 0x993c568e,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Jump", func(t *testing.T) {
+		rng.Restart()
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/ranlux24_jump.txt")
+	})
+
+	t.Run("InvalidLuxury", func(t *testing.T) {
+		if _, err := source32.NewRANLUX24(22, 1); err == nil {
+			t.Errorf("A block size smaller than 23 should be rejected.")
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package source32
+
+const (
+	romuquad32_r = 4
+	// the multiplier of the 32-bit Romu generators.
+	romu32_mult uint32 = 3323815723
+)
+
+// Implements RomuQuad32 from Mark Overton's Romu family, the 32-bit version of RomuQuad.
+//
+// The state size is 128-bits; the estimated capacity is 2^62 bytes. The all-zero state is a fixed point
+// of the generator and is rejected.
+//
+// The Romu generators are nonlinear, so they have no single period (see RomuQuad in source64).
+//
+// Mark A. Overton, Romu: Fast Nonlinear Pseudo-Random Number Generators Providing High Quality.
+// https://arxiv.org/abs/2002.11331
+// https://www.romu-random.org
+type RomuQuad32 struct {
+	baseSource32
+	state [4]uint32
+}
+
+func NewRomuQuad32FromStream(seed []uint32) (*RomuQuad32, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(RomuQuad32)
+	ans.spi = ans
+	tmp := make([]uint32, romuquad32_r)
+	fillState(tmp, seed)
+	err = checkZeroState(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(tmp)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewRomuQuad32(seed int64) *RomuQuad32 {
+	ans := new(RomuQuad32)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (r *RomuQuad32) setSeed(seed []uint32) {
+	r.stream = append([]uint32{}, seed...)
+	r.Restart()
+}
+
+func (r *RomuQuad32) Seed(seed int64) {
+	seeds := make([]uint32, romuquad32_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < romuquad32_r {
+		v := seeder.Uint32()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	r.setSeed(seeds)
+}
+
+func (r *RomuQuad32) Restart() {
+	copy(r.state[:], r.stream)
+	r.resetState()
+}
+
+func (r *RomuQuad32) Uint32() uint32 {
+	wp, xp, yp, zp := r.state[0], r.state[1], r.state[2], r.state[3]
+	r.state[0] = romu32_mult * zp
+	r.state[1] = zp + rotateLeft(wp, 26)
+	r.state[2] = yp - xp
+	r.state[3] = rotateLeft(yp+wp, 9)
+	return xp
+}
+
+// Returns two consecutive outputs of Uint32(), the first one in the high 32 bits.
+func (r *RomuQuad32) Uint64() uint64 {
+	return uint64(r.Uint32())<<32 | uint64(r.Uint32())
+}
+
+func (r *RomuQuad32) String() string {
+	return "RomuQuad32"
+}
@@ -0,0 +1,56 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+)
+
+func TestRomuQuad32(t *testing.T) {
+	rng, err := source32.NewRomuQuad32FromStream([]uint32{0x01234567, 0x89abcdef, 0xfedcba98, 0x76543210})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the reference C code.
+	 */
+	expected := []uint32{
+		0x89abcdef, 0x1258bf25, 0xc3306361, 0xe8723ce5,
+		0xcef81460, 0x4f20c01b, 0x6e181d1e, 0xf3caec52,
+		0x07a00503, 0x668d53d4, 0x6e9118d4, 0x62db5085,
+		0x4a3d05cf, 0x7f719a1d, 0x5f0b6fd0, 0xcd641347,
+		0xd52d7930, 0xeb1a1e47, 0x7406af15, 0x68cec4ea,
+		0x909d3b36, 0x4b2fd746, 0xc7da872b, 0x3d064ba3,
+		0x67112508, 0xe88f4e4c, 0x2fe91ff6, 0xdaf82caa,
+		0x99048648, 0xd57f5e0f, 0xa59dd5c5, 0xacb008dc,
+		0xdc3e5347, 0xc82bba05, 0xa67430d8, 0xb89bc100,
+		0x9de6fbf4, 0x86d01224, 0x6a763c70, 0xa3005813,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Uint64", func(t *testing.T) {
+		var src grand.Source64 = rng
+		src.Restart()
+		for i := 0; i+1 < len(expected); i += 2 {
+			want := uint64(expected[i])<<32 | uint64(expected[i+1])
+			if rg := src.Uint64(); want != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", want, rg)
+			}
+		}
+	})
+
+	t.Run("ZeroState", func(t *testing.T) {
+		if _, err := source32.NewRomuQuad32FromStream(make([]uint32, 4)); err == nil {
+			t.Errorf("The all-zero state should be rejected.")
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package source32
+
+const (
+	romutrio32_r = 3
+)
+
+// Implements RomuTrio32 from Mark Overton's Romu family, the 32-bit version of RomuTrio.
+//
+// The state size is 96-bits; the estimated capacity is 2^53 bytes. The all-zero state is a fixed point
+// of the generator and is rejected.
+//
+// The Romu generators are nonlinear, so they have no single period (see RomuQuad in source64).
+//
+// Mark A. Overton, Romu: Fast Nonlinear Pseudo-Random Number Generators Providing High Quality.
+// https://arxiv.org/abs/2002.11331
+// https://www.romu-random.org
+type RomuTrio32 struct {
+	baseSource32
+	state [3]uint32
+}
+
+func NewRomuTrio32FromStream(seed []uint32) (*RomuTrio32, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(RomuTrio32)
+	ans.spi = ans
+	tmp := make([]uint32, romutrio32_r)
+	fillState(tmp, seed)
+	err = checkZeroState(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(tmp)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewRomuTrio32(seed int64) *RomuTrio32 {
+	ans := new(RomuTrio32)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (r *RomuTrio32) setSeed(seed []uint32) {
+	r.stream = append([]uint32{}, seed...)
+	r.Restart()
+}
+
+func (r *RomuTrio32) Seed(seed int64) {
+	seeds := make([]uint32, romutrio32_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < romutrio32_r {
+		v := seeder.Uint32()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	r.setSeed(seeds)
+}
+
+func (r *RomuTrio32) Restart() {
+	copy(r.state[:], r.stream)
+	r.resetState()
+}
+
+func (r *RomuTrio32) Uint32() uint32 {
+	xp, yp, zp := r.state[0], r.state[1], r.state[2]
+	r.state[0] = romu32_mult * zp
+	r.state[1] = rotateLeft(yp-xp, 6)
+	r.state[2] = rotateLeft(zp-yp, 22)
+	return xp
+}
+
+// Returns two consecutive outputs of Uint32(), the first one in the high 32 bits.
+func (r *RomuTrio32) Uint64() uint64 {
+	return uint64(r.Uint32())<<32 | uint64(r.Uint32())
+}
+
+func (r *RomuTrio32) String() string {
+	return "RomuTrio32"
+}
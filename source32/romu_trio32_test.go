@@ -0,0 +1,56 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+)
+
+func TestRomuTrio32(t *testing.T) {
+	rng, err := source32.NewRomuTrio32FromStream([]uint32{0x01234567, 0x89abcdef, 0xfedcba98})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the reference C code.
+	 */
+	expected := []uint32{
+		0x01234567, 0x515e7f88, 0xec068ae9, 0x09edc1ee,
+		0x6a05b71e, 0xa1c68288, 0x122db196, 0x3a45460d,
+		0x88a942da, 0xdfd7d476, 0xa8aece43, 0xd75685cc,
+		0xd27c48f5, 0x3fc097ef, 0x9b30b010, 0x1a6a6817,
+		0x4ab17a7c, 0x7b870efc, 0x519e58f2, 0x87f57553,
+		0x0fba3f6d, 0x3ff520bd, 0x3f10c5cd, 0xd6f802a7,
+		0x353fa0b2, 0xfcac7681, 0xbb4d1453, 0x1e70ef5d,
+		0xfa4119be, 0x9b1bdf80, 0xad48a03f, 0x19c207d1,
+		0x7029e999, 0x92703bd0, 0x2ea9cdf6, 0x4571356b,
+		0x7a271bb6, 0xf7f32518, 0x8b164820, 0x461fa7a5,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Uint64", func(t *testing.T) {
+		var src grand.Source64 = rng
+		src.Restart()
+		for i := 0; i+1 < len(expected); i += 2 {
+			want := uint64(expected[i])<<32 | uint64(expected[i+1])
+			if rg := src.Uint64(); want != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", want, rg)
+			}
+		}
+	})
+
+	t.Run("ZeroState", func(t *testing.T) {
+		if _, err := source32.NewRomuTrio32FromStream(make([]uint32, 3)); err == nil {
+			t.Errorf("The all-zero state should be rejected.")
+		}
+	})
+}
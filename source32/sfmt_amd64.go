@@ -0,0 +1,46 @@
+//go:build amd64 && !purego
+
+package source32
+
+// sfmtKernel holds the parameters of the recursion for sfmtGenRandAll.
+// The layout must match sfmt_amd64.s.
+type sfmtKernel struct {
+	msk w128
+	// shift counts in bits, c is 64 minus the count
+	sl1, sr1   uint64
+	sl2, sl2c  uint64
+	sr2, sr2c  uint64
+	pos1, size int64
+}
+
+// sfmtGenRandAll is gen_rand_all() with SSE2, state must hold size lanes.
+//
+//go:noescape
+func sfmtGenRandAll(state *w128, k *sfmtKernel)
+
+func (sfmt *SFMT) genRandAll() {
+	p := &sfmt.params
+	k := sfmtKernel{
+		msk:  p.msk,
+		sl1:  uint64(p.sl1),
+		sr1:  uint64(p.sr1),
+		sl2:  uint64(p.sl2 * 8),
+		sl2c: uint64(64 - p.sl2*8),
+		sr2:  uint64(p.sr2 * 8),
+		sr2c: uint64(64 - p.sr2*8),
+		pos1: int64(p.pos1),
+		size: int64(len(sfmt.state)),
+	}
+
+	sfmtGenRandAll(&sfmt.state[0], &k)
+}
+
+// dsfmtGenRandAll is dsfmt_gen_rand_all() of dSFMT19937 with SSE2,
+// state must hold dsfmt19937_n lanes followed by the lung.
+//
+//go:noescape
+func dsfmtGenRandAll(state *d128)
+
+func (dsfmt *DSFMT19937) genRandAll() {
+	dsfmtGenRandAll(&dsfmt.state[0])
+}
@@ -0,0 +1,134 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// func sfmtGenRandAll(state *w128, k *sfmtKernel)
+//
+// For each lane i, with r1 and r2 the last two lanes generated:
+//	state[i] = a ^ (a << sl2) ^ ((b >> sr1) & msk) ^ (r1 >> sr2) ^ (r2 << sl1)
+// where a = state[i] and b = state[(i+pos1) mod size].
+// The 128-bit shifts are done on the 64-bit halves, with the carry moved across by PSLLO/PSRLO.
+TEXT ·sfmtGenRandAll(SB), NOSPLIT, $0-16
+	MOVQ state+0(FP), DI
+	MOVQ k+8(FP), SI
+
+	MOVOU 0(SI), X14  // msk
+	MOVQ  16(SI), X13 // sl1
+	MOVQ  24(SI), X12 // sr1
+	MOVQ  32(SI), X11 // sl2
+	MOVQ  40(SI), X10 // 64 - sl2
+	MOVQ  48(SI), X9  // sr2
+	MOVQ  56(SI), X8  // 64 - sr2
+	MOVQ  64(SI), AX  // pos1
+	MOVQ  72(SI), CX  // size
+
+	SHLQ $4, CX
+	LEAQ (DI)(CX*1), R10 // end of the state
+	SHLQ $4, AX
+	LEAQ (DI)(AX*1), BX  // b
+	MOVQ DI, DX          // a
+
+	MOVOU -32(R10), X0 // r1
+	MOVOU -16(R10), X1 // r2
+
+loop:
+	MOVOU (DX), X2
+	MOVOU (BX), X3
+
+	// a ^ (a << sl2)
+	MOVO  X2, X4
+	PSLLQ X11, X4
+	MOVO  X2, X5
+	PSRLQ X10, X5
+	PSLLO $8, X5
+	POR   X5, X4
+	PXOR  X2, X4
+
+	// ^ ((b >> sr1) & msk)
+	PSRLL X12, X3
+	PAND  X14, X3
+	PXOR  X3, X4
+
+	// ^ (r1 >> sr2)
+	MOVO  X0, X5
+	PSRLQ X9, X5
+	MOVO  X0, X6
+	PSLLQ X8, X6
+	PSRLO $8, X6
+	POR   X6, X5
+	PXOR  X5, X4
+
+	// ^ (r2 << sl1)
+	MOVO  X1, X6
+	PSLLL X13, X6
+	PXOR  X6, X4
+
+	MOVOU X4, (DX)
+	MOVO  X1, X0
+	MOVO  X4, X1
+
+	ADDQ $16, DX
+	ADDQ $16, BX
+	CMPQ BX, R10
+	JNE  nowrap
+	MOVQ DI, BX
+
+nowrap:
+	CMPQ DX, R10
+	JNE  loop
+	RET
+
+// dSFMT19937 parameters, see dsfmt.go.
+#define DSFMT_N_BYTES 3056   // 191 lanes
+#define DSFMT_POS1_BYTES 1872 // 117 lanes
+
+DATA dsfmtMask<>+0(SB)/8, $0x000ffafffffffb3f
+DATA dsfmtMask<>+8(SB)/8, $0x000ffdfffc90fffd
+GLOBL dsfmtMask<>(SB), RODATA|NOPTR, $16
+
+// func dsfmtGenRandAll(state *d128)
+//
+// For each lane i, with a = state[i] and b = state[(i+pos1) mod N]:
+//	lung = (a << 19) ^ swap32(lung) ^ b
+//	state[i] = (lung >> 12) ^ (lung & msk) ^ a
+// where swap32 swaps the 64-bit halves and the 32-bit words within them.
+TEXT ·dsfmtGenRandAll(SB), NOSPLIT, $0-8
+	MOVQ state+0(FP), DI
+
+	LEAQ  DSFMT_N_BYTES(DI), R10   // end of the state, and the lung
+	LEAQ  DSFMT_POS1_BYTES(DI), BX // b
+	MOVQ  DI, DX                   // a
+	MOVOU (R10), X0                // lung
+	MOVOU dsfmtMask<>(SB), X7
+
+dloop:
+	MOVOU (DX), X1
+	MOVOU (BX), X2
+
+	PSHUFD $0x1b, X0, X0
+	MOVO   X1, X3
+	PSLLQ  $19, X3
+	PXOR   X3, X0
+	PXOR   X2, X0
+
+	MOVO  X0, X4
+	PSRLQ $12, X4
+	MOVO  X0, X5
+	PAND  X7, X5
+	PXOR  X5, X4
+	PXOR  X1, X4
+
+	MOVOU X4, (DX)
+
+	ADDQ $16, DX
+	ADDQ $16, BX
+	CMPQ BX, R10
+	JNE  dnowrap
+	MOVQ DI, BX
+
+dnowrap:
+	CMPQ DX, R10
+	JNE  dloop
+
+	MOVOU X0, (R10)
+	RET
@@ -0,0 +1,11 @@
+//go:build !amd64 || purego
+
+package source32
+
+func (sfmt *SFMT) genRandAll() {
+	sfmt.genRandAllGeneric()
+}
+
+func (dsfmt *DSFMT19937) genRandAll() {
+	dsfmt.genRandAllGeneric()
+}
@@ -0,0 +1,1425 @@
+package source32
+
+// Jump polynomials of SFMT and dSFMT, i.e. x^(2^128) mod the characteristic polynomial of the recursion
+// (the minimal polynomial for dSFMT), written in the hexadecimal format of SFMT-jump, the lowest degree first.
+// See horner() for the format and SFMT.Jump() for the references.
+const (
+	sfmt607_jump = "" +
+		"c041472fcfdf35afea67338dca1eb06caaba9f9352a9ae1cdb7e1fff5f51936009309e04b8ddd6daeb134e793325d125" +
+		"3854b064e964da6b00faff35047105c0fb7e67b868a20219dc84582ea554a37d"
+	sfmt1279_jump = "" +
+		"138e99359ac4bb7517d6b3c0eba80ac881996800f76ea47f97c3b4afbcafd7c73b83209704be6126265aef491768b73e" +
+		"d93274253f6e7f35edcd3950522ec96f69c30c07c7f15eb49fe99994aa688a5498140563e2cb68bd7155ca16abca59eb" +
+		"16e1109929ac5059a8bf178ad4d3d8eb36819eb63e275cf73805a2cea90b24306f44ea7f5f8f60dd86795a9602396a9a" +
+		"0841e1dfddfd7656d1e9c34566c9cb3e"
+	sfmt2281_jump = "" +
+		"e49e4adf70023e79812e41405226abc5377c54b6545df463d67acf5bc13e3457532b6052bedd0df6a841f3b6a6dc57f3" +
+		"59847bc34c0c8011585caa215e2eab149075454172621869f3c73e80d07b28ade3fda889e22786e9d8267e741ee0d942" +
+		"b23b7c37cdd7d032796fbcd57b4e7d4b77ff5c76b65cd69bc0caa9259c7d44f9c5e5ae4fe1f2b3d96efed836bbd3b1dd" +
+		"e8f36027946dc99240960a9d1695e03ebd584ade74d82afd682d5b58970ef1d4a5f681095189efcaf1be7e812baae2ca" +
+		"2319c29f755beec57804f653bada29d62061da62c5e64541b38c6c63e097d8666dc382c370e92960863e49fc5a606a9b" +
+		"66115ddce95113b435cf5ef3ec8443269ebe9720044442a1b52830555a5042b04ca579c6ac9fb41d9204eedaaa1e7496"
+	sfmt4253_jump = "" +
+		"4ed0a7353366fc0bb92662be364ce0830a82235ed8d30c235d18665d88d3b2b09d37a6c518b7939d94d76f99c946a267" +
+		"49f8ec570fd1cd55477ddacbb2d1edec68451913ddcbd147034454ef7e6f9a5f087c776732b925456dbb6e39ea3431f3" +
+		"332254661a882f57872b44913c7dc33b9b278a761b3f43dce30cbc84aab6e5f41d695480424d79b9c940fb3d8eea3ec8" +
+		"eb16600cd9d5e428604af46ef52ab4f64276ce29143694f1e4774a5b1b7bb68b6546ede244b9eacc10e8e5cffdd9bc59" +
+		"bf8f3b23efabc62c4adda28a65080340cd830a8118b94526f45c4b329fcbd470ec3b78964b6629f82ec878816dbf525b" +
+		"7e913fb1f36ba5828698fd3446c897488576b28a8e716461ce4da3f3799a75cf43fe8fb956322e9d54bf95cbd95381e4" +
+		"febdbdfd0c682b850e440fdfb480f8c31a73ff93ab16468b712cdabb891afdb2d6593cdb5e715851585b5d32244ef7f2" +
+		"daa939e57a85048a49a9b270f7adb127857cb551377c1518939cb2d042c02d5006168ee3d70c1c810fb989207564d635" +
+		"b3b8bb8c2b3bdb7be3958871633cf682af1f7cb0ddc8703aee2b886c9b1e486ef595b14df54fa543c82c19c9d5f04ba6" +
+		"2578651dcf2c5d95adaa2ba559d564ed8cf0a2c23c5f5f3c4ac23b9f120968fbbfe68b7a595916124c8ab2b48ce37a9e" +
+		"dd7cd03c6c4bb94e4e29c74097f2f4fe8feb173c2238b4999763062ac8acd063c7242361a88e6133b620db3b81b80d18" +
+		"d7c4f5f22a78f3d640bde390ee870295"
+	sfmt11213_jump = "" +
+		"ef5a6762aff014edcfebd49b3baa59fcd40936ffdb4a03161c976fc348419a99fff971e50ec08d9297db77ee6b173e1d" +
+		"a698a623a0235b64a93877f6b0e2591500b345046da41fdd96839cb00ea6ced80744b634363f43605d0f4ab0db054537" +
+		"3869ac3f74b1059f30f084eb7fe25ef398071edc578c3454d4599bafb679f8b4efeca757f68db6ec8d7ba432054377ce" +
+		"50db040e3cccfbf30c95b8d8265203939978c0ef1832ddb653162320b8936c40425c8ac77431fccd582ac32f6876068f" +
+		"7401e13ba7e74476f8c71a0bf3e34853b49fbab2c07346d2263566a5d677e451cc14063d04f7910b0813e8d07790ab88" +
+		"6822d7352a1141f843a1fb02dbbf2f6541ce69c18c644d2bffe557231f45a8ab6a710af305816193da06073601878627" +
+		"9ea243f3d9734f8bb296b65c594b4393381348274430bc258c586f478c4644ace76cd717969d2721afc36e77225ed5f6" +
+		"d825f49d246936aa7a45ab3988e35e36c9d0a9b7ef31338fbc6738da76b9e23ffd538ab72124d934d5e965d3bf0e1cff" +
+		"fc5bfe587d7102cd966fa7c1fac6ffdc0dc4b64a941e67e33c33cb7c08f8e4e5780fbe3d4902e0d9f621214a2d8dbef1" +
+		"6baac2cd20418c564b63d6212f9548cd82605c1056354e2b3584a708d985960c9c6ba79635c934c54aec2cec5c62c055" +
+		"7dff42a45b7b7e67cd101fb63c47a170d4ad65902ce8d190563aab4d452fb8d9d0c19b40513a253fbc8e18ab635c5d90" +
+		"173c5a28a528d8c3093160c95bc94c4042c846c1e71c37a1fe9ec39d9007834199514fc0838633c00c297eacdc0bce99" +
+		"4469bc60202b4c3cecf731cc07f199d1e24ff31fccf333e4ac58c624964a609033c9e58a9535b0c727130c0cddedc065" +
+		"f29b11bce9bc51f138e23ffc577b9d8a50e88f4af7c79a354e96a4f40db94492bae4bc30f5723c614066e09ab0fa96e3" +
+		"b9d57224a62fb84799fd5bf2e2fbc832fc702f7216b3d979582185f05fff5d640e7135bf370a79be6e7e4013d820486d" +
+		"db21432bb0e46326ab0c72d2752a717a201593a23ed954874f6a408e5e98304f37b0008430cd08ed6b3dfef46a4679e7" +
+		"baa2437a536210e0fd44bec89dd1b221d28b081b5d0de73bd773d9e7480b5025c3fe61b4b1f8119d344ef0ab521e7d99" +
+		"ce5decdfde21284755d95d1f57dbf60b92655a28f09cceacb0140176c2e65761e24cad167b980c9b00ed0446aebc22e4" +
+		"7fcbc8c270c83674d86b92810d786702864d8d62acc5ae663fe1edf89bdcaafcdbb59288aca399e8193f27cdbb62107b" +
+		"40f6c8351785460e0dc7dbd0f0e8b643da4d37fd6cb70e0e7d0afa078879e4c6c79cde4dcdace23253ea273817f510ae" +
+		"065de2afd43baed7815ad0e1f7d6160315031806e7f74027d20a3829eb42c3eff82e66d7d8bc5d2feedf5bd348b09eb0" +
+		"88e721ef3ca8efc09b492b18032c454557b75f9b90a8064a34e3903b3b12700d6db17d3190168e4057a4491acb14e963" +
+		"d008da0323f5d6b91e3cebba65bffca7a93fc3772c3892be6bd99efc83b414147b52d7757b586127b3d806e77d263314" +
+		"b64bfbbdb719281a7d24ef35e7c7dc0500aa25c4e058a192cfc9911e3a96cef0f63982a3645d033891fe98d9fce02118" +
+		"36b29429dd0f3c863b835e8378998f1e95150421b1a063566a15e51aaa7d8780790b9505104104ef21305e233e3bee84" +
+		"6b56c51e072534ddefcbbc1114c267a9cdf01d0c4f6e54da64b729afb55c636f7468ac544a24acecf411e949ee93e6d7" +
+		"e4a5c2526c871794d8e88ee13c6c3fbbe129a6b7dd9d6565a2298be61187ad1c6a706ce8c16525cf1836bca86f2d8580" +
+		"26444464ffb1f860306578ae834095f1b09b3138576cdf6705ae5d378898c44676fe74577c2e970451c39402d6e1ec09" +
+		"95edc007c39e5b5e7d5846018f772e4729a86f2057ab1b430cc4a431ff2544d96607760abeb814f932a7b64eaeea4290" +
+		"9c50a0747fe1c6f4ba04bad3894fda49"
+	sfmt19937_jump = "" +
+		"c5ecf0b605bcbebf05a6ae430dc3cd1777acaff2b99fe5221eaa2a2c8acf0ea0b9617d0b4a5e8f94828b6710c0f365e6" +
+		"9b690be81b206e04f64a9994b013dc1e046fb5e182b3d794fdd3d01d73d1c2aaaa03ce4398cce92cc67ed20c031a607f" +
+		"dca6dce456b8268481c80bcf740a810c5d73dcab943e05e8a2adfe5f81d9f118435ceef77fbe3d9d58baa17378e24001" +
+		"fea40b87181de63568f4342c05ddd174a617d1452cfcb4fd1df0dc94802ce7b8c04f21ced8cd34d29771f5cb25471704" +
+		"d2bfc8abc2acc47f10d9f72f781556c62e4a089f44c63d4a2d7b3541fe07c197c0bd10178c2a81fa7888d0ddc777502f" +
+		"8dda122e3bf335e365f0e70be77f0383bf2d0b5ba5c1ab9cd94288e59f086262c42bde8f832819e9fa1467661d68ff8e" +
+		"d3edd81c8aa6c268a72bcafbc3ab20c1ecb1799988abf25a6b9b4262bf93fbd2ef44fe16e6a2702e57b8d1f0468a58a8" +
+		"5cc74f2a75d18b3373cfc47cfd028f70ea8e6a5ef2e871b929b47f4fd1ffe3be9c4c89ac6c2c135fd1f410c8e0e9e55e" +
+		"4a75891f00e3a48f6a4a48b1e12f9032675e4942c502241e3d75b34fe803d6cd4e33709cb2632a4fe42c7a94d1a57b16" +
+		"6f1a9d19e58bc7d904740df1bca2481a01e6f390d647353dd18dc1ba77ac5334e3e0037413f2f41d33941ecfdd1cbdf0" +
+		"cbe4ad3c08ac47ec03c440971327b1631a8742ef3fa2d2fa7e76af3de0216f52d7f44d0efcaf927f54b673a0ade6910f" +
+		"7fb86da9ef4f3715d84fcd2159b3133371bdbf6e888276e238e3b03ee0c255000ae8914736f597f7222c1764eb1b13a9" +
+		"447c406257b23e707576b0456b76fc414a75620093e8f7017ac980a867f1221c1eb0979d14032c0838d976cf5d139b1c" +
+		"5a610ab09f117776c2167b201056b7f0cc246232d183c207ae38dd8d0fccc302b034bcdeb4976b2b8864cc5fc2f162b7" +
+		"2b55f3ee9cd28fd4f271063d4a58e3a66698d56487651dbf7a135b383adb71661d188f6c39521381dbc3b2bd01041f2e" +
+		"bd396f9f76511cffc7ef7897903bdee6c086e193c541ebb401dca2d28d779000a2d69740e8f6560477c23b01920175c3" +
+		"8604150200ecce4d4ccfd0a6cb6f4f2bf2ab37a3072d4e613356307338ee2e6767b6a83ec6cda267bb1994e099af4067" +
+		"d67d95341a1ca150f7196db5e2209969b3d432179a3d8da93f71825bc7b385d960f4b881b30aa6a4cf16a2672eda09b3" +
+		"efea2ed7cbcf77ed405f1aa175c59f5f619a54cc0a437ba97cd8d041a5ca0e75534b2e9c61d5ee2e1570945fe276921e" +
+		"18308db9f3a9805d798611f7f496d2b958b6eb4e946d572c471c619565832d20d0e82eae1eef9e1a2472c6c851250c08" +
+		"aae4d403558e1fde9c399e324a5e8dede8ca57b4492afda60cea0996508a3567a01a5e6e822bca9ec8ac223bc0534c31" +
+		"1ed07eae4bcaed013776ba0bbdb22b8bc038b61cb431c8079201e0f8304a439fb8dc1d71050e4d6c93b154a152def253" +
+		"ad808a11dea33c6d797c94ac0eac9b518096ccb85081c2c02a89e32624e700cd1ccfa7eb90592afaf886d4f61f1a378a" +
+		"b44f29dd73dfe5ab7a23a8f31a9b6f12639c81d3db851abff93938a60f61b659a481f286ba10339e0409f39885f58b42" +
+		"e255ad89d0484763459e03a04097d2427444f59e3d49206f784ef676914f4a4e11b66635881ab05accd0020559a780eb" +
+		"d53999974dae64cfedc4ee856ae2d3b3cd5d40337d33115dea8376f07da698f20c11dc86d8203d0d12bf3ae973bfec07" +
+		"00d2337961e1c4c9448cb5730d9457afb1d8891301f3264fd30cba095db3f527907c80fca305ea8b9ca2adcdb0e2b278" +
+		"1839a1ebcb7ff5b627ddfcf72170118606e5742be995af9d5e0f31798640f32d6e09438cc75737d6876daa5c0eea191c" +
+		"6aceff45d66b5beceda17833b2968b1e9454612f85a93c239c96a96eead57a36da005c661e1ac83427e5724b06b7423e" +
+		"99731bd126719998e17ab3be9d2c141002533c84cd27ff029cea709f5c585dc1194bcb6df9663a7b1c6adca72895bc2f" +
+		"42bf8019f52975398d8d68e64b76796e93187ebb1d92a10387c5d41687d8bacebf2705904783edd71b1b652f4acf1185" +
+		"289441b21ddb079de827dad3adfc68a1832a2ef50d9b4b324392267dd3ed9094eb442c4306758ba07225268026010bf5" +
+		"82ce2563dc4de2b6ad0c8b0aac39003f4b0ec473854125038a77be70ec8d41301c56e970d8aef57ce13f0619f882edc5" +
+		"82d78248178adf5576466b0215ae8b899d95df9b6753c87fdc3a54ed8a90923dce88b89f371235ca1d0f4c0d6737ee8d" +
+		"b168f7ef4aea9c3bdf06d9e96236333b7ee207bdf4c3603c55be04a890837a041f3a8e6ec6e3f91e57a94504098e341e" +
+		"c44a3236608d439cd0b5840d7f1f2c589fb356f378c66439dc97ee5b176050a208bc813a2db4b023dbc5298a013aefc5" +
+		"6792d62c245ff9d18228d53ae155918d0a6ccf61131cc5cdf34deb4545eb48a0f5b7e486b146af6699a756fac06e9a33" +
+		"1a8fa944a5b0046321a40635a709bf2b9bbff44d907d83924dc0d908ee1d21c60100ddb448687928ed760783568ec89d" +
+		"65fdbd077d5312683ca526d413f4bee45e814a160854b7329c83fe490a242595fbf3b85f32b4715f1034c9737d7fab25" +
+		"b4b29b4c90c9919df8377c5111cfdbb43e6ad9e467ff48d0d85de6457131a4b4ca6a69e3de7d09691a9b5da24dda54f3" +
+		"fdc5f5c316ddcc99842cce8af4440034f4bf6236fae7092d41a027880b8e29371a476451997d52ec9bc428308e171499" +
+		"e29fd008f7a9dceff044deb0e7164e774b248e472ef5f38893e7f71bfbfb4728e6063ba1111df7b978e81313388f5b6b" +
+		"c1111ca35885810ed57cc6fce9a8f53c7cf40cc360ef836bc73a937a1979117466b2e1ab4a11cbc0be9c0741d12d5d1f" +
+		"7c7333f7d34ec9d8734541f0544a7242895a40eec2d46e606d751b275ac6b7d208bb5cd112dedea4652a38b134ad0617" +
+		"71b9ba842108e77ad0149d3fb265a277a770d9083825b7876e8c30a1f83dec6d54da4da61dbeadf706e6a8d18d4e00fc" +
+		"d19985cb61c3e25439cfa68ba1f007b43a4d79c1f2ae8566318e0d0c0ac6e312fd9af2760258a16c1c2e864ec053ce49" +
+		"70520e1780c9ffa218fcbeeb803e553f29ed66da093b8498ca20e030c9dab6d2ab214837468cc65c3a122222d482917c" +
+		"2ef4aae56e8b0f7625997cc5e726ae9b110ab111b123e71e123ba0b2847481d324d6f5568878613ab22aa7fe23ac40b0" +
+		"4c82d9c118803804aa014d7b60d1d05c5bd4ff07e44cdedf09f8dbd3738366d87301460db172acba11fcdba924ed7bc0" +
+		"debeabc4ea9ed658d12abc8d529f8b9bc736b07968db946a80c9a6e0d5ba9b412742aaa0675b09844685059cd00ff7ab" +
+		"088813ed6db4460004d48f61f905c373d223a7363bcc5bbcb6d02bc711ac954b0a694c7bc40dbbb9eeef50282e63c560" +
+		"88786a70b1fc8720aeb993451c33a533e3703ce76e2eb8450796109cff8c2d4ba3f2e1dcd6d9397608ac967baa41b564"
+	sfmt44497_jump = "" +
+		"64295068b21a3e592c146e4f575bf6cea7134407e5deabd768a76ce10c0f55b036ad7196d46334b8b44a11a0e6337945" +
+		"a89c9b8e4bd23e0d6e6c5ca718758aa6a6f9f2bf123798f55cb709e9c227c60b7e27c56a8a5cd8fb51ebca602ea392a4" +
+		"71e957113d96423faeccf5e2f5bd385311f81097a24ca235aa54f0a80ccb8d1975e8555f08f8feeb7df6dde5790327fc" +
+		"2b274fe4c27d01e7368aac10c752acf6ab46c2299d326ef7f7c053c8856fb4960a9d24d12a50e84fecdfad373f5cfc0c" +
+		"4fe9ea970d5a4642ae5c3fa127b86d13e4b52a08c4da2950abfb680fdb394f1282171c64eb1ae0146fe2bcfdbc8be93d" +
+		"fcb4408aee1e76c8679046ef6e1ba34bccba92d41aeb805ef344623af086f21216ce88a9338ce97336e7677b414b16fc" +
+		"30386a1beac02612eca95e93b24f0422e515c358c32526a6459c104c4c3721cd000a196d7898134c8d2b61acaa52c258" +
+		"892c5e0a1374648f5e3a0d07e63cd71243d2c767c3449d250da58651c5839bef9e19884ebc1d55232034210359e8f7e9" +
+		"d5380a3227f6e7ca1277c32cb3ec7a1876e05e62d8e7f7582a078b4d14ac348e7428c7b408a821ebc41d70fc7bb07f84" +
+		"57a9e6915e6e6cf89efa2bc322b22008b0e8605c958b2dddca6281552ff39dc121ca9c1abfe63bd919f6b79bdbd5163e" +
+		"33d09676af991af4fbbbcb8da278238530cf07311cb4e6b71a4ecf66717a9246726811aeb953b8061644cfc890f75252" +
+		"9e2fc760de381de2a8566704830f2d3d477ceee1f87fb9af6f69160883d2534acc1fab1b731a06aad48069191c7b34bd" +
+		"4e9212e1a6eaa986c3509fa599e9a89fa132c8a40d24ea3295dd8c18b4a495c2fdb362e938085b78ab68bb0027b4e4f4" +
+		"44eea20423553a33c5df99ca1b8d91f2343e8f439a8cbb044e37f46a657f5c07791d069e31e7077ede300a1a923e89e1" +
+		"71faf0873021d284f10569e4440e638e833a2951b98cb9c267bc16bad6169fe44af06a741a683c21f5b1f782914b5ce4" +
+		"dd0a73b0e763e5a2a02f77d0ccff586221d49e2a088d4b6d69f8813afb4b58914f6b04e1fd5b8650ca9c2bc53c66e0fe" +
+		"7ece32cf820239df5e77ca32234c14f9e496e35c7f1843dad4e59d26b2e7fc07b2d06c12a2d740c56344e14ab70785e8" +
+		"16e64680e119a960d683ff2b5e4a91398818b6ed9ebacdbcbe7520f8d00ce55ccbce77956386b6f8dc87f242ef48ed6c" +
+		"f620ca9e43f336d37d91658045b143b3bfab5c32b91937e58b775877dcc530a8ccfe959e651642110f172d1ca0b8db39" +
+		"2cd08560e551429a1ae3cb91cfd5d7b11ad454a9ec8f1e90d7a6d885c2ce04a423af04883a5fd7a8e77be6fbc76dd6be" +
+		"b2633190746bc1b5e96e68adc47baca69bb04be5936b958fd7719427f5dabd7f5f0b39a8bd19468388de37fc27dc19e0" +
+		"1867c27be1d64512cbf6fd58f125e3c6f728f2efe8069a06a45a65682b39d822ab99c79c08918539f72581d0ec22badb" +
+		"17381f27efc0979c7fb81eb5f915c25cad7348be4e21d507f8141147a732a2ce3477810946aaa39bfa264527eeab2ef8" +
+		"2f12ebe12ced9e0dae9be51801c1ee67a8830de99da3cf689168e9fc65bdc1f57620dd3ecc6fb665a96490dbecd77c68" +
+		"b7f378f3256987d0949470db2fb523f22a16ba5967da98769299f15807e8d23ef30f2b0d3c183c13bf41e7d14ae64392" +
+		"85191514feb75762f031a279571ccdd00c8771c3712b946792b3407a72a5d67a84f368a0f6817f690ba7e73d50116ebf" +
+		"621713fb6feedadc08cc0fa9e87125853baed7459fd02d301d5ebee371efa16c6fae49eaa70231101741853a1611f7ce" +
+		"42372b24a6c55c0e72635de24891caeed6cae9615cfdc7c2f16c55b15e22284cb759dcafe122c82f2cc12eb43a79ac21" +
+		"e10515628ca9260261802ba3d221b9c18e0a29504b01ca0f33fb2511cb421c2f2e7d19f9af43241302f09d19e1d423c3" +
+		"621a17e618f6df1b13df93d4282da5e3cc146732ccdc94d0bdc82553f526231515727477d6fa96976755520ddc47efe8" +
+		"faa71540c6f92f001e9f550500b96a73e2d2ea8d16d49b96df9e44c46cfd5446c4f1068d7ada1b9d9650c27c07081140" +
+		"6240352d54423308f20faed3f6349454e52b92ffc37f3bed319bad40b024f91efc25c2310d1be4362f2609eb1e6d217e" +
+		"20694b2e4ff919dd062e0cbf11870da26241f05112f802c26ef8e1a3a44853b10c738e865e844fd28df17db1513a0fc4" +
+		"495d9385cd87f721f50db67fba391008dc22be9799deec7e529938f40b7a198e173a1bcfc4b74f71950e4e9cd665fdbe" +
+		"5405cb22028f3cfbd32e56d5ca860fec2a4971aa21421952d0d35a799723ef5f7ee0bcc98c57d59854494ec6df13923c" +
+		"33290ce6f1f6452b30721beeae6d9c0079e32a6e9e1d97e905bd5a9603e365f3d93aa9d35c59148bc34e4298d06d4f87" +
+		"65c322a68eae3f9d984de11114b230c3bd150a62cecd3d4fda9a26b6f2b14dae95696bd81a9dcc4c5e873288fca93b63" +
+		"251b9ee746a556920b3d437c478a962983c1830ccc1cac173fd96a39d6553263e1bfabf5ab9d9f2660b950d04f208917" +
+		"fb964c37184e637932dbb53c942c8dfae38892a32ccf0defe72c193720c9c64a68118294d77e35f7dda6a8d154bcf851" +
+		"a9e3c5b5bca1eafce152e1cbad4a14a78792354bac0f2a86ab5e4fd23d7b0003150310b67b921f9d6b3366fd5fbff9b2" +
+		"da92f67b9a6069a48aa203df87631e0b78b679330cfd618e1a843e3b4ac40df94cb1b7be0e26eb64167f7fbb0891c61a" +
+		"0b6e2cab98642ec251dd2154e72a1878fed2c73715be9169fd7cdce6adf7739c8fb6010eac0870c0127a10c6e559beda" +
+		"3d7151e6798e78809995d57a5bd0746608a261c760da7969be2a0f70efddba70c6aa53fc7fd3f6935e503a76ef19f95b" +
+		"dc274c04d9b20ed339749a58d03c5413640731bb1d4b348f36b6a8f789d805980fad10f8e297454f6e966b9cfb85b0a1" +
+		"cdcf2fc0d240cb815820bb1e4fa6bb1f9c9f1b4141c678d8073bc9939fa56bd0c42285c21ae7d9bdfe6a0adb14c776a0" +
+		"e0b64092010102689a912bd8e3d064056c3cfa4a4116363bd6f1c69741b7a115623c4f513b0d44334881c98c048de046" +
+		"df36543fe0a05fea3830f7ac007814834a8f81d4d45113df7df9980ea165357a81e9172746d5cd5b7ff5c8b173283b5d" +
+		"797c913f5e992d8c3d77e4df165b4254d05e845985556a4590f4c70d110a191b8cde5a01c0b7ba2dacafe94b1987164f" +
+		"f8c164cbf294faa222f664fe7072508d93a8a7703b549371785d3994e193c30d378126cb3e215994879a8d669470ee37" +
+		"bce2239311190e92d893c09d4d2b6aeddaf23de4c3408766276f21d01e6be9a3763bc80e07cd95f2333b316e540fc480" +
+		"b7c5f8ae6b2dfe3887c54e5b9c30c6d530918de08c5a57182dcd323dcb05e666075ce839bdfa4bb1ba1355ecd272afb7" +
+		"cd8791e982389de7207c3fc12b56b49dcea8b68bad2fb1ca6ff02082b847b2e640bb765ce2cbf0382ea12da0a850cf78" +
+		"0e6d9a84d22e81f12176ba78e2b4a5879d266f0f3cb7119b597f7754058f7854b575a5c01c11e4bb8327572923911d23" +
+		"f1001dbd16c14142157fe67ef8b4038187225b3e009987616ad8e4a1b841fcbe0dc27b95fad36536795bb253e92ce693" +
+		"a7b3fe76606aae7c6c7632f7de74c02874858ed8ca9f64dfd0a63aa1bc4aab51c5f178b908f97ae302c5e01c03af9bff" +
+		"08142b21275483fc313e1c8a275ca09cd34371a74202ec44f1b367dfece4e79b1055eca898cb563ef8ca39756d265632" +
+		"57c92579adfd707466c9a1ff030e76f81cb330f048aa873c8993be3a78c3b17e47b716d2297d90e180a440307ea5a584" +
+		"5f0721e935e50a1f542ddc6a2d54608f727eac914282a90d3e4b381d5f71ae80435ca267cf4fb4196a0309e56b559308" +
+		"441fa812d2918fdfa507e5b184994a9124a50af190e8ba6faedc465d17b5121184c47074b22de56543fb3b00713d07df" +
+		"50ebb8bcddcf5b2a6231d360a427cc9b9b3d01c6a670c666a895275d046fa728610939571f6d91589297008a19ed5406" +
+		"04a5eb4432be8e57b86cb542d0f6412988cf10bcda3e3691600e38a321c3b9456172fd5f13f27a7ff9ce0a14353a9c6a" +
+		"12d0e56a7c4edbca48c986df543f257124aa671baa37f951da98ad8af150e74ed98e852cb926c9d795e9f1067019d50b" +
+		"133416e7903c3af6d1c1cae4495e5ce743b45e10ef211577a22c15129c3d8a5af8b3ff3b56f17c75e406ccf53b21c3b1" +
+		"db5294a2fede6456b854b6df2646f1153da1ad4f97c8bebf151f558502ebd3cea4b165f1833a8b962355ae701fb724b7" +
+		"96a0ce8573019b47a6d6f10133e0cf1bdac16f08d54985860d5ff332ba6f05f7ef172b013e0ba02c6d81d72ada4a3e45" +
+		"b59703b39325531e7f76bc12c7b1eab23eef9eda1ca8205884335e9b8366eea959f23150c55295751d40fe3042c293fe" +
+		"20b1df91a2ac139a1e551caad750946440e44a0768566fb469635fee0030cc5060c438b29fb740f9f54baf5f38ab8a86" +
+		"2b5bdff358e53c4fe7cf097f9dd3e3a39b12dab567c926133ae07119e5ba007c1207ff77e8c34eb8a3c3fcb0298a39cb" +
+		"3d04addad4aa44fd74f7afa4d30b803d98736f58b81cf14a271b79a85f3f1ed52e509d75093f8430feeb5a4941013c02" +
+		"0acd57858b297a7aeabd09166625f626f2662012d419fca43e2ea2ffe2e487ae531dadb2e743c5ce76cbb8a074f92579" +
+		"d4b0b9abecfca57119f766c34a3755ab2bbfe35d3b67035d4797c80452aa0a0eabcae6456489aef7c68c2711e55b3057" +
+		"f9090ed392a2966cf86e92db60c6da9cb6e3572fbacd96ba89cea89b668e35e5657e0823523b994bb32b39af1b334b4e" +
+		"097d5e8456b12272f535542828fae6682e68812deaf883d1f44f07ae18d42a0cae9af7df7d434b40e6681d4f3698c0a1" +
+		"e2562a6b71c5b97410883ed322ebcb54cc80dd1f1fa57dbc1ebb3dbd3503f1b89c8d0d38da78f4c5dc129715d5c0ebb1" +
+		"ab9f47655a41fedab7d51246ecc63d1f48f498487aebc87f67e90b4f9ebbd11243f7fa281231b9b6d483dfe9602b78f8" +
+		"046c11f102c0c285b579aa15ed1f6463e769d9e1350009e51a82186579436fbb6576ba8cb627b5099df5a55a25acba0e" +
+		"fd1ffc2d5e285b90048d88af16a659549f40195f23c25c975e10dc8b596fd05c0ca616a69c937b9d067d3fc3032051a0" +
+		"87cd9615c4845ee3e05446d82c6943c2069f380671cca150a28ea0b72a4a3f57ff51fb32180b527cee9d91fb74f1219b" +
+		"613dd4802f31367be1b4ef9a2893373b4281d6905fd53530b25a03b57dad61891cca6ced385ad3f972b840a68a6f4990" +
+		"c8904a1edfe28d82284300c848e2c696871a80dadbdc5f6f8cff462b3ca008c6e8927789ed1f76318890254fd28981e7" +
+		"369c3c492b843175d4200526ee5748a73269b0a49c623cb90cf6b4e7e8cc9fc46fe213da820e33b0f02e7af52b085f88" +
+		"ce5b97773d1fc7b292e446daa31f046af7da55ba20c37c7601bbe58856d1411009768c06f332c86e84d1b7731a52ae0d" +
+		"7498ee07aa2b693d44bb87824b3458cb148e2e73bdaa621dcfb2f0c7a83160d9f55909d3270b47f8160b2343c9850e8d" +
+		"6211d95e0158ebd0d4574a9693179e34be0558a6d2e5532f0a628965ef25f0e07252356b225a3b513f14458349cceab2" +
+		"1d1d54ebc334275e98437dd14e8d003d41d7002ff276ec17299528dd6e4effcd56fc0e1080f168ff34b7fe5b15fdfcef" +
+		"9f190849340e0ef9ddad5996ad9b69cbfa86ba2c8d545e4f3103d2ca39052617d84edef717eef4194f5077a4bd676c8b" +
+		"4945d0e3132c52053ea8824ab2785c847f20f09a54df5a7287f99670d32bb4a38627f33c7085ceaac363e231de964427" +
+		"bf3abb1d78e4549b43c30cd171bdd46ced6368c68d747db36253db94150916e8bc03f36465825ddb6beae613b39f902d" +
+		"775c96b21384cf89c7261b8407c694427152f8216ebcc84ab39c6e8e012f75b5b4ccabff044707f45fde93ab04aae7cb" +
+		"31ad8559c29419abb10999105702a4a955071badfee5b483be90f04b1a27ebf3fb90abc2d2967b97f7f5000ef59df389" +
+		"c9a7d6e4d5b52aab41cfc6fc287fb6fcaaa611a977d283a4b6a975f2b3bb948da99f061747a6307e0e086c443d36b1cd" +
+		"d2463e0834869bfd7cfd1f3a4d1652678f180052f73b7cf710560d9e1ccb89f812823f49a3c150e5c155979067313565" +
+		"b5b3317979871134b3ed556b8590d86f7c69ba60ef4789d2b262f94af1e2ab1b5561b6d39f88dd1aed4f1d3514b66255" +
+		"b363a599fdc6113c3dd681e24400a3c0a625ff1690711a1cf121e8674c17ab2c0fd289bdbf7d20d5f4536ad26742e335" +
+		"b050e501ecc5dcffbdeb511c633ce89fa6b8b1524a76dbf4289d7d8f715515aab33fd3b5d94c120d4dde19ef18a08bb1" +
+		"ad883ae3318498f8f6f7b5ed69c7b8e5f09aca83c0bc3ceec1194cee920df840b08154f22a96287c496f5ed8be9c1a1d" +
+		"452e0f3468fad39536971e1b1db28ad20778f5f3f1a1f190c225bd8d724b98820cb95844dbe8b7b2b748c63e6aacf5ac" +
+		"d9bbc4d5d0100ff0358e279931879bf9bdf9de44fa70506f0c72ebb5e13a9ba4014418c7e503ca2516560270075dbfab" +
+		"888ddb8c6db6bba8bfa4c0383d4130a2ee367e1007e44a8a92ab80ffd29a2bad366fd08ff3e6a4294be3a782400ac3aa" +
+		"a9201503e2f829d7603a75b1356ebd760aabbb2223ab40a0a93092b80d88c62d01b6a6f9ecf347e58f430578c49abc9d" +
+		"b9b09f3003f52ae8cf8168e873672ba87a0c111755b7387c720b0e40263273e835b3f148b622eb97e18f7e30ecff2b09" +
+		"5fa60b0511e4b05f5bfb65cc3adbc98e217b65a409d5302b2c05f2d4f40f841274f4630c85504d77788b9fe8cb7e755e" +
+		"f8f734574766c695c4ae8799601b967cdd63782f706e34144ae8d479305c23adbce34efcc3ffc154ffbe00469ce2a19e" +
+		"66a126e7d51d5fac5e955d0a12d8c45be94bfd5e2e304991468e9e639bfd086af848d992e54ea976dbddc598b07918c3" +
+		"567038c8adb39dce2b3fd01bed49597416bbcfbda8c80e0c28dab9448b6c989add2796f12ef479de729a3031ff299d70" +
+		"059606cd644ad82952bb76a259d39093f1eddec5a3d65ef203d4045c5efe6174fe480378a2d2a42039d3eae9d7c887d5" +
+		"77f5232e70750e0fa6937fd106f223be6b3f38b0946500c2b09a932a996510266306abf4ce287bc183011abd9556160e" +
+		"c3b33148af15c05ad1470537815604c330174c5e7d50f507a4747c76f521b64bbed7f1cc610e3759d3ac472ab872435f" +
+		"09f77c2f1a1948af8d5801fe6c77d0e78fc4034a0efa8319dfc49be7be064893021dc3be1eb44cfb1220e2663c813592" +
+		"2d7e7d2b1207f61fc12723909b42d800171b8263451372b1070f4a0617ebaaa232754425cb7e75f92f4366bd683779a0" +
+		"ff57d67738d7defe9827eccdb62d7fa0f4b45ec045c9d2289f36c95474c8dad4f8f0c788a8c1cd72a53f5d485b64614e" +
+		"a86f4364d23ae5b5a2e0324d83cc3e2d7456eb2c61b03a9212cf869685b15455635cb580671b546f8364e31ddd17845b" +
+		"d0bfae0fa722b7446152069e1d4a34f43403b04170b8462e59fa3e34d42029d6996f2851c247e82f35fc0d3a4e927f73" +
+		"924dc637c9883d64710d49bdbbd585780147f5767e89aa564165078e4a79c8a4eada0a8702dbf910da5c6ffe2abcc4d9" +
+		"6596139a91de100b1ae4af10fcd5ad836225010e68ae7924b803d31b39bc098aafbe519e567d4a13960f152f05c45656" +
+		"d22d8bf8bf2d8dc364721a2474b32793d2cf2dbbfd2c4e55ccca88676ea94442b162d783970419862ead4b7e58541c28"
+	sfmt86243_jump = "" +
+		"adcc1b9b87683c814323999171f81308218797af2c7eda3ceab72256e7221d2347ac92288ef6edc38d555e95729712f9" +
+		"36623aeb12d405c54ad40014555d0e478d34258ffca958370b97e97f629f3426e9cc7c9bb55ce9684ea1e41e33f2c917" +
+		"b8a92286ca1c64e75fb662fbf4ae5b97bbd15f0d47b6a99d799ec0cb3158e11835dd326c8bf42c0df920a4593ef72771" +
+		"4eff1f36058b1ab9b33efdcfc84fe636e634f74c8b57336b714abf518857c4df3845d548c55f2149f398e9c6de5a8406" +
+		"8db5c446212a5d95083412bd1b0bd6fbdb1a992eafea01ccc6118aeaa3a74a60bb39093c1b31e2129ae1846e40305fcf" +
+		"ace55228f0089c1c00265e52a524ee605ce88c11975aaa52575da2f226edaa341f609aacfade972324514aa8539f3e5b" +
+		"855cd911244b4d33425224d63cf23d615dcb450f04b7a496023ca2d996859c6b3210b8e0db155cdab2f6a09eb998ae75" +
+		"0e812e1ab5e153e6c51e6224abf0e093c4d844538b17b2687e1a6dd22c7c83f6e7d8a9b5cb73060903aa4de752976836" +
+		"d15d4cba4e221f8c66b13d09fc878c2cb3b5111c5bfcf224807a74097757dfac3d00eedccfcef084ee145512e1092c95" +
+		"a481a98d096db461aae00f84d1a144579fb450b015f1d102ebd2884dbe4fd567c64e293bb1f1d487f8a2d10ff5fb90d3" +
+		"49c80bbf3d29394ae183102028628be11fbbac5883badab95f73a8ac6706b00e759b42abe9da48db3f1b9f1a7279b66f" +
+		"1fe1a63f2557f31a1ed8d2d6f4808d13d0cff6f9965affaa60156f29e6b31df6f3a743b42151a94638984bf84802c4cb" +
+		"c2b4cb84453f94021f13cc833ef4b7ff575b72fdfbbc9363b90f10bb89f8f351c6a4c5801be35084b8487b834036a3bf" +
+		"67d5df18fac54897bc5273ec352d8b27a3b701e46e7cb6ef0690a4c851704e1094431e4fb9e99d609e44d1cbd67a8103" +
+		"c1b28c7c0ff0eef4d4b32860cf7c8ac9559a44eae6bf9e7f8c0ad0a68d612c0b4e13f2c94fe66d50c0b7b0e14fe6b78f" +
+		"ca70966c70f9264201e244ab223f360a81f184f2ffb71f7ed81208bc163f30fcf81dbdfd8edabea326514d9d1d1e9b14" +
+		"36caa819cd9717aee33f625cf9ad8cba282742ef8e0029b0889ab4ac8f288b8bd676b89759081cd2d7e2f692258143ad" +
+		"19cf468a516f48e96da2bbc0342b93940f2016915f802e4559c210e2d511ddbc710e6fef00d5799e3708f1c2edf0c264" +
+		"c3426e2df609c59cb70173cd955f9482a9e72c7f0f0f1bee9619e28f45abedf6ca7867612a772a45f151b64795339d34" +
+		"325163dc0949fcdac6d6e290a707ee99b8b104405968ec898a00adba49a42e872eed331ee737a34e2f4b62182ba495bc" +
+		"cdf0c1810c09999d4354e8c9e20ca1b387ee5bcd734640390d5b2d29aa87ed627abca9822d96df2580f63e14287bb68d" +
+		"a238b1c764c08782f1a1cb118cf00a6651abcaae6f6fc92a7fbcfdb69e66aa2db6d61a87c3444c5b963ea1ca78e4dcdd" +
+		"d1e61a74a6ef6aac425fe344b7da80665290fcc798c5bbe7001342c50df61d2b319393da9d99db3a75aed13d8d39d0a4" +
+		"7b29700c9e4ab5fd4a90e39fda1a583983e6a06b376df457e8a3b80351070a29a0175c0d8f17544ea5eb155c7865e9cc" +
+		"aeb66f2454ca9a53a563c18786937470e651eb9b4ded768b38feb214e4814e5c3dc0dbca5fe15d25ed1f783f9554f3f9" +
+		"5b9965f7ddaa81cb8f67bd01c6c4e7232a16bb884f23eaa65e2f0295b89dba8bb1ae25873890a3941cd07a3ad6177874" +
+		"1ee863fdbb615362e14e19c26a8f0a1f02d6f983b865c5ab457709140930cb34ae94ad7b3e5e88da3edf31b237480d8b" +
+		"fab22d3e1a34e8b2abdb7d5a60bfd57eb591f9c6e194af3659ad63093c2047fab10b881d4964a91e900477f92086ba1e" +
+		"3cd178b23db9924270b1acf983aad7db3553e9262b8810e64777a64a1b859fe09997db14234f598e45526c1e6410e7b9" +
+		"38a42cb5b1bead598b7aed43dd899e8d8cab31963b0e044517db07f6836f78028f310acf609e61815510f533dc13113d" +
+		"120a56798b94b792e401d344a6ef283e6397c865e36bf201c6f1b4ae83b38082986252cd222c33c5b280b1839509eaf3" +
+		"92521b05edd5bf4edeb37a9fa3973271325db6a27880ea4b47873d0af162394858b3d3317bad82dcaffd5dc4c46b73a6" +
+		"88140a3623dadfffcedb67bc79757f9a41cfda18056e4c0bd4a5fe55a63147ed79d229d0ac9f1d5eb7b0385303415546" +
+		"3e807a2d5a1cac6fad16ec66afcb68a123a54a59891abfc3efa0015fbb35624d9670d881634857a0b9a37f666ba759ec" +
+		"d3d6ff09df5a17a8dc8747bee4fd6ef8241d9fc30d7d3425f7494c35c79078c55311ff6f4f3867f57b4dc90364255114" +
+		"dd4e339e8b2d6689472bcb25e0867798b4a9c247cacf33e2c7e36ef97a5c07b51a999313bfe0ec0e1c594cddc1239c96" +
+		"f74b4ac0d2dbe11c16ad5f5276975c1b6133bb7174e61e0cd248fdb3998c0a90d1d810aadb9f05b908ac0228e731c4d9" +
+		"9d0a99b93af96df81566b3d3e4fb38aea7219e375e43a6d9fb6c948c550e9de797346d7e9d662d290eca2afe408bfb0c" +
+		"451c93bfdf5d4d63d77adf417c77af1b1bf7a630bc2c93ba13184f11ccebe0e4483c332b75bb9981d480313240a110c4" +
+		"9659c0684caa08f60b1bdbfeefb469a136b5830bd74febe17c2ffa32f0f6948a0aef599e796ed17d91d3d479c5a6dbbf" +
+		"958ef11e5814ab75fbef79421a33043afe03443564a9a08614a72051421061542bacd6bc873a38983c45ac6d83115fd4" +
+		"4f9baf061e524bcfbb3c66f397425522d283abb0b9c3301a9ea18185807ea7c1243a97a299a4ee5fef2dfbed9f6dd8d7" +
+		"a2069f07ebf01f7b60d35021d909a90896e3bb72e0b29491577feb1bfd971d76a43e8a7ce6856a6961333174f462c7f4" +
+		"fc2e727aa84ebe966fd00e8322b1c4e0bbc51694326889992f3f9f725cfb427449ece1412fe45ae8c457a6b86f16e855" +
+		"9f2a27a48f95cad298b608207aa97bee4c914bbd7237e7cdda58e27b46dfb504eadf3448f64c5432b6f4a291469b4a5d" +
+		"2b6d88283de75e81d4e6f43d8bbdc5c21d0eb84ccef0092e6e4070099a0b2ea42f69bbe178611ad37cedbffe5267ec0c" +
+		"9127810357780e0fbb089a635afed86426c7da81fe944cdc21692baf7ebba477973a606ad5827522a970cf748d2abde6" +
+		"5e710ffb9c1d30bbfc8971ad12c060b504d892d2135872dadb3b9c7bd589cfff0262ce552e98177fecbcb459fa39e825" +
+		"f2eb0480daaef4c8fd0b3e7b42b595a1f396378c670ea60729e68224b00abd3bd0f2e2870d004dc914c5b9771e2b7f6f" +
+		"17a3068c0c63c1d646586f41458cfca60277949f67effb956dae43f6d90b241883a3f47f5f4283474feedc72024a3d1d" +
+		"b846d8019c3c8a15c83856cb82737ed108f03e9ff482e8540a5dd226125f89e54e2f0f981d74203cbf97d728ab927b17" +
+		"a9d94213019f3ac1c0452c0477f4122ea85fd9c54f003b0a78b6474ee3a896b7fe3b3bab6afc2e6fb5f03a6a0a85336c" +
+		"a18b2ff5ef93fa25f218e2d9cfa536af81723c1aec9c34711772c646c4e5aad31c6d8f9f6705802096c20c1523a50514" +
+		"de3ed324b7c568016adffc7e9b40ec72aeb5a319b37f9429a398beb7aec99b5d326a8a789388c5fcc75108d74ad00049" +
+		"7aa7ee41c4064587da818c539d898456a0f3ee3296f55f99958fc625a3f4b23ba7c03b4eb77946a1ebf93eb5e4e99a02" +
+		"ffc673baec38fc95be05992794024b7a2a4655cf0af908ec995b62904ce3a997bbd10dd15fd1cdb26b34145213457d98" +
+		"6cad532d70bca34479742ae9b1ffa53b5c5bba60758f47d3379b293e5aacd891c446caccccc81ea558a339827ae450ed" +
+		"e42ae23d9914f73e1a2326632aba6bac40d4b163de95c416c710fcc49f7022fc01b05916084851d2a5c1bdaf14db2c72" +
+		"8b7c0e7d2504a055c55e1e65599d624f5a37fc6a9bfecfa5df5b88ba0db042115c1852273b9597c150f23562a96498fb" +
+		"c6028dbe3a0beeb89a20204517e26f102767b96e1995d6653c6dfc5e367c7eab874acb50cc6606eb43adc8a9f1e11e49" +
+		"561449649fc10809979ca78cfc6a9582abb621522a40f219e1275bf97444c650f6bdd132f3019b2aa601cd52da35a76e" +
+		"32e1f7bd2b3c81003a1a578eda3d3490bd1cacc473be79e3222ed4779a8a45153248235fdc169c140baa558a0afd1ca1" +
+		"9188b185e6ec340463b9f2fd3d72520e2bf6a0fd4995a3a5a66ad0684016ef7cbc3f9dddc9746c97dbf1deb370ed7866" +
+		"95cff30a5c423173ea938360f5ad853bc972d8fd5be6e59d96299319c516573ce07b0d1631a6eb96543999fe83aff887" +
+		"34c55ec712e587373296e5f9bd5decdbe82f3ec8ea34b0350718f6b765df584d9a731dceba41ef791fef7a20a7fa2484" +
+		"954ab8f3ff2ad642825e1816fefefdeb377475c1216e88aff7039fef1eea240fd759f365792ebbe5885469456a22d985" +
+		"e7e069d65fe3cf266b464525bc8becf1db4999e5cac23caf3da55d5fa3664cd7bb9dc284e7a566245eecf0cc70fc9d16" +
+		"33fc7dfeb1749d17d9c84c567dfb95bcd2f095c0039ce0b35a550ac3e1a6e124218df101dd0f4fff0b2a69b869e08d43" +
+		"09e6774a9ab074528455a5e8e948e7ef6cd16bf782f4b030b22275be38c860f312c36b89a3764f6d3a0430806e058362" +
+		"eaa138ece2f67f995609033b943d12ca87ce460b731fff571feb13f18d05e662f90c46ac73ed43bdead98327ca8ed207" +
+		"a76cc98ec00698235c3ed344dade033b7da6f5f26d49547b5f5b0c61c883efb0884fbeb24955226f999f30aca45fcad1" +
+		"a3e4641e38255fd571f744a6242eeebae2abd3bf76be471c4ecffe350e7a1e880ec2e9fc78109dc1073cf143fb360ac8" +
+		"af8a74e1928a62671d748cfb646793c6575495603b0abdfa17b8fa8d93be458e6079e7df500a59f91021e8d494546ee4" +
+		"5346a585568cbdcbda0cdb5dc0824172622fcdb6133130ff4db48782e24750b055ca4ff8ad96c0eae8c0e99c0ad6449a" +
+		"f7db9f0f636824743785b9e405e1402239c1d902f310bcea4dc2f142b594d7bffd9137b715bf6772d2ac85d47b4fcf9a" +
+		"869fd5bac13e853f62cb62c07a3e7c54612cf6a23ee0db24eb5cd4986ab5f0c300cc434ed0ffd349133fa51c0960fa45" +
+		"1945fe630dff9303b26322e21c29075162e2d511a2f229c9d27020a94660c129603cc494908a89e67fd1538354db3f1b" +
+		"c28c96ea467d37fbb694874a5acf91b60cf80bdbe42032480f127acff707c15657b86306b1106a7418c23ef855dad6bd" +
+		"9d472fc5da428c073906e4eeaf82aa477dc9ef3b8df571218c649043fab292ae7c30af3415cf59ec4191249f3851f512" +
+		"c2bfa6d2365b9b6ca7104cf5a57c2a8fc400c96ec489b31f5f86cf1a3dc72ad852e98a2b40466e4cfa87b4289cceae54" +
+		"39798b2db45bba5c77d5a77269d2dd5ec73d7da8474dcca1ac193c54d906aff16e2a90fbe184787395dfa60b250c0af0" +
+		"2bae42e748a2d660dfbefdf280cb65a11e078b698c3f37a69482470692d12e286829ee2ab65d4beac80b16b2fb57f1dd" +
+		"8258ff53ba6451f5bf9d2a6f941027634d23676bd7207c176c52ba4fd4847426ec6348067286bf28abaf9f51176165f0" +
+		"45a9a3dfd5f900b4f5b74b1c3019ddbc8f545666368b3b563982661435bb5b10b06a0e5d8761d1fb67cd9595057d3b36" +
+		"03c5163791bdc2cdfdc6c20a72b5396407d878d7b4d46e41b276f1639e5b8335f344585bc9bc62c017beaec9c3efa0e4" +
+		"16b7db5a11dc8d7e450724ed18de7ae6e044caa6a360006ac3acfeec5dcd9324079e7bbb74a66a9bb14916df24736069" +
+		"28ca88692772eb8ce699aaa0f5deb7381c1d2ee435cfc5b9060e581fb355225cbacff890409d1995cf6a181c17355355" +
+		"0d16162b5a85ac22abfcebb79f1457f0bd7585c37c0812d8c65ac1fc0db0e83085f0a1efda9ed898b4da5c3640dc5607" +
+		"3cd2da75669d3e871ec0231ef73f15a78da5aa2813953fad6326b1feeee8899be6b47b791b00743b0f9d62014a6bd211" +
+		"bcc917e7e42bceeb8d456d064386d48a92e6b2bba2b2bc20161ef6f3fc0e9c21a891e3ddc9ab4efb7554b6867c188b1a" +
+		"7193eb711ae396d9ef21e668cd0a62f7421d7d1fae65e076741bfae70c6af266dc8f308a5dd3ef8d45494a9dcd172490" +
+		"fa21ac26072160f01844fea955a3d69f51a9bbc6415a8a4aa421a06ed6f12122863a7f9198d934418ba1a421bbbd51b4" +
+		"7f228c17ff0c96e45fcca3e1450a93150312b27c36d17de3f9968162ebc491b6065990da121067eced4f78f764a48dc5" +
+		"bfb893e62c441da3f7fe383c6365a944ef4772e945e967cd1c9800539202cf32cf873e69538a64d9e0c89170861c4fbb" +
+		"661cf0b14fe03f2e5bb75dca5f428cc9751333ccd3cc787993b0aac46851bf4d14b3d95c6a8c2863b04c256b92790d7b" +
+		"f4ed84614969733caf5c537c20aabb15fd4f31e42f7b80c180fd4de1f30c93ed0d3d63be1059cf59039f31bf93c6202f" +
+		"39ee14da9e2d587086cc26d2d7a1d82c935ea235ad03036512c55aacc470464c7e7283cf3554eccd8c7e71e2c9042335" +
+		"93e6b3fe3233993654c4c5005cb64268fd92d768ecd83b608de16631e4afd24d763859c25e4479cabd49bd02ff03715a" +
+		"d114854820116ce5771c30eb933346f9dcf2d809ea683f1a98ae076346673bf596f037499b5516dca7266f208df7903d" +
+		"54429e3c74b883a3a060cc0e1273bdcc40be122a831b8ebe86b718d0cf1140607a4a9b168497d45c83889a778840dbde" +
+		"f0a878d95d870003c0d68176637fb97a15e689789cf0a8cb1940ceae2f336be5fa2272b0b1433196d575e769e6676299" +
+		"c24893c81f87ac6c78d98befc352e2a9d4f8429f23c6238a3ea982205f41b6fca146881957370531b6dda9921b23323d" +
+		"ae339266b3016f59070c23b9a6dcefcf3b8f379a4fc0c08598a784d815b20d4e2f9a66cdf610360af52dff29f690f2ba" +
+		"b252b1b9248d31ce54358e321d8b8ede4129e504c62ca67f75fcbc9286d92ce894f2bda84c65914c895a74418608458e" +
+		"63e0d499729a81e7defa25bd284cb9262faa31d2e9c9439f7ebbb3dd6194a069247fd1b0dcdbde00e75669a43458eaca" +
+		"3d085ebc07f20b73ba21a6a9bc4974ac74184544640b43a126f1e6726ffc6a5701ed89583f4c505dce0bd6285c54cc09" +
+		"ec4e6acc0870cddfd86a01098cc786094cd4e75c67f2714aa676fd12e545093a467d3992077191efe556549d8e0c1ef5" +
+		"15a442ca8eb82f8f22018fde252f898304ef4d45dad5587f5067bcf705ea183b4cb9d7b78f047e0a848a3c62629e21d3" +
+		"ca8850411d9187be6d3998d8acbccf544ed758d2e459a23de0ccac050e0c2224aaad506707af571fe4a8e4903faa2588" +
+		"597de78a7f7f4316e35f5e241404f473cacb94060519ea42684b9163e4e218834c1e38aa8cba6d928d5d6a4c06027344" +
+		"7941727ab71ac6935adf26729a0d2a20923e0d2d2283e90e244084cb0be946ec2ce47cb4ccf75b607f5970856982790e" +
+		"ccce00276ae6ab2e7fd73062ce9f036d659c8f7bf37228b6bb8170288b85260fa1b650ec945549127e08e17cfdc16830" +
+		"e6c2c599b53bae50543a77d740ccef8677a2b8421bd30b8e8f6648e50e9f55fdd332f0d2a23ac157f2b774df349a3c93" +
+		"fee7fc9264f7bc0aef026d0778cf4450d1d6f0560e9c7830445464ae7ea25d27a64926b91d2c951a629801a411b77458" +
+		"85dddcfb0e721d365ac5d680ddd440e74ea1ceb205743a5c29367da9abe02caa1c6cb498d4cd74f9171daa87e8119de2" +
+		"343d371149ebb0e15784bda04509fa612d1a47b0be4c041047675402feb869cc6170e57cd55f8e6f7b948514bb010c97" +
+		"93f7378a054d6c6481658025ac162c547a97866d4f4f17f92129a7da532c37a51b316f0897dec13fbb1b674b3af659fc" +
+		"6ff2580a2ccbf7eca17ed30959a8e2a7f0354d830bf1c3b0e92b99d0e6daf6faa7494e40bd86a5c1890d13019167ca5b" +
+		"f20d530e4a439f44903953704ee96a60d5e64d7782f840939483789f9f6e212067501755b606a1f1a3554f0ff8366e50" +
+		"f8d60fafe27800df26db84ad9dc7ef758637fd109315e0430ca8d751723174e30fab893390b577b23d42773005a2342c" +
+		"6aed8412dc33150366f26c9c84449871019dc9d450ebaa5f0063f5b34812d6a29c045dc32fe9d1ac625eda4473ddd035" +
+		"c9cbe47b520f7ee96dc0d612d63054c139ca9ecfc04573aa5263b740b71b2859b2b1262df83d4bb10aa937eb46705c9e" +
+		"f4977eed45466dcdca2b32c220ee775311a167b42e01cb702b8e5ef643502c76d48e1050285c0b9315288f6f0a8504bb" +
+		"f7b97edc28fd4970f7bf08629bd305c3b26f8bb7d71f377e7d39a11b6ead4fd6d5e2399e16e45eb6ab8d1acec97cf09d" +
+		"b7e07c9923712bc25ce5d0cf7b5999423027b881305148676e8389ae88527ad5041523717400acf6f856f9ab4b8f1b0b" +
+		"0d5d760b390c5e346ad0548061363c62ece809fd4e97ce74a9c3fb5069b4593eb04f6b6ac9c6854f8608ba52e5338f43" +
+		"abb0317b70c335975c773e830006b855d1044ae18df761e5e3563a5303e8e8c9e069140c40f02d68b7fa4f78436a8e37" +
+		"f6f543818fd463373737881595a3b77e227e25176f8ae0ec711415e66a763b0132b6adc81f8e0542faa4a23573b4b585" +
+		"ec07eac42904c0091f062b1725d8b7b59edcbc07a5bf0212afae62c04c1127de0027bac276138209d63c9e6add5ed2e0" +
+		"3c0804efc138d2e8e86847075d0347aadb357b33c4e384730dace75d23d4acc010e412f7c65a6c1b8d0d3100f794505d" +
+		"b8c26a8e7f8101b958c53810a0042342542a2d4560d571f8dd40d83cd58889c7d7ba03cb357026f9950135aaf31d38f5" +
+		"7ea6408a4474a6efef3b56fc45ed289d8d1cea550ba68a21d40a2bd0fdd2e1fd4a261f0a9633926181541bb5e1cda486" +
+		"8e13901656f48a1a10a4030da3d43b09fb326b43957446d3bbaba041045f0c0a77153a3518235c4a388adc6526366e8d" +
+		"ebf15027bb737becba8a688f6b179b9541be3c5de29b43bf92d71998ffb21eb4a0b1a7b0bcb3aa2304407f9f3158ab9f" +
+		"52bd99fdab91c3fe2f8173b8b5e7bde6b017d2c933f541e4bcb4fb6e742e9babb1ea8b794405ee512d70fffb2d754ff6" +
+		"fb289308f51ed525c34e6c9cdda9f6fdc7e7c01c15200b5b04594f3878aca5d1040b60cebe1e44ffe59f2418a48b1f68" +
+		"8595d3ffa3840a32f03cac6792543be5d68f09a5b7350e4c4604a18a0cf1b24f036f14d1c38661a8904190a6039c4569" +
+		"0907c40c9e24d03976dfcc737e8f507cdcc93e85a3c0e81af3fafbb66d51a7e20922c4150f99cd7b129ac6ad2de319f5" +
+		"6c6b6855e373c65b9afe6286db8e2439dc660b68355678390e6db3361f57297ae890da0bad7ae6d81483b536628bc59e" +
+		"b852c41c8979a37108ffa0808863fc47e6f5e48ac185fada82fa2b3a6e20e1ab3bb6a100c08a3bf61700362db4b5420a" +
+		"4ec687c9f4123f35c5c0610f9fee0b8c8d8593ee486567ecdaa3a47b419abf7403c73d6c02c26184b0343079082bdc81" +
+		"a150700eb72870c85337aa9986f12a5b1d9d5cded64214dde41e905a23b9dad9ec6a6a1813c008a09907e82c01c50f60" +
+		"5ef8071b0eddaecb969407449c6b9b8321c032c413fc987936fdf45cb6992ab21b5d22cd12490d40ecfcd0d0d163bdbd" +
+		"dbf469b92d4ceafb678f0abec41c22628d6b65a76b3be2cade34c522c823eef1f570a47b4a919da5d228d0007f2f8db4" +
+		"57d728461d537ac7dcbdba67dfc810380302a88d2ebf6f47340ce32318ca98f74e4ca324a39fefd903c2115253347f61" +
+		"81ab74991da0b137035b959660738d9d70c2985e22a79d10c839f20579c3c50edbebfeb506bcff5931fe992ab18b2103" +
+		"6815fe2cf5a41f99ea8144d424fb6b422228da1a57cf3a8e86e8bb3615c9448fe536704218eb2847c1822590e9243647" +
+		"2a7a197720dea8c6a3bbc4b27dba4a13f88099c6be941f83049c91bd338b3c95c9759922f5d3be22c62641e0bb96e8ac" +
+		"18ff6b33e13bd4e0dde5388547e11d607a1ee112c70ef40b7afaa5dd5e2147861bb24b2e495a6f8b23544fa07a6da3d5" +
+		"498cf983823eb654c9d0c22bcbae9d76b566e66ced76f7198de13b1c1984d28bb34b2e02b29d350a992fc3910ff93b83" +
+		"7e380c99eb7dd4797891fec8b204643571981945ddd3e1cf1864e17956c83dd912d4274ed8f5f1eb7210a84c2da4632e" +
+		"b19276ac843579ab6c1f8f7b08808f0bd9b4a9f5cfbe7825c87d654fc61eac1ecc6cc0b7580aced50e867a4baa589c04" +
+		"22fe2097d6df00da2fdecebd2d57cd171b420f790d4f0c879363749618e19c667de246072224f736dece7ce6922fd3c6" +
+		"ce6336955b26531e8c2f0fc3b792a3b964f4b1524e308dc766fc2bfbc5ac25ec08fa3be32714660cd461a5a09dbd5da4" +
+		"f50203b3fa53c27796eac261e1ad7fc65c543d5258880f0b3209edc71c521990566d6dfa7f2d3a9b928c935b5a09e96a" +
+		"563ff7585f8936805306e247306b9c95bf612af18efb2796fb5cd92e72d57450dff4b41f1f9475448f6da3396b249fc2" +
+		"298ab775b64496bc8769fa9a5027169b44e413549bae71dd4f35cc563f675e5ad575ef76b7e79183c08216deb8d2d4d6" +
+		"c527afae3761b442a76e418df9381e4b267e77a593668ee51a763b34ad208799224174f95af1b8eab4a738cb80276550" +
+		"ae9b393024c5cf4981b7604a7c71436dff58c65061fbd0338b89c7eca380ef9efb0532c5162764b99b98c9a9afaad6bd" +
+		"d130f7b6c2cad9b6f2ffbd4ab1d4f84c3bf1e0e18523d048e66d8298fb9fd9ded59ea4098b0bd16c81983316df08c2a3" +
+		"643320db469e315c1cbba1a71e582b4cfae41406a8db1513b3a7d8995f2868707eae6f47683ded608a8200363e6413c1" +
+		"17280f4ba5590d4f25bb39c65fac9be071aeded3ee48adc4a6fa692341077633b9d99efd5d9734e3d1f0d7f28606f7c7" +
+		"e3eec7998fcbbf17988693a2a953190d8083cf78b1772ecaaf3fffa8d57dee9aadfa8c5eda4739476d6c64c928c30a0f" +
+		"959f76184206bb449de1f9118f09ac6cc156c8bdeb91e790f3882fe403b4065951418cc4ecefd35c75a478d0b2b67d39" +
+		"14d7ed3fe9730aaf29a22180c0311d5c5208e76f9d654d76aa59ed71ebf13e3f264e88bb6aa245a5bdde7d0091f33a76" +
+		"a8ba09d9aaacc04eaed0b439829771d2dc309a24ecfba029a8261bdd9ecdeda67ab78e74da76e990dfa167315720285a" +
+		"0d89ad2e98c5c8718a4d9c979ed4ec7c6df97e4efc6d1649ed7025f07b764ef6e3415d1ffd1a548aff7cd7ba3c13d7f9" +
+		"67c098dbf8b6df9261cb8b65384d5d856f95636788b9b618dfb6a33c4ca6eee93197b59165e1034fbf6a90a15cc10efa" +
+		"bf85dee971f2d16baa5a9a955afc3690d74aa61a3df76ffb7b3956b12688aaf0fb59121c0498f8f1bdb9cf74aa274af7" +
+		"fb82a164d3e965c1f520f76631012702f8d5f67d8f1b954401e935fd4b8905716521e8b9426e479f21fe6acbf717be60" +
+		"90105babea1744cb3082f1c1a34aed4429e393a57f97dae026f78ebf28f996175b136b2cb2dbea361cb2db42bad0742c" +
+		"b49f19658f38f5d8b426644c5f82d64337810c40f6e45aa090f8091b29f7004297e6d85d1b455a9d178f8e7da132bf28" +
+		"2a0736446660a99306e398ccd34ee47fa4fb27ce67fe135a74a4ea67245ce55a7a09d5014f87065ddf37b98e94b22f0f" +
+		"42b28a7bfd0b55def28aa58a253312d522fe931a75eafbb619135fb90f4396366e28f1ef977af337fa0ae5b999b558c6" +
+		"fa680cffa6cd63ec09fc79b98c0a5420166bdc6234641822f80e254da4db901f3e3f2f9a133d6b8780ab2ab6d3534915" +
+		"e0ff1425c4a123779f26fc52853afc144cd0c6effa1f5ad0caf719741b67509ad2b1c691e19922725a73e63b764fa0b6" +
+		"1907a1c00e5c2091f437673032dfa5e5539c204b554c7e4cd18620ba3ea27dcf03f816d64b057a0d38d26e96337d575b" +
+		"72a13a60dbcc612e5563a51b86798fd9433cb9697324cd23dea6beb15cee549c0abf050a1c6e26aabf32c77263187d4d" +
+		"23a6b2a1426eacc3d7ace60c9c3aca64b507a7a9bd57ee0fdda99fb56450baa1aa31724f6a4386f131685202a5eae489" +
+		"c8d35114dff3f6b27a6a98e9026ba9ff55fcffd4a5cad77adfab21d94dce716b69c7426d8272e0b6daa981173f37bdeb" +
+		"48030839bce0e23c831c81e2ff4e53def70b9d104c08fe6a903108a3ca727ce2baaaa55b7b3acd9eb77f3e4ca2405fe9" +
+		"9e8d4e08b8f12385e5ddc0fa68e35bbdcfd6cee4c2c0145d41402dffafa2ae16bc385b16f10dcaa133ece786cf5f71c3" +
+		"f702fe29247893bc1481832976acec0f6b3b0aac9d65d92997834a7f810350bfc4cc0a969eb35449719d2a58f702bd32" +
+		"855e05c97a69e5dd65e590ec1bb67231df175b618b2e5ccaf5604b778c88ce43197546bdfee2ccfad4031a469c0f0b57" +
+		"cc2b94bf879b218d8dd7962ac58f97abaaaa53ed7a56827dda778c9ca82a8cc6eaab30879d1f789175ac63752a2c5b0f" +
+		"85159698ef4d5c43d5eee97ba783788effc8639e570a01a2698545bac8bcf131c1d4f4b0e13b6c1ab0a3477572934444" +
+		"5ab84034801693848f14f41380628033330eb6f43376d5283744a1aeb1103657d37a4882e82f63751ea2ee0fdccca1dc" +
+		"1cacc8fb2910dcb5b728e2dc1e1dfdea701a51bc2cc0ef281c2042112d374d41d8d1704bb339cf2c210857e0f0ebe69b" +
+		"ee75a96b2c1086d0705c77a281d0c58a0488b01e11490255ae5f5106b29990f7d8976a9564cd1189bfbb43f73fd88314" +
+		"410d3caa2edb68ba6e2e36771cf4f0b1b4b58885568fd1618b22c0baa2d62f1f599c83a643273154430edc81702b5851" +
+		"1b9724faaf30be057cabc9e3e2140da9ac08937916b6869aba88be72681c9b1f476d68a0ddcce4b6c1987728e0f68f02" +
+		"a537745dd871b0561ed8e537c878fafc63128fc3f993f0368331158654de02f74c0be878709dcb7432405d3fe309d944" +
+		"1ec9eaee7e8324ee8f0f77e9f2ebdb2134c1f807c2231a8f0036541a2d411b656324087c8d1939e7b096645643225e0e" +
+		"9e85a703610adba1762bc9d2eccfcaea66a1bdd465ef6789d0d9e3e7cff683f82b845181e4afb824424239c6232a805b" +
+		"c4c7f5b9c85edfb77d889959a2b16cabcf12d3cca3956069fb70f0c4b5e18ae52aed0a14d1a0db6b4173831f8c29bea4" +
+		"62bce1a1547fe331fd7b86c3b0d805f7b737b45b7d9570c5a785a1b287da6572e42d4eac48b7b306e0808cb7205c6629" +
+		"f2eb6cac9e6bd0208db26ebf5ea11c3b26353ad89b16ee8c71fb5a640857ef64524a25c00b44bc7543c57e64ed6ff8e8" +
+		"782893d0378569a9e4f53e6043a735881961805adb1fa2a5d98806eb7d75707a3e37eee8e3eeea6894c5fc134b578ac3" +
+		"fe0cffedac14578b9afbf90e8b42bac2f58df07605d23f12d115f36204a5888b1584bb612e6cd70d49c74d6112686cfb" +
+		"511e4c607092d7110d45a64a2d1ad124ab89cd3003703496bb713aa2ccd50eab576424c9c9f1213c09d218c0ed58efd4" +
+		"54fa1a15dc793b8551a6878035e9289007e7d158ac4144505351c6d4947b183401b38767fd545aed507523733da42a4b" +
+		"4c56e4760f749ce38f4e85ca86082a3279a5282205a1b9dae3929eaa14e04ec38e9e9d0a4c4594bf59b19379d30d3d3e" +
+		"9be8574dbefa04de31f8413e9391dc36e53e21951a8405c80b55e00ef1ac1a445d31b656dba67aa396d4ac2ed49285b1" +
+		"f8de8de9ba0f62dd56681f0a3e03a1d43fcabbffcb3e843362997cc53548832711de518784d801d1f28dc2572c9e7dcf" +
+		"9b78762db7bcb315824e833692e701728dd127dc02f54d68efe2ea5b149d565238531c71c613123b504b0395a613d7df" +
+		"f120a940f605741bd3934c31a3d71c6979ddc91e644b6b1129893c6485bb573ac3d9644f869dc345326c8dd9eacf9965" +
+		"c766c26ab2a8b9706d9f8129d7ee879a1b2f3027bd780ecde57c8f58019169fb1c1cd3f4759c333ea2c4710f417600ac" +
+		"16ddb195e4d320f20b850e56ca5c41694dee1b472d1f8006519553beec680af6a0541ef84eb17aab3125229dbf235ffa" +
+		"fd6c12e23d039df13dbcf01cf18e145181f93d27cbe1108a4f1125b0ff8fba79265cd1de4f4d6741e31aec6fbb280b32" +
+		"3f15767e27b9c45b64ecbdf6bbed3477dac98902ac32eb34fe673173b5696b97b51991f5d62e4c43261898eed522ae09" +
+		"1da3d15e7732b72c9996a5c4ed0039bfebef1b84ee1330dd2a4fce12dc84a62e1aeed4ffa90747a51650b338fa4ed993" +
+		"e1aa3554b40f21f819db3b36c148bf79a5e4ade736a7b6cc63e51c615de8e2c90f4e7acc1a3d97a3845e59b276f0b663" +
+		"fcfc578cedc12719209b7bb24951d16e78affac5ece38316679850a0f12d74c292a6fbb90eb8441af55dbfc446732278" +
+		"43f0822719c379a5fa0f86916799ed21a0863bbd6a062d009b50b21103592819e9ff586e2cb7b8b948727256e09f11c4" +
+		"8bc669232f185d3bf5737e90827167f1a9b1a514871eb8cd56609c1379084e03380aa2b4f00986c35b18c7b026dd698e" +
+		"fcf8dd94244a3f6d548ca9664bf7228e77c3b7e8bc3f07df13e02056ae2e1dbfd8d2393f7aa5653f0f75f169b1a56d2a" +
+		"d15c525de682ac20b4ac61d1615a926fbfada814d710908598d89f63964f224d7e4f22f4bf55cccfdbc9c4e0f9475140" +
+		"3c7452e44e49287ac72cac9d3f2cfab209d3e1f473ded9eb7ba731dedd0d16507aa29066de5a9bb62a49defdc5f79f18" +
+		"878917bb3ea44aaf12502ddaf55d83b2247f2c6d9f486b22dadea41b9c6c94f0f9f95e559b21f7335c0e60f21e0ef501" +
+		"45e1cbd238193ff304abfe841007b79ee3f28329a46ffecba30dfeec7b7e29e49e979bfc2bc040937b162b4290fbfff0" +
+		"cf5ec519209ce685bdeca89e07efa87c28488f0bdbcde4c4e3c1c1b68fa8386e756ed547db478c0a32e7bdc4d3350252" +
+		"79a8d64030122c0772f0148b50e529672cd3ef780b5f3cfcac85972484fb26bce52d36017e745bb196c70f273875d3ea" +
+		"f8b1a1b0f1e405c99908f706706f942769e275347f98f2e72cfec7312f606cb99cf5e20b7488fffa5ece12669fab64b7" +
+		"cc7aa9260d92fc8807763c40049d0bbe2694ac2e4a5ec76373e4a4f8a53daf3421c9fd53850c4a06b0c288c431d18ca0" +
+		"b11bf19f276b59c37f289ffbc8281eff8963e5bc1e4b70684cb6e4f2e8f3fa8a"
+	sfmt132049_jump = "" +
+		"192430bb8093e471733dffe8c67ebcfd10f4100ff81c55c9894d25acc69cf1ab425b6bc9d9c5fb2b78e797f616d54994" +
+		"07cc6a02a9d4a49fb2cc814bb4bab62ff617f3eb3405d523370bbe85601cbac4285f7a3ca65f1eb477752536666f776c" +
+		"4940a97633043ef9171e73740c9a6b4f5d1684e53c318b7ede9ea14cdd355919d7480f22994bbdc528077ff8dbd8d055" +
+		"802f48f0fd41c077e799a135328fd1df71be8e2d14f7083d5193755d288d296591594927542066595e85f98bd5d7fe30" +
+		"658be2bdfb43da9c822102815abd8bd61a28c5335998288572bed68da93d188932be616f37846153f6d039ddbfdc9476" +
+		"098822e7a17d31484da5832e6b0b237c500b613d239c0f1fc7b8776b90d451eb9bbcc11f9a5902902d63abad6e1efa6b" +
+		"4328e70b0b88e7bce0bfd25966278aab08694c82c24005cfc097a0f73eb99436d09c5f9960a85eecf496f3fa35a0359f" +
+		"69edbd69068fdecb368b717756f083484de4149674f80b1fa8bf396f8f616e9d3083f43a0ab71e7e95b2cb6289dd773a" +
+		"74d1da8c689c0ec7cfe969ea317aa113b751e8df1a3ca84d83f180cd2674936093d7038a1336c049c7697459ca847568" +
+		"ecef410794f8622f6456488cba1a06765a16510fc26f25ed688ce8fbf0d878b5fe261a1c33c1703cd45af4f01a415272" +
+		"6b927959bd0e4ab6bb1994b9f1d59e60fecd97b0ade848389c98621d2cb8226b63e6145574d937671d89d29a853e0864" +
+		"a9e1095d7e7dcc0f05e9fd287cc090cdbfabd452242f36015deed6f625ef1e9b55c46e10f866cb00e66b3ec15a70ea30" +
+		"a447f987e14e2ac4752f0a13c17fd460aadf8917b7e5b9b976214fe1e109347d7747976519cf3f03432088900b8da982" +
+		"8e5e6ebc29c145cf27dce883e5186fe2bd62d5238871f2c920e17cd5d21164f4768ab826c76b71a5c7a5e4d08b1df6fe" +
+		"32ebd5017145712cbda05892aa438d50279bafe328c77bf263a38adafd873d30dfcb5bd92821d4c151aa1aaf6b8139fb" +
+		"e7d92ddd410069041461c72fa9b766f813a4f7df92f4b916c08d2dfaf3eab08a10a4c349cd76363d706ed5213e89fd75" +
+		"7b626eb08b265c9869279d4fc11e0930b0ff42dbff09d086d1c3a361b8ecbf0afb0436a206464c2ba1192df37212d94e" +
+		"06e121afa13c7fa00acdb31a742be483202fea2582ea0991a3fc3e67bfaf6cbf6e0eb111314b68c990b68a987d163c49" +
+		"af136000c87145f13355a812b82becae4893917e5a48c92afd09e509f6318659ca7fbd040749a55001e74fb7e45290d6" +
+		"2995a38ef2644d085576da027d473c73f5042d7b2d959a9cb1680ee06f0f93e643af75819ff67186db70147928775641" +
+		"c875a0558b73a046d2e0ac98433334cb49cf2c0e19677d5bb64e1a15c0b5d71c07bd20f02be52c60da0de9be5067d801" +
+		"6c95731ed7d4cf4000ae8452eb05eb66830b564116a7a05c07e8237491f4e86d3e35544aaf54d3b3ae96d5a5b1f9a4eb" +
+		"41b269f89a798d3b5aeff0a046ccccf81e58ea64a93a614f977d445a9cfcc4173aa478d15453210394e2dda299256751" +
+		"abd71b47d09d25e196105d7861ce8a0140d52c3a538db44ed62bbe5d0e17d58eb8ef261c9e4cd971d755a10a87aeb7be" +
+		"2497f3249b656115804d46af745ba68711e6322771a9050ebbe85fedd76c3e8bbd09196eb8b5a39452acd873c5cc5eab" +
+		"66eac592acd1e715e29c386254fa337b748746f86fe6bf28a2b10a37662003bf45efcdba3b2331d78195c47df32e5375" +
+		"ae9210ca8b1c4af2866d1a406df34f00da1070c3df2a2c51a7e8f1ff72fa394d64e74e6bafe8995ade2f32de14962ad8" +
+		"b1e5c57e328aa3eab19fde8236f544a6591b480c854efd7f8c3fa88fc665af262597f6a02d1cd80c967d4fcda67ab376" +
+		"d6eff99a8f5ce3bf2d5691b2975fe89ab025be68d71bb51ad2ad21d1e5a2d18a66ee70d949399111b40b11b07064944d" +
+		"7ad5cdae741a2522e94f2979366526a9eda9dbdc74041d471eb1bf23d20689451ae32409c084a52ff202ac7f58c67d4f" +
+		"c1357034268415160442e12185be48878f1c8861ffa797aeb16fc324892539ffe2b96d612af62baabd445457ce435bbc" +
+		"17cbd3e35d2d7993f2d701c01c63aa44ccbc4468bee8491ece0f5a03391be5d17748e532810711a2af0fa27e9ecd1502" +
+		"669d821d3aaa4404c83151f26ee57c2e97f89e0e1278d58b7a4711f577c646ff625e167f134686ce2ce3cf97a1d13d02" +
+		"f44e194e8462fcfb0ed91d2e55de6615489c4c481853640e7706a4c21f5e1e288bf12d6e064fcaf47f4f91d9d9e28f7c" +
+		"0f828d2ea0bf221bf78a3fcd23adc5ec55a659398cf50ceeddcd746174bd4e8ab0b8f5d0f7371239c5ac8d256aae7fa3" +
+		"41fc95dff61ac2ae91a78c4e3a17959934cf82d2e03d31ae93ca180d609100586c74a81f7219dc8773f9b2027e46269d" +
+		"bd5efadafb4ce6617b2f923b7d7f82c25325f774c213568d0835453414348325211b1d44e9e0d4f3ffadf2e1b5ff09a2" +
+		"de857c95e1b4efc5d9b58d3f04adb3fb22a994dda33f6891b170676d635454d3d46fd824ad197fc4552cfaa60225fbab" +
+		"066989850cb1abc7b21a8a2d1336562315f10468d8bc883b6c52845beb1cd70303fb4bcbc1ad4145eaca3aec9f63cab7" +
+		"a026fdc8c9aa8a96015056c4c7c6ba2a5f6fb2f1d617e28018a83a84a460f8760a25a408bc6a9d0d31a9469038da6d3a" +
+		"9622274eaeebdd78c9fe0f5b735b147c1810c92438aaeb29b90d396c01ee58a9dbd4804235183016f796a9b10a15bbcf" +
+		"5f2047498119af8407c81bf9663c7a74e873f79e70952cd70a96d1d9e871427b280956ad1a164bfd88e3e0dc90a49259" +
+		"cd50a56f43487ee5b75c098373056b6d85bac6a13af8226ff24573746210a9592ea546c8cd8d48d36f34b3da16509e27" +
+		"a7f7bdd56d2cb2f1079da88df7afd787cb6ab75fa59ca09180c648e682e0ea65c5931e40528afec546bf55d43f38e985" +
+		"a5e0811f7b3c4783779b7c5ac12cbdb3e3f316244af5c67888753d054b151872d1a39b1f7dd5e63e4388a351c5d948c3" +
+		"96df0327dd7d7f452972806c08298e3b7f0ef17789b0a3ec1a0f95848fc53381d3edeb75963c67e41c0613ffd6fb5b60" +
+		"b38aaf3ea51f0f2019be1edc6c86360dae38b1c1b83e1406aad82a76dd84047a8ace200b9f6e22d3c10a365593ba344a" +
+		"690bd4d19862f54369d6551b08d6018559072154047cb12a41c1070503f1b3ae805c69edc13d1889d3d5f84b92b1e6a2" +
+		"37883e4d5fe757860905985912e24e7d5b002307f7e3bdbe08e81b84e66b9cf6807b7c4667d7de1617ee04e889126fa7" +
+		"ef49cdcb5d8176783bf10a3fd1dd56d5c20ac4da17b930e89b3acebc7f36d9abdba62c82b363bbefa2040c2ade0c8053" +
+		"5f6faa643bdd52ff649dacf2d85d27fb4322220f3065bc6d282e90f97164dac95c13037642a8f4ad8a6e1a1ebfb91b52" +
+		"8f26332ab68feae1deed9727bbbf3f7c9a3667b3387ac523dccbeb5f43d98b67864f0b8e71e2cee778387eea809f831b" +
+		"06d00bb827013c295eb5b3c0d86281c9f3c29e492f06b05a4dc001380746a634b48da6deeb45b619572b6a7e887ae71e" +
+		"2765b6c397607a82b03a5980b8faa81f71a6f5b1fa08fe73fab485b1b114c46d72fc00398bb2eee07abf05fdec47234a" +
+		"d5732a964ff2b4e326c9b6a948fa551f4afe66b455e309817550b88d13efb1133859c9f202484a2c81eae1f78dd78bd1" +
+		"f61903666c346a4386eb0ce18d53f2c694dd18d847cab2b02c537b4c84441140fbf2f8995a24b0344b919607b7b1a962" +
+		"da5197998deea2325933b7b016278f4c25a25675761a74cae824d22427150d4ac62f9413959f594b5ffc85e629a64a90" +
+		"e1eebb8c14d16a19fcccadff2c3a0ed60f8b907ce5197ed6ed0ff06d2a65aac95fb3233b0d0f7ecb236d7666e36d2cf3" +
+		"439d84793b766f65f386eb72c6d75f744242f33a561d8405697939c81a0f6202b535f1f07336598bb2bbc31b598e22c9" +
+		"91cd3fbd89cf59d70e736126b37c2e158f56264be6631baf876b368e1fcade68b1bcb82a7b8565b19091650c59bdfe18" +
+		"87fa125dfc8f44b9bee2fb0d33cc2a2f14070e7e1560b486b07e509f07c946daad5225195e2c02448aabc4240bb96625" +
+		"9ba3f0164f2db951065ce6468fa64311235307a85b4c159ed22afcd5e4ad54937290bfd2306d031b5b634b0a41327cc2" +
+		"bf73cdfe97a504a1ee2009c5efa587f2aa1abe652a715cf55cc8f0913709fb7337b51f1c32e55ffbc443fa2b13982bcd" +
+		"1de7bf95641d02e268c14d425a29ce5cc6d2ef55bc4fc4988bfa88d8df44bd492d4187966e9245f0e4782e8291830333" +
+		"441a3423775c1d7ec1c7e666072dfd001b5e206fc6fe311955432b007d157ce79431134ca389bed5c3f95d1cc27506c5" +
+		"9e0aa75a6cc81ec8a3d923f02efadc041841a790689e12eb4671eee5bea043f5a05d351abc3b22ec62a6e400676bdf40" +
+		"c218382753068bdffc2991ea441e9e4a494915c23e9450910fca42217910bc9d50d1f87bf7ebd7e55b4b1945c0cd35db" +
+		"98035c60eebb6ff96e544544f452b8521d9fa40b29f51d3f6a3444ad733542c20c547c6b4f77282c394d3d314328c07a" +
+		"9d10a4784ae6e1746ee47c0a4ee86df278446d1a9d098f785a1ce65d7276bbbc28abe6c8b6ccddc425e056f35658efa9" +
+		"344d06fa14a11386d4dbd4e5740d9da2c78fac234794ca858acf83643309cad780f0f86addb21a983ebda0c933e9bae8" +
+		"8a14b32d63388469a285fa1b9700d93d142607d41a3f45c5fb2f3c314e33fa4af28c902c01d0d9437d4eb96d3b543a1c" +
+		"608e05c144f085b85418bce60000f4eb6bd069458dfff2b74edb2395871dbc6c79b850051cc53a60ff1fc59fea284d57" +
+		"5b74e19fff0ad13cd5b884b4267cff4ec11b7dddb9fd6e991858043be9d925c96940a4906056c15a28e774ec646e45f5" +
+		"a66c571e13b1194224518fcbbb9cd9c51ef2a6f1f54f9f49cd2bffe4735e147ed7cf15379b566079b8e53133c2af8d4c" +
+		"cabd1ff49862c6771b53902cd298d2039b1ac3413b64fbae89eb343bec9f998a6e2debe3650ec013cf34279515132984" +
+		"89e1d3839ab0d786d542cf3500fcb095002cee4d7e67edf4c3acb78077505e20eb0de2750522b902a664b1160ee95e3d" +
+		"9c1a34f5fe528f03d56942dae06a4b222144d15995cd21ffafd41b4471cae53fa8aa20c5c7fbaad0ee77e95bdff1d868" +
+		"395b94f2095658c98cabe9886cfa932a5f35f4bbfef54a9003753a4f8a049f9702790fc68aae403fe3ae6f84501f9d1d" +
+		"84be4b16e0c4364657de83db82ae51208e069a5f118bee83367cda92d6c0de3805000b98086d976263d8dd1e1deb723d" +
+		"64d7a919e717b8e3e416fe875b0fc6c9a99c2cf1f2a4285fc92ea95299d76a497a1ee2eaad1814948450f2b154c95baa" +
+		"9224142c90b2bc5dedb443c13c88c78637b6d06f111b945e4ecf2889ba72c03b6acfb52e4d0c6b6a3b7ba2592dec8f26" +
+		"7417ade0cc548cdc40ea219690caa6ae893e23b45cb903a9febf0c82b3541f1a775ded3fca898d2f8c183e55662e6d62" +
+		"625bfb2388418c4cfbfe7aefef9731565e0bd89715220345dd7adb9e2b9b93689771da3197cb80935d1c3a82fa42a108" +
+		"1b3e56821c7838e1e78f0ec9be8edbc59697f9d7cc75a4bcdd2568bd06df466e5d4202fa1e98b359d3bbb350c3a5170f" +
+		"ead6a9cf79de77490ad4ee0ad41740535410379a4c1ddb4ef1c0d6cfd08d6d5044aad9eb1c9cffce7d0b41355084e7d6" +
+		"55b0b12390eddae6ce217b41cf9e34149d8162dbbc6dc7f27e5c36577a9bf9e7b8c22f2b2e4ffeec14c07cf22b0982c9" +
+		"8e75b09a2698194cf074c335f829d4252b1012e2d6af89473299a4938d6b14dfac196a0506bfe2a8f36980a4c74a872a" +
+		"b0cee6ee629b62ab711a42ba798d6322e1d91e5e3d9667ff505248e5dbc35fd38fbee46b2f199afea30f4cb28e1bcec3" +
+		"68c6e2af0e13ff5264e77469e90d9e7ad09b30b9e69b449a5d0dd4c47382fa41e64f31a8cb17bbe8e126de57fb6f1bb4" +
+		"03479e9cf6412a5861d2050aeb2cecc931077433edae2c2bef6318b8002b374c3abf87091e8d0929680023eebef48ad1" +
+		"a6dcc328ffcfc62580ec31889071b93b4d8932efd5e5eb5964ea83114faa7fc758e39159dedec9066864d3de81a04825" +
+		"3e8d12fd2f1b5a6bc5870d6da778f4226e5adfd7d84d68e1c3bbde7b14ce1872cabcfa326c0e485c5c690690005ee280" +
+		"dab7c1907ba027d6cc12f8c2176e446a77dafdd12f69d13423c0590c957fa38852551d3bf402aa02a5a8530371885507" +
+		"1b14466d3e04d4697f75934e8ae3641a38d776b54bd9cd80a0cda72e7991ec540440cdc702a9c4fd0b2ae17b757b9f3d" +
+		"16606403898c5864feaaaea939f5b42c8ae7d911a0ce3001625a157695d2b7aa74d03d18afe0c6a3e04a821a99437686" +
+		"aaff9710e367492bdc3f8ca37e5c4dfb092a18eb7b4e82e78078ae6cfdadae2a3208cd5abaa9e3f1d180f04e1879f96c" +
+		"696b773b0c0c20c58c92a946e675cae801bf42547761166dcfad6cc96fbd44ffbf4175ff0de1d29b788576519e61290a" +
+		"2f2c9806ca90a467911e9bc9884ff4061a4b079e24d1746b51989f25fa2454b0153c70226f6ab5ba6c5f3bf7126edec7" +
+		"9e9523c6a83f3deb15f95ad295d70244cfc4d45d815cde6d94b9550d81ef7e1e10b50916c21e11d29969d7ed4c979523" +
+		"22f037977bdb95647d0bd1224f64fd4b27416c3a19b9054948643548fecd5720b8fcc354c0bff2d11d2ba35eae49003e" +
+		"7b1c65b43f2dd26dc7b9d7d03b080f6f7bf57af14771273840a7e15ad0bd27eabef0bd99b0266c9ab40981f7e77a116e" +
+		"65b76845b09c05b1bde09c64ed2c4f5fc1dd74ca8e7c0ea30c931f5edef6232d0fb76f6e1648280a97b1bb19dc942096" +
+		"1e587fbc694f1760b7364208a9d3d96083668f3ec2bf28c007eb8184921f631454708f4f3b7516ea5eaecf66def0aaa2" +
+		"790ea348de929ec49ca8407f99ac11fcda2706ce518565a223b0313fd2a045a688c39848cbaabc2d8e3f0e9a8495ae2e" +
+		"f1c238d36d2003d77c5f07c4bc15972e8477808d3be57ea33a07d1941690a0d00fd332c922c423ef487116694e71ea8e" +
+		"2bc6656de055d584760dc2f26c7f5c5409a59cf22044729ad51bd3c04e64a735d07707c1e2d8dceb876bd2d7e147a335" +
+		"8625606c59d6c4a4426eec7fbc719f28404b41208031f8c33c100471ec15e969159862e3287b10437161b68aa0d44974" +
+		"fb946a0cf50a0e6729d363c30585ce99de7b687c061d88a9fe91fd761bae2a33a20debab74b002d590a3b39f568b6c49" +
+		"b44cff2eac3fd8a323360832cb985755f58538b4197b7ee2f1647b842714b219af61619902e9224b0983f9ac7b15b916" +
+		"3201b6925368613ef98b9e23d3874a4b362ccc051f37fa959d6f2747e6d9ce8b17a12e778ab8cc97fae7c4b220b5018c" +
+		"13cf24328510cd6752aef5a7545a40ee570132e2dde65148a91bfd095b6d90cbcbdb1666743faf3584d2a30808a16212" +
+		"61962af2a5dd076d2da2c4e3f371042a7e1c4641e9777f0b1ce10b8721c8bb1ac8e35a44f462d114e0a95efe6ee12d53" +
+		"ca49b2660a4623bcc5a07ccd7253764fababde48cffa727e288b993771a153b7d55a9577c97c2d655e7aa794e38ac62c" +
+		"9218ff2c1e1816c9b95097001f4779bcbb4fe52c35e01f0c3ef98157ff6902b47801f0d708ac98be1f003f335e8a72c1" +
+		"e11788cce2c5559b7c8fbdae1cc9f4e8b7ce2e0e7da0cc7bbc63227de3cd88a7e8959db6661444d68609a10083103dc6" +
+		"621e9f60ef0067c1959982de0723224a6bfe2f1a41abee7aac12cfd1950e50c034dc3eb88bc5d2e6e6a6d0eb991e11d9" +
+		"bce47f77209c28dc29d5e520dfb6c2f68bd6b722d73ce524016a2f45a46cce634b895e2135ab837c55a29192c4dc84be" +
+		"f652ef5962dc39346cdbcb4b68f2eab60efc0f34c37dd99971f43e62a0b8961defd3c85db482b24ec3f3a9765522266c" +
+		"7d35f6cbb1e7e5e8e56e2a398c54d7f6055ef568291057a5c65aa30295ffb02a4404ee1e18392e1f18acf962a99af12e" +
+		"9180a5a85b75904a8398262e92b19e51da910d325e87b075fafe11bba41c3885b5f1be511d126324e33e39ed4b6d448c" +
+		"d9abfcea11495226f114e4ed1419d157f4c8c70c6c911ed879ee30dc54f69101f8ac1736a82332b356ac83c2708df892" +
+		"31db68d472459b309000397591d26f675111632a3d4a47f60dccd3e8f83d6615549afc75de24aa21544bac54c301c278" +
+		"72d771a9cadd38c555908940eb5d6a64a6abd2ff00500b25225e1f574d6fabb73cf756b97bac44bed0c33727d2494945" +
+		"31591c1b58ff8d7975f83d091940eb82d4cb0f9c03d05e8be4da9853cc82a8d8d4b341d3c3a7e93afbfa28cd219305d1" +
+		"7f4850641e90b5f119b994d8121c94ece14f9280349f474092fe6fa156a06ddcf2491e5e0a55cfe3bfeca8bc01f5744c" +
+		"a0f41ba12941e547b63c82ba6fc840afdc1054749af8be3512bbca7daaf8a30a6bd697bb7ee550a7fd63b6d081ffa566" +
+		"bc1597d56458bb4016d9cc2654ea7e8759a363548e0e27df2291542b56580dfe1b9f3fedde137ef16f6efa1d1ca157ac" +
+		"40ef31104ba76b31d1c44dbc579c660d95fc5f7720b91da499035c10c5d37f80d4284afd3f7f8148094dcdc68e7b2700" +
+		"48b9c0f6c02f26ddce6aa4d3290c724be3c670b724de0edf60370d24b8357b5fd424370fe29019c0facc1e44b6f73685" +
+		"f8a21f68b32fd45af09deb53bc6541909f61d1624203d082c049baf408644df4b100e2cc7d51c905a35419db9efd45f0" +
+		"549fb90d204b792bf456d4c82704acb40c46dcfb1dcba965e0c6c71f4e0a47624a7089b32ff734b3173c4a6035735fe0" +
+		"812c48271339b5f319756be36e5c0858e2d920ff5c9fbd0ba04198be7e6d62c20dac84dc65843ec45cc3e70025364d98" +
+		"1fc2db25f784643aae8cd7c39fd6f6a4367983da81c2ebad7fc46f96067328e033715aa7d5d390dfe3b3051f75ff77c7" +
+		"2e5ca355bdd6cc494449d110ff02cea53606a3450dc71b07809d641a40a28f9d43b88ad73ee4e97fcca84e973f5d5317" +
+		"518ea9d5a59d89b1bacce4dc8b486087c6857ada42d01e56b62ec6213e9d497ab49105d3df31911ca6926c5404fa04b0" +
+		"6e1ecf5ea793bc05d82680cae9855eb85bf3b42d7c223901fffb18c9c8f67505ccb1808ddc2c2e2695a1517c43bad316" +
+		"c543f3bf516fe5c9eb47622bcf37b9b74d0e5cf31dfe8bf19ad056a62e46ea8185ff8b38c4bbd1d344ebeb2ce4450ef1" +
+		"3836f6ee31ad5db1b0b3d5dac0d6ed9f156cc1c014c0bc20ae332f2172b4d7f827dd5ea690079d0fc84ec6567014fce1" +
+		"3719205913a7d340f224df1175a6ad8f507e1dcbb4a607d60a84eb8e6dea60525688574f8b4e00d3e90c3e693b4a7aec" +
+		"b7203fdf52b410e9e0167e688c7edbd29f481aa4c9292ea54e1d6ca145b93fb29789128f9c5d8ca5cdc748810193b88b" +
+		"f714f66ccd1f8acba095ccb7c14c1ad3e223063bfd418316515441c3fa608968a2c244f4fc0a269c783f1a6c29db2b4f" +
+		"d05037c56fb5509acfc8a1806bdfbba90e48e13d764dbc8c6b7d70a6b84f4cf462d842bce18088b3c9bd11c260cbe1a5" +
+		"0edb97ae38450d441d47a823ca845b0de884ed0cc1f1c011567f9fa88c3d4e6b9c1c06183434949865e9d6cba856149e" +
+		"b53debd4abac75eb5eebf61cbedec5943c2ed34b32f1d80d171c715d1f16a7b86cdf8e68f8a5865482d6db8547314808" +
+		"3d0df9c71868032789eba5d5d09f7485c9a8818a74eeb77f2af48e1e2f1ab56b31efc2c0cb8ad82d31ebaace4b198f30" +
+		"14b34adba09a2daf18d56a20ea1c0a439bc469f48256997c6ed79ea28dcea4be7ad2620e0ab42a208429c7f9cc507045" +
+		"57ce6f312bcc9e6e69c65ddda275cd385c3eecf7ac79d014216f5a71e5b67d4bc43bb23a8eb8f5c06003e18e23cf9bbf" +
+		"ae8943bbf5de2955dfb3c53706d1895446aea2ba7792d8484ccc793ecb52fbe9727bed4b2900f5714743bff42f8a62f0" +
+		"4295dc9eb9390924e5d00162eba4bb726a2abc7a09bcc54b13526c75e0cfdef31e217d3bc3fc91bb8113348f18c7043a" +
+		"380a128844ee3927276687090a760af3132ab4d970f7f61d9d44d51e72f82eb6155b91b18187140b28415950cf965366" +
+		"6c1e8160d9d565f4a77fbd52b2c97a0c133b6c4bfbe83379806dd4f71dc57a523499d7e987207412e18bf8b1ed6f3418" +
+		"67373711ebef262418320a0dd53fec8989b14a51d0e261c7af4374424db6f7203a271e66ceff6afcc459f153f888cf1a" +
+		"60720f3a02c874bb39117e953159f94982c936d6984a0ae7c941340b442b2527e67e756bd471a93802815bdf070ce8b6" +
+		"d56a465371a6df49e545e1b36e774a6e275c752fff3bf5f9254a553c5ad9500972c157024fdef0480b7134f9a324f827" +
+		"b48bd3eed13cc1e5685b268d1c9addbaa8ff32ea53e3a54cc23bc5ab210c7ab6f63a02b3e27dd14f48553890892fe52b" +
+		"053390fb4a0da37a4dd6f82403a7752ffd37e9ec61ab3855f96c547f69ed2311d19f3a09a199bc3a076b36a95ae19533" +
+		"9ed2e9242fa655f5d85e374d0a729ac843948b4d890bdc2c268eb3db4edf661443ecdf142f9ae26d5e4ba502cb0ad74f" +
+		"f0f4d384084d797c6a811c6fe4900a59bc5f422fd2593e4adc6d9c8cc265e6f2a5a19ef1e6442b20a1acd4b4e2cf0e65" +
+		"40b254b9bc033e83c1ca69d928ae9cbeff5fd503280d944bd8f892ea5d59c717ed6bb13ab3160af17e9be3d0255d89c1" +
+		"c59e71257d9d63bee26e284f78f4b47f846e4ed76bcb1b0e13f964b7190fdedad40e6a150dfb97a4b716125b4a52c665" +
+		"cd8fdf8daf4610b158e480c62866cfca5cae9ff3ee91a173536c41b05ce9eed7cb9c659207826551e4e232df87afcd48" +
+		"d354b084b9bd703e8147e382067d235b90d96906307f79f7b517b4221ce356c2d7fa4ae8c70f9d657e92cd8d6de8c461" +
+		"33cb5cbb5fc98be1d846f2630688f5c187540cc8be9bc16069d20cbb428595164c2c1198b2729b4dd4d727f198812810" +
+		"c7f2da4a389fe8b8a1104534e109e951280794a632260cba669372d19daf58b69be7622f8a0ff142f4971d1f09898119" +
+		"02b6477e8c1dda9a49aa47f3dc38dc045c0f7ef76bd458d5745685bc3b55b6695f09a2a10a6856c9913566caa919a4b4" +
+		"a8f7df363b65a9d7a15c9504929be802b073d8313979ce2bc68fe6d4349ecdcb227179b563ca9c436a4ef4d2ef732773" +
+		"f90cd796af38baa13adf8d7ee861846166529871e8d89db7d21dd9d681f6614280d3d428b06eb22d0061ab6fd364bc98" +
+		"990383a1fff36ae4926158e9f97ba2bc60aff1d88593abd1b97031d9fd58e589eb579f07422f5cb442c9dea727ffa7e8" +
+		"7617f03b9cc274e13ac8167eea0d81af9f294c72ef277a7f9c7293642b01d0d0a455c3c252edf312c722618bc1bd4c12" +
+		"59b99b8ddcc363c862b64dcdb7daae49600490ffab25e7c156c35cbcd5bda7fb6ea779db8d4e0faf3afd9b966123c37e" +
+		"5558a15ed413908e2914ba96eb2ae5fb2e116eb6fd7c78c71f388aca744f744c5ed20d935d9863f8c98c768634eadff3" +
+		"dc4515580831c054391b2310bca290094dc05ee88895d4e6fd905cdeb936c4314a17776b48317b761e9ab16fad22da44" +
+		"446949775c3e02e919d886c5609145aa83390787870098ebf6f29498fe882bd9b182293843c22fdbcc6c99014201d1b7" +
+		"d80a00c8fd79d789df4f3860bb5c7f4b182aa600407ffe8812c551157aa59c68cb5e943925aaa9b09de0c386e6a12d6c" +
+		"776fa96492540e476e112b87669f77585bb72196421f11883aed9b650f785662153c50657b1d58a4fae407d3decb1790" +
+		"5de884009a5b5a351a58d673408798edf2084c0ae023b6d07e04425aa2f9f6a131bb1f51529c394c40d75e32b07be9d1" +
+		"fa36f05111b1cab5811d1dc4cc1e8769707535b76ed0373952e3ec7318c54dbc88a3eed4d170e8338f0572bbeba6f325" +
+		"1fc2d4cb5ba07036938d2100805fb064c9dfc64c6c5802561fc1fd9e84fcb62a7c7cfa09d05d46e1bb2d765a80758663" +
+		"d75fa0427f56daaedb096c5181ef24ba0273156c096ba9d6c4d139e11433bc170ac1a00c9ec580def66289cb4ae8a106" +
+		"f51ee9819034962286b4fd13a6bf6ebfeaef3e37163822b53302b19e9960b724f321676edfaea36924da7ae0b1fa40e7" +
+		"381f69e18a82e3fb07ff160b1522bc9d72923597a371719176074918b86dc33e44651d76f0dedfe31847dcb5f827cdba" +
+		"4c40ca1fbf3692785ddd5261189212dfcfc647c4165ac0415bba486a1b135cdb8016772f28a1635baed62935df10fa59" +
+		"b45faa614c13a74f35423ca896e5cb0333dd2c497467ca32a619d246c08c39b117d73d2ca857ddfdc28620cf326ccc11" +
+		"df63d8c6f2f3e829bbe5d0f7891c62b99ea66e740a5dc34cb47162850a24fd400a2aca5373baaca5af0065c400b7efac" +
+		"ee44b00b26558b866e3c59691336ac4e98f16360c3efaeb8cf520bc64343008797baf3625a09676f63f95ddff199da77" +
+		"97bac62c17b9bbf9e03c11cad676b7b6e89a8034f5a9807a4d0e2c4ee66fda792a0cf3dc1ca624debe053b0e9f799a92" +
+		"e1dbcf7b2d0243f4a7ca48c98e29561e08187ee18838e4a178e0900c85eef817f9d6e2c8eb605fdb8f7dc914f629c78d" +
+		"8404d2c169abf277e8960942a392a8e0377f1d449a7ea551769645352e3698d8d2c19b6f89d2af383413a4fb510be0e5" +
+		"1f5cf3fd50dbebd7f196a67c7b9bd2956a1c10185b46466ed81a6867a81fc1f9a3082207cbdf914a995854b51453b056" +
+		"8ead02496cefdd6141e11bb0aa54d21fee9044a9dda5afba65c3c0dda3f7e1f8975b9b9d7a0074a4dc76301f1a1e3980" +
+		"7a50ff5dfdb32f7fd7a048c604b5d5d37349f14bc5681a156a0b432640ee2b12450eb253fb6f6156a18de88f6df05203" +
+		"d254da609c02f4ad84ba29e91ccf7496172549ea2b991042756a7620e10e4785f22c23370f55aacc7b5ab4c8d1894541" +
+		"40b737fb863d55af673a0f8c66ba0ddd11780a88d1fae2ded23432bc8dacf4b74591c6c3e58b1b8584337c3098762209" +
+		"d5eb99da889914dcda9bcdbc8bc91f4ff79a671310d14e696a69d9f67fa046a9ef122f85771d7fe614a1fb59ccc76953" +
+		"e953e0f5702d0344aa79ed63015d92f9a544f43b4f3d934d1dc1ce8cf66b19d5fb01b67ddee874c30c6e22e7139bf904" +
+		"82b9625fc22c0a21efa91c56ae8e5193256b6a015296b5b821487af9eb126c5cc30afd7293b3a4eebcbdd5da0f4b807d" +
+		"5a5355b612c8ecbd2638142a9b99744f7cd5d4de708a09678a310d13c1b721d07b332378a36079f541a5446041d36a76" +
+		"9a613dad888866f00422d94487208d2701b7ded7659812fdd6f83aea9240db967f16d1fc12b6d6f33f4482c425871084" +
+		"58d72fbe5416e5fccfb311e2a39188dbbe38e13cbcffad1985708760beabeeb137fbabfd921ce67aebc8b36dd67f2e44" +
+		"04bcdc50277a3d872f37ce2469be7c92ab65f2dd9124a46cf2f114a45ac8ac5db8a4019c6a08d75b1ea0cac1a77d3183" +
+		"312c38756df3c97fe91acdd345c10e449b1daeaa2453800d5d53a58882a64c3b92f3c8992d95d6c4d69c3add30609600" +
+		"b40487f789a03431155e98734ac6998718d8715f3e40c506e88bedf04d47b439f3fd24ec7c75ab3e7e2ca349bf97b43e" +
+		"00c02341b3930420a7d902b9cdb979507b038888d5182712d1c5fb6f10e25084df2f90527a936d081c504f775a3f0a7b" +
+		"02a5d90faf10333a7cad922c4b91ebabe419680325813e27925f75bfb0ade3b017ed75594f2d5a01d6dc19e08cd7ff15" +
+		"0b843d00123cfcc00097407352dfeae2e950fbb8d0138eacb54749759cea427bd94296fc1ef7fa8172aa9217fdf62242" +
+		"2983f3f7a83958d018ecf99d065c62a2839bd5c7567b9933828b7807ceb4953551f9689804314025bbfd749909b5c9ab" +
+		"8703bc511d1bf3d2ee8db5c2970b2a5010b9c423d8b64f479670ff50ef9bc23563462d9a78ab3ef0439569152f0d6b86" +
+		"5cefbe68f3f1d6c0d80581552eb5e7872054c73ea3d9bf3667fdf37ea4e7493ee6f15a9f80bf34442f93552e8ef3d565" +
+		"652e9812e04d7c44f3ff6b0c8aea20fc94177263464ff738152dbdd286f74c184199a0bbec236a301169ed992a49d2c6" +
+		"846667109258396d6a0d8e3cb856c2ad7a895dfefc46b080971a9f0f12889f54a219d08649cf4f9b9e267e02e9f7273e" +
+		"4afd8f8d97b03e2dac7c265432cd2858dd0f16af3c7c13881d21176d392b06a140bb066f791ab54a296803c0f5223abb" +
+		"dadc78e6cc4ac77e83db3a69fc4e082f448ef9e8e5b89fb29445fe73b45a0ab3c0f2fb793ef3bec625ab7a51cf3f9991" +
+		"6032a381a0948b4af5f570d9bed4510cf71206b9147e2619edc797a14f239ae95e2a8c856a4ae74ed4c1bc07378e7809" +
+		"73fccb17f3b489f04a66276ba298b37a9312f4c2a183692a0cc03f3b05e17afee5e83e93fa783d6542a033e4f821af69" +
+		"e0267bc7dae9df9184a50ee2f36fa627a5e7b84cd0e67cccaeb6a08f0a83468e0298b63fff6c9379b5a3651fd7234390" +
+		"27739a00f09147e799024f4acfc6ed8ad1b9cb1062d98bce8c0e5a6b0b041c59c79a4f6fab9f58d6243f03807871f2f5" +
+		"3972c5bab18fa2f02c1fb64c8bc3ed019036132266622e26abdc4134eb8134a6814a41a98e2cdd1dfaa9b2fe166cf56f" +
+		"2c4aa297a401dee85bcfbcbc69103d91817cb00debc191aba440d725ac3e06ed687792a58bfbbf6182a7d78ba601066a" +
+		"25f097f1c320efd81ff5d5536e5c11922b22622943e741e9fc64b0b70ef445e2125868f519608c52029ef1d41e7d2522" +
+		"daa8a3ce6169217d43813127208a752ac21e1542b34820ab2eeee5cb1acec44017715cd057a3553bfc5bd74891ca907e" +
+		"be742676f7fa2315ad80cf5754427a3a38decfdb64aad737c60d2d45ec2f58269e65f0b9cbd362370bab843307bdd452" +
+		"f616cfa6a683fcaeb6772245c53f5e81e819f167362f14c86832671d2caaed47c796855cb7bb5b550e6ede13bc49a2a1" +
+		"76f52a11922eb8942fa1e881ad41d779ee339f8ec0002971654d826b1bc4b0b44315b57ab59e571c4de4a3a8b685e444" +
+		"09c7f689efc48d4417d21afd6f79d6d33e527a944829876b2e012998b8b0235bf92894cd4980bf60604a9c1daddafc69" +
+		"3d5bfd98de1ff714cdf9ba5b1e7107d5677732e4b5336df6a1cb1dc81f0d9a2d7ab24e9dedff0f247ef8d2ba4e71101b" +
+		"1987f36909130bb2c6a7e1ce08caa087b539f8809e91141467a9de5520983dd96497968f92ac0753fb5a626ab974b5fe" +
+		"59fd582ba7aa48a2ff3083c7fbe612bc60025e1769d61287f5b66c570c97141f1ad2792b51b4fd467713d8f2f511f2a8" +
+		"3d4bf600c545123de3f8f61519ea25b23682917de78560cd883c57fc41489f61ae843b1af3a99f808e3192bcc63d0e38" +
+		"b8e0076477ce70235c50ecc7c5f52de5a0820dcca3e3c556a2805a790f57f377aa995f53d97d8d62b39554d306987ae2" +
+		"9e975f873b6b865786988c804cbd8720bf4a813283e59a5aa9508f7f524153fc68efa43de7225b64227c1fa2e925e5db" +
+		"00bcabec309c61309b1c47bf41b4404cbc6dd134c5d1a438a009a9fdc59f92bf20415a71d6a0daf71a1cdf4c3d68e439" +
+		"bb12a84f1669f7a64195a3d5bdf0cc4104755658864b9d6822285ffefc03df03a22240f7bc93ffa54c94e9e7531ded5d" +
+		"ebe1cba12680c46858862d9af23cb989492a8bbff2a0ce37e360b33b52d66ccfeb108d600f031ccbbd207b313ad50760" +
+		"b61e88f2b01ed63528d37eb3859e60a0557d8b3b4b2d0e117499a47bfa708b74079620b782838b6d31f8388744a76784" +
+		"4ad884fda53ad326ca945b26dc62c4b1d6a0ab89c1d461075565476cc93bc17bd8b7fcd057849900277df49370f158b5" +
+		"d23a68b326920573cce378cf5a8431187ccd32be9a0e22841cc06212dec8306a2ce2907ba327fbf5c94995035195d60a" +
+		"005ec09190ebb25f9e0f2e2baea4ef07ef09662a435dd898014a5d9b1f58cab7636e3595df5c3e564db62ef66e73be55" +
+		"3d40e7ab65c96fb598fbdf27bced4d128d1eb1b21f7e34e9729d78286520fd8ff24658dcc4027481b69587287bf4450e" +
+		"1334541cf2bbd8df131f46cb3802a1416c4f866a6c99d4a7b4a2a97aef2740f1529329c8cb5fba63e34a7cca7f2c5eb7" +
+		"41fdb161fe8ce3f3b9dfdd75c3acbf1d036674c35c7fde0aa59bbe83117857026ef264521a10eb172e266640dba784ec" +
+		"44b48df95d81f7dbc65d0d63ce6c115a482359aa239e8665350549ffe26fc337785c2f803a2818d3d65ee2252240184f" +
+		"93d70c2de663b2885ddd22f999edc03a023e5759f1740916cd75eacd6e8a8a9c7ff8ba1e12926681819be87fd5011406" +
+		"e991b7bf353b8bbdd1d688788b358acfd9877fe441c6f704eb4d426f85607b57c6e8f47d4b4859a389deb9deb2058acb" +
+		"1146a260c8accdc028854fd465015641a7e5f3b96fe8538054fe9b17fd798dbab7e1f3722396452fcd26b10b1a29100e" +
+		"7eafe12479bd89d93dcfd5e38f3b5b5e8de7da6c59f617d4b0f0b286ee3413f31d5a5db4759844d1870fcb4dcfe3ec67" +
+		"ef42e072eab9cb89f8d4a3d95e7df3b1ccc46314c032805418654afa8388ddd5fe7318153ee10375122d00e18f2f43de" +
+		"5b8fe21388a63cdbf84d69e4c6c5769125cd7b42dac952d554fd257d16b7c9ed7d4b110a4f879888d82088c9e31d3a55" +
+		"a335f3fe4b2a4fa5b6211c2950fe592e9d2a9912f8aa4366e7332450c0145de12a1e22ceaa149fb9b96fb2308e3cdbc6" +
+		"efc5803e91f6dd4740d3d26c1e34d1b74c43b1772b11028912ba8a9eb86fa805eacc4599e93d18f85798e8b514bb65ea" +
+		"f1d31bdf36f25312d5e74fa638c6cdc367487b550da6e2fba73e27712abb6123d53e2d4af041765dfbdb44d34897ab0e" +
+		"0ddbd51b2d3473f802bb605d631a6caac691e19ab633cb461d864217ebba341a5d269f6757c657f135e26ac31fdfaa41" +
+		"fcc7e1d6568533a627f84c518185df2ebf8032be7b6e2bb3910007104d9ec600667b6681d05620e99ed197b754fd2aeb" +
+		"58b2f8b46e45b94af487f758f313d42a7093987b1003c7601293f24b4a9648a7567b88c88be8cdff830d528fd222f67c" +
+		"6c3689f6fece9b889eaa7d6c25c49b7585d1caed259556672b05bee70498916b272185a50c5bc09e7a715119ee1ea86a" +
+		"7526a2b9f742da77747d07928b64dc9fd2fa06e5f8c3a2d02f739528407628cb717dd52958760822e6196962fbc492a5" +
+		"e533bd04a2cc52989dd33d9eb55285d70e66ee2d52baf4a3c6b25d28898ed8d3fc442b988fa148e7528eacd5a9571a36" +
+		"9513d551e00050e6d68b49c4d35c496ed8f648a559d3e16a4accd4b9d9cffdd8859142c5cc71c016efdae5e1459f856c" +
+		"dbe204ca0a310b73cd9d7dc66bfca16db67853ae148562477744c8593ba02966e8f0b379de23143ba6f9b7bf522a0725" +
+		"7de30b2047f09426afecc906b58a2f61dfeae9d8c2a822867a4e676850d750fb6d7eaf431fd4be45b5c4d62f3fad5e6b" +
+		"cc250f058f9f2a2b1bd003715ad48ef416039242a5a2a5e0103df122f306196c77c79e2e26fcbf971e20c29e34ed0815" +
+		"778d8634b55eb449db690e42cfbaaeab8b06d0b1c96e7781229b90d24bd607dc32711548c56ab37c733b9df67c3abb9e" +
+		"d58811999aeaa1e961ccc5d841f24304379690d12ef106c24696abf8174dfe866e110b11d486ce25e6337dcb0d2c0880" +
+		"6465e061a3c095d22cbfe0c2184530b9dea352018f2b0df3d55481c58c10ef8863e1707ed23b8984a8f11872f2d09624" +
+		"85721373cafda7337e3f1cdd4c548607d90e20ed483bbfbc41199c3bc59d53ce67f6ca7888ab6930cb876e2867bfe841" +
+		"0474d1154ce3506faace782376ef0f157737dc6e6e8a17043a750bffbb88db75e4e7a3f31b5ba4158be4ae491ecc0b91" +
+		"1846f8850afef117fe546052568d05f71d516410104876c96836a37e04ed6aa0aa485201a74fee9138d41955dd7ca689" +
+		"d4058f78591275b9ae8bc4fdfe53667225b2b5f39d6642d29c4114250fc008c2114ab9b4d708e3643df20c65e9d7d833" +
+		"ffb2bab0ed8d1612062b32eee7b08d74d92c1551bb046cc3cd52b7187b3f3477286fcccffcc22c0c58b1aefc5f11f0fd" +
+		"80e9e17d97e25ee63a7f3067bc888679581b6c52bd221aa16f5bd41feb2128da9c6e3529f73d9d332da51a93bbeb8ad7" +
+		"f8058f65e507ff72d3a7c6ddd8fb36803b77db76edac62af3336809b03140b492269c0d7b059cf296b6ad9b6f46e3c26" +
+		"efa0b0846968f03547cd8575a35c0ec139a95c94b9a99cbae456aa6741994c0f6e32e81c0db3260572c48587b9f7ebde" +
+		"d85ab7dccb153f9a0fa1cbfcc918a16b708ea3b9974ac468575c7d0fff04c4f8a9f50e2ddb0adc6d26fc44ba54614945" +
+		"63e7d0805d9283396b4e76899bd59067c43e74062552bfbbcd304c780516f3a206ea59c64225d98453ec6abafcb9a156" +
+		"275388b6e83d1050af922657ac0ce37741ef8e954a55ab27d7a6a6c815ba9780a4383ab8411ea475c82117c386ceea16" +
+		"8a2e7843aaf13db050a86c1bcc321a84e8c9341808a5108016ea96d61df2381de9fd3245a62924ccd84dee890cec7361" +
+		"9d67f11eb17b6abc135c755fd20d347267b4f66495d044cfdcda21320b8bb13384616d3144931ddc6f70de582d3126f6" +
+		"24e5a13baa1df45708f9ede71109d7ceea9580f028538495af5d64825453126cdff2cdf4b78e5f6403f01e6f888c06af" +
+		"d0436d22315689f93ec5707da800572ea08763d2f4d7edcff7c2522201f94c359a7645c756b13428e693302e7cc966a7" +
+		"aa0112f789adba47dcb3ecd0415040f6c20c951d26d28e9189d550258ba2a776016179761f25e868b6a1fd0416885102" +
+		"99de3da175ad81867e12ec3e1f554be48706633dfc7462fba30eb6ecbfdaeac8a8f1319717d000fc4be4c69b6b901b0e" +
+		"f3af4753d8927c170ed2a9b3c91bb7a034fb30ce3f50fe22577ebbba8874912ef2658b6a11158874943c54f1d06e286c" +
+		"2ccdd2020f0c7a036fe1a49cd142c3db7d0d436e29bedecea48abd7d64fe8413be1510cca5f49e157f619ea9328f2ebe" +
+		"a5d7bf411288792eb998c2a7277b8721eabcd6db662178875d11d9a4e4bdd9b213d264cffb950474b2e21d462c95f651" +
+		"4581ff9581e4b7888574924d80b03b842eaadaf0885ddfba9235d7b884593128afa15a9bca155cbe308ed71a30e4853f" +
+		"f604fd7a52c5783575929aec1b1a218b26f94104df75b1c4b712b4fc52386e5cb6a39f71d3dbfb9c5d6c2f15ae08277c" +
+		"35a3c6be68ce1baac0b8b3a4b15c28215c7f114b8cd677b6117d46ba700da4a6bfb8ec7f7c4518b82c18c216c2dba281" +
+		"2a5ceda363b9182f844445f0222e1786cdc6f43bdf5c751507d4b2b254f324d68ab6ab2309fa736fa9c5218552d38fbc" +
+		"2829f2b3e55f4f9fc843119dbd0666a79cb8bef2e022196ea9a99f150b668a3432cddc441ca1694db0304e499adc267a" +
+		"af8f2d5ff8d2336c74b7a063852c7543f4cb447ccaae67ece93d86a6e7d875277ef2ccb6d4b9da9b9855234b2dfa2c21" +
+		"f38c6cb21a5b4cb81a40c86c94a0849bf6938349f2cb3694c2761a6f477d8aff6d8931c6cb39c0f79619e84bf35bded1" +
+		"2b298db44b0bfdc71df9554ad3a96d03a3bb9ff56422fbd0959682a56ff2a2b7293865ce6b55d82af4bcaaa83adce3b1" +
+		"4e00618bc5b1d39f963efc0fe2f99744caa605690530522f9462e0ff5c6de3f891c570663f1a036ec21820f88739e265" +
+		"44fb156695548af49f265b13704feef809fb48eb08fda31f3d0811bcf902c65449fc79ee705fad77e3e69699c47d88e0" +
+		"f0ece9890a5ef666a81c4a3041b265d47830344adaf48dc1c085919b31e9d0b8caa0a3937d64a342ce0ed1e1ab927926" +
+		"d468db7d3199e71d16ca7b251bcda0119e0234d19564fc0a3bdc8dbb28c1cea1c736104895ebdad512820a80573f3fb1" +
+		"c7371b719f62dd379b8b6d876fd2f364e772986f75b65700b711a30d35885ea51b13ee709e9f6f531d1b12e105d2b6a5" +
+		"79d6b7938a8583c99a779a3937b2bb391b95549ef3737b19bb10f08b0ea8eb48211dacf4f3ea63e5f0bfc278f97cedc6" +
+		"8c8a12beafeb508b412498b3edef2102d85d90b6960fcd6c242aba1099b3e9c0ae42eec355e083a424e6bf89a5c8d29c" +
+		"0fafef413db1fb59f5f1d9acaf2774e0e0a2d7ef5d8b2be36a5abd4ecaf25205471469e4891ac14be2dc5c87ce18ebf4" +
+		"43577b166a07b17d4d1d56a11eb638e1881fc68737391b9d26ed61cf125833d86856bdb21069490d0cd1cc79a8033e2d" +
+		"7e206b45063878b6d8aefe2f80840bd39e08440ebae7d6d847c199a144243e9f2ef7750d03320d1bfd4d7a29ece124ab" +
+		"45dcc9920eda639aae0e3f5aef9b82b339877b5c2a05eee0a95dd49d11df269d0b46dc6077ad0940494b33e9e3c6072f" +
+		"cfe184bcbad2d351403cc5097201568b8e591911e5efed6f8795bad9715adb38cc44a6e2ba97d5155ccfc680eacb5615" +
+		"fcf306164ca5015d2b2728294268ced7cb87a4a9e7b0334d1bb3f1163ab8a815d1d0788dd787c95d92f28cf95ad65760" +
+		"4884fa1c905e41f62ed34f4a5729e565a1b33ce227307b1a9b97c2ad7043edcd2a89bf0d75a2d6425fdcd2a0c2cea2ff" +
+		"6b1ab66c5ee924456f0b3ef4dc79be57377e9cd0b0a8eb6e85fcb974c01e4168b37f2cbfbf917c7c8e5032cd3e2b5a1a" +
+		"80121f17594fc0d9d6eb66fe793338e53c719310e2c3fcac45a6cfceccd0153e6e47d5e5e919869a0701c5b1b0aff85d" +
+		"fabfc731d0581ffca180cc9a83b863e9b4008fc0c6c13386d7d3f5adf64bb5756caddcd2da79dd78bf3a680a11d9336a" +
+		"bf47d72688bd97d87abb47dc4eea5a919c63af9ba1252708ceb534c7fa9fc2bc1663634d9707313ba118ab04ee3692b9" +
+		"53719ba7cefc841854c644a868d750ba2751976fbcc3705ca37ebeb280ae99e636a4c1dc7739b6c539b319711945d767" +
+		"fffb62ca0926c5e90e84915be18c4ce6be9a58cd0fd63d761a151b7e5268742aedf9f014582ea901bb48475a8482ec7a" +
+		"a5761480cf40ab7573fe88eb5871e6ed644ec87520fd8f10f4b74059f521fda43a216ac00bfc3d3442a4c0c18afa14f7" +
+		"4f3e6891c0df470b75e4c7bdbd6c585f92e2002620562c86f59a7155523cb0fa6a4cf5038a8c7ba92368a56894f6e23d" +
+		"0d554348c484a5af3e796470006d42fa6a5d6440f958b4a74e8ecc2039220a8752d3c8e37071ef306c2283b6351b058a" +
+		"4c06dd0f761611ef95908edb6c37f0920829d63d2662e3663cfc75b9290fadc322e118246ca85344ac004a50f5dd1025" +
+		"347592fb2488a1c3cf2205589938fe794a710b173126742e3b32e01b3c3fc01d5d28ccf5b457c56da387316a786c53d2" +
+		"341f6acf211a31474d11881601749f54cc5999397292be8af54cc7acaf0b093d5ca12d69633fd4afa10b1ee56f5935ba" +
+		"7399afc2addd7a12f3531b0f2589728a7cddc14e2a40a8691d4429b02b591499273065fb1b40d037beb75454f38a63d1" +
+		"bcbb9cb8b9fd0c0b9bf66e6f58382f85773ec4396004837276f2b9111ec3014d6c95b6ab4a1c45b1618402f69bfb61b0" +
+		"d45b8fc94dbda7dc106a1fca8b005c151dc44569c5ec69f16a12cb2b38952d3a80168bca29470d94f76a7c1847b9c50c" +
+		"c55e4b8e2b57da892e328e61d6d847b9805007617edd0be10b45247c4f190ac409c3cc78a99699872ef3e613629930ff" +
+		"a258ec81c35d1d732fc2f50b4e32f617c76bb3fcd5468471fee757d15051ac0c0ae50eb529e1c6aac8b057ab88aa114e" +
+		"dc982a43911eb40473b1a29be34c060c328d200d53ae4a3232eb6823ad2790fa96f9e6a6deb1d4c8a1d1433cee9330b0" +
+		"b8e8a9001d1d3d2f95d38dbba881bc9c9aa052fbd0c4a36b899bf3446b5426517312b50fe6a28319a1bc1c5381ea041d" +
+		"a727eeb2db27821158237a0600474d4c850a78a452e082a400f96b96c2a6c273207569002f03121c154becbe70d0f5b7" +
+		"754ab9bb43c27cffa8539ccddf5ab07f86811b85bada2913eb5239bb7c811f928abc861a135a1a447d3836817beff789" +
+		"8ee86991aec1c5d39490427825a65906b49b99692ab86bf0956a80522f07819f16387e20ccd7acc9f6dd79e411a00d43" +
+		"fd3e44965f4a6fe512eea4999cc8a1e014ded56c2eb9f7d9a3d54cc12b6a9a2ddfde725f5ea7969035127c42739eb292" +
+		"a23815403d45c2bdac19e48c638416df313e4e2863dfcbce7c2b8b1df443f3d6a27150ecfa282aabb231f8964e26c391" +
+		"8bbe67a5271f9be30417dbefaba94b426ee3f9a5fc7c32e3b9f74ce6d2b68945f213b191220c0a7cbebbb6cfde0ea8d0" +
+		"135c110879262175daa00864bb8f52ff45249e4fac29cbd08b70d217df89fc12c78e458efb68b9f804aa1d719189ce72" +
+		"96401348bbade2e46802c16873277fe8748574b3d9b3322028db526de741ee8c54d3b2a8799df98525262fe95d3cf65b" +
+		"112c1958c95723c6be2ea0e40c858e80b1c0ea31e0ffb4d843e819a1a364dab38134dd1fb17399507c3cfef1582a7cf0" +
+		"ddfe7a05ac29434949fe89bd3a5570b22060def31012a4649ee4bd2c051d80328eee26e2e0e14d55b25b03c81b834dc0" +
+		"797a36c22b6a0bdcd338870ca6210612e5cb4030bad316198da746bbfdee442a2390ad5aaf552080496c99e36774c166" +
+		"96d08104061ca20cd566eabd650b897049224ac8d342a4b2fa85f0621c004188e26fdf96c97a0ac6dd3faf0485c7bf1f" +
+		"cd440df36c43750e8e5d270e967eb23c718142490c5dd101b21967583f7dd5e7cd8e43f3a77a3886d640307ac18aeaf8" +
+		"f105974fce32b255374f933b794947321d65fb095593fbd4eebc3bce90116e9073ba19ead1cbf6b08f73381ed22ae6bc" +
+		"3d3c84e0c8aae7bf3dec001d48ebef9281632551c17a8bdbbac5f662f5d89f7459e9455e2e2824bd5f9283357cf6b898" +
+		"420d2cedd95a5d7df1fdc731f3eeb6f5c99b730e5bf75d217247b6c2ae76d2a8553e50e1eea915a36e556ba5c39b9835" +
+		"381c3c1c8b531a2d3db2b42531dfccaeaf5d3814f62191e55750d81eb11d0dd90bb742527c49f2957a0a1efc291766b4" +
+		"d123b8d331cc35a79ad7ef2d877eddecb315d70e4d6ad193d78a391d237f5b1efea8e9a34489121ac56258669f7468ce" +
+		"efe73770a11eb47a699fd6f3e15295668776f2eea44aacd86f190d5a03ceea550abff00bc40746b98f6660ae22290ea9" +
+		"5fb34f8a6c730923256b44f8de92c71377e62ff54c1835b764001ec109ba174a0391f9034ed86cbc8362c7151aecdcbc"
+	sfmt216091_jump = "" +
+		"66f32b29057aa7344aea80b699ca9322473696e30aa3940b5624f63e3c7a9ed3565bd616457c5543a697486a61a917c0" +
+		"4b060b916fadc083831db17bc21f2382acdcb31aee8f959203eb205d7eb082d14145d03cf7915cc0a7509947dc196f9f" +
+		"9da6ab25ced1a4ea242c80fe58f07703a3f115682e11f0ba49f65d2ea26b6e78ca005b5d2e8e568126ee31920c774d02" +
+		"01d5cacc5a2ac158b6c27e97bc5eca54df7c7b2c05ec12b89215e9b4fb55ba31d575039c8d768cd84501a2c73a563d60" +
+		"4e4131b32eb9dcfa92e2c3ccc4a5d461fe6db1423504bfbabcd9bf31697ed18ece500f6ef5d27b3af0bebf4a0249d20c" +
+		"7016e84215e84adb49b7953e8dcf1750884159cd18bac508ca6839d9e935b39036ebb8bef03e2f914b38707728990712" +
+		"f1ab86ba12ef6b92a83255880600127adef2cdcfd9dd1ca0918ac5ebca5cf6df5d9cb46851b88dc7416c3eea1486823d" +
+		"8c1a4a42ce96632bfaf3844eb352d7f69d30b89d93dea2ad16d475af7336796dadc6919976a7ed9ca6ddafa55ec06cfc" +
+		"eea653b37f0d4a5f6061a753abc753f1cd0d9af16f5332a9e79f83efbcdd841e8c793a1fdf191a9af3888182327d3893" +
+		"7c4c8e0a937015e599e046d757b157c05794af9b2a0a3c5412e36014d850a4bdee071785727230bd35b13c42391a85b7" +
+		"9b7bf0e595f1ef7dff44b751b1e93767b344e7ff9f2a37b2042fdd7b48715aaec22ea61aaffd40cd92c5185817a9aa07" +
+		"d63dd0cf98b2cdea011ce36b84728b27a8aa962f1c30a806f017f69912968beefe5912ed41bffd83aca3203cc560b6d7" +
+		"35da5cc2a36c0a13d547575bcf8238887997f89fd00a8aedad00aac3349dce7c9822c291a8d963122b25a11628fcd279" +
+		"2bf6599090cc5a0ca9eb1cd93d2de969b8477894b6718516a92661be3df532ed0c0998a0027c3cc914721bac9e78d55b" +
+		"469a23e04c0cc35fd6d74d1c47b03b9495fe9b70a76d860679c8ee402512cb29c28160b7767276641345bfde00d47b1d" +
+		"af6601dce22e45df9f25251c5e67021e01e79f4e8b338fdf359fb5e4b5791a5c6039c7805f32f612386dae32d84e0cd5" +
+		"f30af191dec17f11fc92e6948af86798daa6a4e43b98616cb20a4fa5e60e2e0d29ba98cceb5ea32f4e2d0e28771a5b3f" +
+		"08cfec968e40ebc1d88f9f6787a3bde1ab09b35016fbd837a465f072a954e279ca5f52a35b94826486754a66b4f317d0" +
+		"fbd956ce923bcb954a014b2cb0324dfc481258e0ee53da0336930398c5d70218c109430a384fb504b9c895c08aa188ae" +
+		"b317cdd184621f5d90815ab4265ff723f2436a11f09126954bc832875bca24ed1b8efb86d2cde81c0ce0fa186d0c47c7" +
+		"f19aa3018524587dc1167ec7867c4b8c0b961b8e8c4425af6c9292ddb74d2431577f0a90bc1c6cf9fb000f8cb0da4aa7" +
+		"bcd5935f6782bc9b90f2822bfc28dfa240683d9af2150adc623c214fdf88d18386269c4d3777f7c1dd5aab0584147241" +
+		"39340348f5b9e61065d707b4db3f40adde142b22015162a14569e3e908fab7327f17b14a760f591ebaff385c25e6f84d" +
+		"12b70f7dc82650b1e9f14210cb7cc0a88c7e1da69ffda06e08c2c630a0789064b88f12e7138e71fb0d9eea00fcc36523" +
+		"081cd75694a933aa3c2b437b45cf514cfa84386f0f2223280ed2f39789ad6d2a14294164932444712a782f7c28611cb0" +
+		"8b01b8ea29d3d8d3c092a2292ab23d46056fc8d093da58bdac2cabef687f3fe23770652e0a241025f6db12b147e099d7" +
+		"55c09d7f4c41a380704f745ed7f46e0b167ee89268025c6f2d5cccbaec736aeb43e0d0601ad93c402410a6d33adbf897" +
+		"46883cc0afae2f621c73e566c0efa7c956f69b1f8cde3a5cf7551148b94c97a54d62e5261585d4093b134d57739f20af" +
+		"0c3d09a06042ef730e5438cae94d5ef75653aabf6469ee53f8033c285911763e076755fd7c32489b68cf080ecb5852d6" +
+		"ed45003bfc384b3bf2606fef652ac7d93964d413f8952cb9dfc4d4c05a16137e05d0534dd03c728b8e99ac66294faf73" +
+		"a9e3c3490bc34d41cee668880eeb742a9fb398326c09ae05dadf77b08974eb85f0c16deaf0f16f084b9f9461fa25407b" +
+		"534cfc556946a4dc2796e8344455a458bef7d7d363a0cd61463a3e24205ef1542a504a1a60a62db4acc5b44c1a982e44" +
+		"d6a8ea2f3d46569140a641ec7645a950b0c011cab8243bd0dd3a9e16f4345a7548f8388d0424392c67682ed609bd56a2" +
+		"73f0a7ad6decdc82ffaaae977d3840777ed179fefa0a49e6379726b394ff6cb230988a11eca379c2f66ef7d9259f748c" +
+		"d57680cf86a1d6dd9d6b5969dc2251011a81f44fc0f6b564feaddd31dd7a20442293bbd6ab4f16669af362dde66f6145" +
+		"551763e7637e48db0eb3d77f99475a9efec516b6e113d5c2b77d10440d6565b31611bf2c058dfb623a7c5babef82fd9c" +
+		"b78f25f0fd9b3307e04bd46875cc06727901bd1e6148012d8e3940c65b1c77aeec446f3592f7b791c56d8d6d57ecd096" +
+		"f6b3fabf68153ba0cdccdebf7eaf02175c96f17aa4fe0126bf614c429ef109ebbec6b1910f4d74dc60ad9cb454dec8c5" +
+		"4d0309f605e948c1f37046d077419f8a85f84c5ec56eecfb3af3ea4a145ae487556bef1a5a91ebf789b70b50faddc3a5" +
+		"cc4710d4c0d4f459b08e9b929d573892702a18e2f6148a1be1d1315cee9fb1706b0531d5c85aa89735ae200c4942242c" +
+		"f18a151d8c0fcae5b52dbfe728875e871f8e8ead238bd641f90c23d169c6d789a239dad290253d7a82261640e9495394" +
+		"21509880e0c9fc9b975e2815ef4358223d8f5d578baa37e483b18d02ac2ec8b0a52ba94bc6d08b11634c1d4cfa0b56cf" +
+		"7d38599f8fef43c1a6bd5f39edc40d7021d967e3297dc14527b01ceab4174791e6da31e0292b878937854047c73c3722" +
+		"3bb783a623056379b1747764db186b29efa13e2bdee3f4e419798e852fb1c8b2e6f377822f3314b9256ee8d1897b677c" +
+		"9fac8d1815290a3131eb266276d157f484731292d13a9021ac243b784e118832815e325cc6ad4bf2de62669fe077d7fc" +
+		"f4c8fe14ec180a2ffc4170d4afc5de2e580294105073d62bebbb32a1357d24ae40514b8b8f3583c19edc96757ba3eadb" +
+		"c29b53953c2d9f31f7ac8381c114571cbc06ce72acfb2ca25440d72ca1e5aed11c34c5fe1da41b4b145f6b402ba2607e" +
+		"10b2737d2b5653e7d5687e1b39103e42c098b1a8e2b6ddbec89877d09d8045c2671a91ab10391233a9cf0c657d6fa309" +
+		"28221df70788b54860011be53ba037bad06a7f379f3cc55da30fae25dd9cb4cbbba983a5783224481ae2d20686bd1292" +
+		"17cf6d1bc6b518c0c03e5eef0b3261a05012fcc12614b99e35bec733afa86f2721a9ae1f566b1ef27f318ea78bfb0d8d" +
+		"e376a036cd971337157a9c140007ba4008292d9f990d9f027c31a97cd0664ebe7815a8adf7f0a4e5fc0198367ab7793b" +
+		"85e0006f836ffe0e6091341e816852aa48eadfb68fe240eb0578518dd9940613f6881d74315d764d10d304bc456c5c2f" +
+		"3197a8b40ba152464908d8c691b4eadbf5bb481eb314879dcb6cf31ccef088d2f11772e18a2e0667e63fce05b480c09b" +
+		"21b54c0773406dc283d4dd7d88bbd6abb68d55512f013c8d2b998744dabc74494550a6c74be82f5636297d2208d49728" +
+		"686758527eff0f61349949843da7b14cb2938784c17b79c1bb8147dfd49e3353126668f2f4152cd1388c345862319d9f" +
+		"d748b0e2def78d766fafb74ce7c41a2101157b149b5acf7f419d9f0aff2793cbbe6799b1aad5aa5a9a1d3950450b8cde" +
+		"92091dfcc6b7cdc55e145cf6903f09d3205e9beca8df1f24f724a2ab39ea4cd2cabdea9ed92613eb29a60656ca796716" +
+		"cd6ec7df3e8de198a69c22bea5b9012eafc1b287b52e3ba3d21ed9bd29fcd04be11732aab59b46d0b73ed2820a18e77f" +
+		"1264da0e033e35e9c18d7ad914dc7d54d20659c370b7e6eac5ea2819d6356ffb0a66c8d6c42e84752b8b23c2108e44b1" +
+		"008c341f3c1aac32e9729a4f1bd323b94cb04f00b58f80fb8b9e0dc1ab15150f4a138d6bebc91dbbecd080edbb01e509" +
+		"45f41c24c1214bbf4a7157acc56f393865d602e1a3159a4e41864214d1724d761dbfde2d380dc5ba1d2e601d8458ed09" +
+		"3d08cf85e7224002fcb71778707095ca033dcce5aa9b79154ff98f5733acb9b0f22df87f5fcc958c5bd85a2072ec484a" +
+		"df28eb1cfb22eedc7e87d4cb135a903f2d859842a28fd3716703fde7a5a44260390246f55fed3fb7b242c6e297beede0" +
+		"0108b3d8424d3ec7e87ae76ca3fa581c4aeb3d11277aefdd783ef05862b3a95d4324ee174789b642df1ed90af9b646ab" +
+		"ece1c777fc3adc1f55cc8a1a489c6042e60bf8c40f776c317fb5f1fd198a647cded8491e065c1baeecec053b671ee21b" +
+		"4553e7102a5ce46c5255eb0507f118a36cb3a1104bfa20c3d035be1b557655bafce0d6144304e6ee04dfc97ce8c746b8" +
+		"2b88b3d6f6550803e1540633db9c9dfce9ca153c72163578e5a6f1884bd4f5e5e1b9b00d950a4ac341ca5df4f3d5f4ab" +
+		"fe9ef9f60c3141204affa978313e3bb97ad59645aef6b55327a6174ba9d426c9e48d6fef2e663d48c90de7e9ce349b45" +
+		"835ea8601d12346759eba2d974566dac5bef5e7c9298a33287d584d0cb06a6318b766ce9c7db3a3aa68bcbb4eb7d7c45" +
+		"5290c10057a9301bb8202ac2ec9bbb6505ab98b10890745530a200c5bc28681f9214837cd190e32da874c7775c7a10d3" +
+		"4f4f3e27679b211ca9afff7caed385483a5f24e5b00cbe9f4114175835d038a5052f56f8a89b05b80c7227d1ab491aee" +
+		"78051f504ee8943164e9f701a67ed5fa2c2d2005dbee5ca9459543ba6cd5bf3583d4f4913fe6c61971bb9064cdbd1ff3" +
+		"ebda104d8c22194f33c891048a2fbc1060cd2b697b90d4c83e373704dba0543d5d47553a88d28a29dcb6e8132d9b9c9d" +
+		"f79d4d45cea72d5ed69f58245a85f27e9a496c1acb2d9a1e23a4c0932b79ba3065a7d4ac7e53f5468d42a1bba864c889" +
+		"439828100cd7012fbf34f291b633e5716bacca721fd846d7c6593217f3d3758facfe221cd7a673efc7f331ddc0b6fe38" +
+		"35882a822b406a000e857375eb5c5c1d56daeebbf2b790582229e2d5ced136052a1c2f56340b7d592571a1e7b9e00c5e" +
+		"9c8440a35ce8836ef5f2ac333851151ea106840b1e009eee6465f611e8556b93db86fe745a05d295946a54d31d7a489b" +
+		"e44cfd682a82a59d239b8b9774a4d8c33d7e3b921651261db9249c83eca2b881697fe168cb984125dfef08f6e97e258a" +
+		"b53f7da7676b8a9152fc8af90b5ddcf3a369ff95d807d92bf7a9b79d196bdd0229f14ecc06b437b9d0be5c9552a18d5b" +
+		"a6cdd8c5c315586ad1bb6f3a80afd197829591c3245ced46118bf47a570102cfc01f8de14c015a8a2ffd4255934ef5e3" +
+		"0726e49054421dbd332f7209099aa63ad92338231fd54d1f716b7af1165c09f0035a0d980c6842c376a5a8b8631814b0" +
+		"5acba63dd8a3bb08822554b1aa9e0d17f257fc138160cac950febc2dbd46148ea351617cc054f380112622deab2c4e02" +
+		"4e5e44d3cf2e9b1046c82558cc9f15fa97688304b6320c87743bb306922846cae49df4f85f698781ce88be8d7fd8e131" +
+		"0c200a9d28e262abec52f3bb5031600c33832a09a350ede2a06bd34fd28235d9750559df733d5781eb6d764172b32982" +
+		"9cc57f7ddc17fe4fd6a3c7eac00c0d3719d7dcc3bf3d354733d933a37f93f319fe83c8ecb2cc5c3c622cc2c5ab17bbb2" +
+		"c354f9d298526545151267887d4af6e5c4df8062a484b1e5092028cc4547b7061ff4f1d2c477bb9b58685179a0207e0a" +
+		"931a85323e3b7cd5ced2a6f7cb6a5496089f170a87635d3b41ad08898387639265e0e4929a4122f0aeec3429c157163a" +
+		"f3b580fd71915721cde0d358924378a6793601de088daaab88d8cbd25ab110fea55e58262f0e69236afd9d681c55a555" +
+		"f29fb54e9888d8520fd72baa58e6b3ab87c8d3175038be1ef3674eea77b708f56ec3fb56d9f5dfa522a1ee9e86d9bace" +
+		"92be6a545db09d8c71470d68b669ea066a66bed74b2fe90859beaf69796f55a671b05b27c1f9984ef29af7fd38f29cc6" +
+		"b0d38df9a75fe9a9be684a8ee19f1d780ebfe8df06d61ddf85507736dc59d3bef891d49ab6f47eef2544878a74450e91" +
+		"3c00e7444eab26f0144960b9b178ae6c9c0ff61dc3f324a037296a753cfaad0f29030d776a294c81015c4f3215f7f446" +
+		"a12b94d25df4bfa17d4bb4c3b93640f528e755622d863776ee76641a321e9bc60f853818004f482d4d55e0d1e1638efa" +
+		"64b3cb5d83d559f40728b6fe350baaf29eb693551a7b67c292ca333fec2673251d17d593e5c26403874229f0bc801a67" +
+		"bcc119c7a26b72b3f49ee88a7e8fd8c9c9522c44d682137d3256d89721ea692e9b08d6cee82efe726980d3d995458f50" +
+		"0b2ffc2fae52b854794936bbd3b02e0ac79a644818e4bbd5c7507f2b996e5eb6c4e4c0a31a754390e5cdf3dd94e63a94" +
+		"6fcc99354cc456bff60ca97b76b4d2bb71162798ada825c485345c3ff1a59fce9e0c4db64f2b663444e22cf7af9a5280" +
+		"17d9625804c2ea42cc70b9fdeeffb0f8b73d7c7f9f5bdc8cdd74616e3bd14aed8973098b5dc6ddc3564bf8fa9b98c64e" +
+		"5ba51c9bfbaebfd9032d99e85045c7af299ea954a1aa722d9de5e126514be036e88b2adf5e2b61b3c24d3edeaf561662" +
+		"6e71b6a6bda0f372f9f57e758eb5cd3083cd7523860df8fb11c6e1504ca21908c9c851772c70378ebcae5a66bd69b284" +
+		"c28fbc7d85431d6742c82e71fe5be72fb557b91663d1c96ae8e581a649447dba373f6534ed539d675e042b952c392e0d" +
+		"27f9c5bb37d91fed2f840b1ca136e27c625354356719b0d941c950d58cca8cff119332e578119f4a77e3a8e6493914c3" +
+		"6533a3793486b1502e67b215565f8971af3d61f47571ce67e712d75c4ca1d9a627912d0fa58ee9d5908830734a5b708e" +
+		"56f7059bd80bc8fbd38d14a7caa5be7bc97dd6cb94272d9a6b1181ec63df5bb323862b845e0b55285841980e28a62e99" +
+		"49865b9ad4b638cecbe1eca2e77b93015831cf2763e4f04d58b41ee5db4e1bb901963872429fbdafb2411ef3460196cd" +
+		"55cc3b51113da404d4187a9503596ff3cc0015412a258528a31510e206c140b5a21a590b0d1f201fa265c2416966aad8" +
+		"9cbe7ebe2af38ce02bbcf2041bfd50b5a76cf24d5649da049a5ca7d83f43782f9fc14f93138a982dc145d08fe860bae6" +
+		"973b5a0c8c1c96a9f9b89b3c70e77dcef810628a78bc8212ac4f67f2e7d12b8107cd49c44ff872733f2b1d2042c2876b" +
+		"8b31d7c2358376e92b0b6539ba5cfb980ae11554b5512ef1062be967d837bd5a738c0d2d4bc3adc0407982ebd7ad6575" +
+		"b63daddf4a7f69f0a6968031077ff48aa98a307b940c90463955931b0a5c30ced6c036d8a62b6f9fcbfffcee5e80af56" +
+		"161c8c4709a614a683fed755749847460bb951767731a16538cca335abbd7a3187a8795bd65add8887554fb5ba8beadb" +
+		"19bebd1495aaca838eee236d7e1e87483f7024bbaa1cce8529379d7e8835b3b48fb79129b2b538d43377235c8cf353e4" +
+		"6d286ab4bd8526981526b7c32551693a66d0b7d336f093c5091be0e778e6c043b3dd93c9273ed36009f71bd606701b68" +
+		"f2b67ea66f0e1c84c507c61ed2365ae554c2b6615d1fcd54b96ce018261db08597d1d0f46a2df6f27c37228ad0f87ab0" +
+		"87d27b597dc41261b745dee98c9bc40768062b8c4c03fd31fedd37db875ed462282d814534c346daed5191a1a6ba46c3" +
+		"d6d49622613038fa4ee827d2118c2a27c2528c3c9da4ac9374810eec0c20d74e278b0963188e21372649f1af00143ceb" +
+		"bf4685813ebb02c6c0bc130bcfa27478ce1afd0b3c4908d2ef5ec937b7f27f190f31bdc3fd8e836b080e81ab9e046b2c" +
+		"4dc5389044692d609ebe9482d1ed0ff00f7dadc95e2e57f8081c82e8fe6c0684d59e2cc4aa1ac6065457875d7cb48023" +
+		"d3ace79734e233bcbc43834249a77050537d28720fef08b9fd367b4cdff5e3730839f44c210979b3487962001cb38273" +
+		"50af0ec71e70d397f8bcfb42cec61ebee33f3e96790b8ca0c0fe1239bd980fdc7941b1a68b214d2a48334d00a9f23d39" +
+		"f5c6e8af3485dd20a66076237cc539bc6a304e70ea76e8eb0450cf5905fb54f8bcdbf405727574e52197cb34262e4292" +
+		"ac10fd0b7f59b42fe4b88736505254128a83b0750d81737978fe5944211e37fba3c78b8b6700409bc875b3f86a0cbdc8" +
+		"97f4e9d1cd58abe799f3a2f1a6a1dfa792e68e751393260a8a65ad3edf142238c676d099c889a9a13d9f42f40059439f" +
+		"8f636c0e3a4258a5b6ffe75e7ffee9c5554a374289b771790777296705c42ff8027ec16cc0299937545c046395d8a3e4" +
+		"a44da3f94c8325680727ae90de5d2910ff649cacd5eaee0d18ba3c5fd06d2b12790d454f2ebe6ccfa2e05970706cf98d" +
+		"61139e13046c5fecc8efab5f20ee0bd857a3f3ec9fd664f40a473bcd75264e84e89ad99619f93f93152c2552b821980f" +
+		"7e06f1971ede2cf19e1a4261cbff6442d68ccbff0ea865212375ca704b532ad397c2708a1b088587a6dcd78a1b10c346" +
+		"f0f19c1bf9fb7642b2834a51de286559c5a228ceb2fbc6262ec33d4b922b60c006c5e27a899d4c7856556a982335bd47" +
+		"1564b781c6e0b2ed0816366185b97c4255774121b353e95d78d31357d1d060b579969adb4e6bda310fc4cc303f3362d4" +
+		"402b0e6c9291ff12db007177edc16a770b3404610d008ace1a8b8cc6018e95438c3208b6f64b26718b48098cf3210429" +
+		"1d74a160fc8c4a0dd1b134ffb1c3f7f1e449a9a463bb5891f7f7695cfd4a0e97ffc5b767faba6b4ac9654bc7e52a7142" +
+		"00f6332521eefbe03e50ba2f8a5b1e3c3df049b176f5507b574791f907da76ef92bd9a37b2e3c9b697d23c15df78e052" +
+		"e427233f91a4920cd1e9bdbb15beb8bd353be7984254bebe757cff2cb0e8b370e4a662d429e61ce111418c6379f408be" +
+		"380643baa79302cb020f8bfdc81e5389c66960a55ed8f9cda7384661794920a10884bc3d6949eb18e20a7d4bea9a0af6" +
+		"389f19d61dee865b75be231eb522cd65739e159678f898c55a4d2cd5c15749d1c857bcf8f8cbc318d46811146ab1598c" +
+		"b33a619e912036879e6196c564b39ef1930c390e7d280dc077522183ce034df074c18611db3445144ab6fb8ecf86a4c0" +
+		"e940e4a70bf586d49cb9074f045f1b93cb2c6537f45b07bef556c2d16ad7c58c513ab8975f25d6a8b998714c6441c993" +
+		"da01d9d20102aae106b3e04c874b34461a294e477c4fb76823bb8f852ace096b1c1310939e62b2cac6605938e60efeb6" +
+		"a1fae50464cc7d707dc5a08b11a57a6ea71a7b290f0f69bafc35dccc4faed0c5e3518ac9f183dbc77a581e7dc3e2c540" +
+		"e1ba0e3d425a35161ccc912787608c7a02beee034d7901951a66a9fe8144839ed29fa574c5a3a8bc435f5dfcf71a4307" +
+		"0cef69d5ccef324ae614d991cb19818e43ae4a6094120364ef0065f377b01478ce074e93c71e1d346d3cff6497d73170" +
+		"718c329be317a53787091479ce4be61502e10b1063af58603c27e7bd9260b9a7ce1543cf5a708469c3bccb16e95df332" +
+		"84f6713c0358363989cca075440c57084990fd16c0ac11e24ac2bd03474198b14260b73f61ab67321803fec91a390108" +
+		"d46b8d0f2cc268ccf504ba54476fa974173ee0e2824682a544d8d31a75341f162ea49ea0e4884b8308421b2e90b49939" +
+		"fba195682f558fa91563c83fbbac7a4c2a314c04c21ea2124b55cb1e570269f06626f0c37effcd3bcf003edc327ce267" +
+		"38ae61745f98027eede4c4848fad2ddbf63d6ec7292d670c67f9fa1844494afa6d0364075f8749f93d123f8cf5cf3ef4" +
+		"f7bc6a7f919056bb0d0eeaed972abd3b95812e7bb9c209032e428f8f8f76d40c26b77c4f141d04877531df1db4241f71" +
+		"334adfa641c9b3484d8d1417ea5a768d7d54dfcf93f6e86ea2086f04052ebe30a3252e5e11710a7ae5bb42a63ab5669c" +
+		"ea281ada764dddb3850fc5d2b3f01e33501a9aa6f5d328a789571784f9f6dae72853826347e29543e11d68cefdab71ef" +
+		"5ee3c4a7b4824f77dc53bf977202ec454f61ec2eeccb3a58b5f1c7308e9bbf5fc946e1064c140974a1cebec6f55ce42e" +
+		"f192964d2191f534d5cf9795503c43b33cbd58736985a8697b622d5251f14ec456a65c1b6a810ad6d4172b09762a1ee0" +
+		"315f5dc6d9fc0f37c8d4bdfb36c7ecabe5ab4627bd906e9645c6f8765e4ff7b3aefa23922e9de417034124b58fcce8f6" +
+		"5746ef2971b3a660bb6389b964b8c138b755dac75e77201e02c396d22037d5f85b6eb967e15ee56244866f8110e8e854" +
+		"880e806db4953b41fa1b564fc83e7793127340513c3dc559b48466957a02293ae4834a06be3959e3deda7793ec9b9bd9" +
+		"6846a44bdc898bf46f597d67eee9479e244011425488ea1b321bb41a69836ca81451a620c32834ec0d6349d590585362" +
+		"a21575af0c3bc34d87832387f4d2531e93d6aec0268cfd07d06bfef2ed2a04e36bcedf06d91eae3cf6e02ce73bb70c21" +
+		"f4726db36e3d4d367f0d4aebea7d2757b0ca3e47d54808bf9eda8e32816d446e37ad8aa79857dd498409123485dd4bd4" +
+		"7e02e1688ccd90c087d8c5a6e29294f16b1e62e5ff2a927d2ec51c53520e70d3778f006e067078a17476535e9dcfa747" +
+		"6ea5c52a948a25b233dff2396012aa828df2bfae2fe6518500824cae6259ec692d0b103043d52a918b45605fdb3d1e0e" +
+		"4aa18e00953a2f4db6affb2d7f2b8e7aa16be136d50de6825b55d1794a98f70162e93801ceba64083363c999e2d7035f" +
+		"8e99ee4ab81626d0af3ce6840915fa3042b2ccae24e1b9da0cea35f563f9412cc407012ba592f8fd9c857631f3a505d7" +
+		"0a60c0cd8822e4fb05c3ada42bf9829f1de87f548084eee59c49368aeb9816564b6033b4b4b6cc3a67b679911be23768" +
+		"519212e5ff68bd97a740c1ec7375b7fbca478e532f099523e8946c366d5ec7f36529b07f573f002f94b8ed9b91c3bf6a" +
+		"e62efaec895208277dca4bcab47d0b4649b018e0b120f65b765dc4a54ec7064620777b47d629f06e19b40f1d97b594e4" +
+		"4caab4c7e6428560c7b0a56dfc1c19401ea6de78551d25aad1c4fd9f446cc3fb012c525c0579222b7b969d224295401c" +
+		"50662469ad3e07a5bd4354927d1d8d600d38a2b736e4111d79529c3d05a4fb9ff696ddd5bfc358011beb21b4db692db6" +
+		"95164eb27e986ba86c8d5f561135b289c867dcc7fb01d8090474106bd51ece726e0223ac9a74f06f6d5cdd27e5dfb282" +
+		"508e48a2b6fdffc935006de94eaf416281c66e418969ea08d28451cea1cf6b2f8b6a0e07fd3049f67521502f7e91fd5b" +
+		"e0649fa2fa94be6d7efdbc67d15bbe210e64abd6032fd6c4bb5a3cc2cbdb4f40a8b39c90bb428cb0de8673c81ef53fc5" +
+		"f622de7004a61b878bc2dac1e2d0cc174943adfc576d7a29f6803f84ee0808c568573b4a0e1551214160276f4ca2289f" +
+		"bb98f8995e7324c1b6dedc32e5067522c82fa431b6f1b11b58938854d2a694d169e1f17d532a0b4c906b5a1a84939c6d" +
+		"3cf958d5e1f360db333805affe84b3385a9aa88a5b2472bbcc40e5a89c0341f37928b653b6e9792ef9ec248adea56eb8" +
+		"ad380c758ffa540d70f485801981d89b6f91a84f76c7b24020456de1afe76bb3d05b28ecb4e4e2e56ee2dc8dcba0d308" +
+		"6c80d44701b61db7f8e4b239193706f5d95feac6ce5292a83226ebafe6490b08c37de7143a0f570d5d9b0ea9b85d8096" +
+		"4d5bb303d87fe5a6e28b138c52fe2d797ef558a5f27552b841f180c5e1833af896322493e966230748adef5d1788ebca" +
+		"60266362ab04f84395aa9d95865087229defbf40fabcf0c9e41b70eff01338eef844b21a4be6c271308d4eda444ab59c" +
+		"1c893eca419bc39719d87e615af9658be65d1f5027a6a31060f4b47a693ae2ab4f89f5de3ce51401d941d0344ea47cc3" +
+		"898221db01bfcb2da4592d5f7ab1d6612464e1718f8548cd75d21dc230eb6cef7ee7856eca364bafb37b8d972b7073c0" +
+		"dcea261b2da7fc27e918507617415abee5f36d3f8b57ca2dab0bb1d0c348339f58873acfff4c0e19b019b46da31dd1de" +
+		"8a5b3b4fddde24f5fc596f444733ed2393daf0454206af30b85b79283812601a3f2e3ae14f9efc1bc7e4a83875bf4a2f" +
+		"5d6bea7a6f647950390cf48dc98abc5a740b626dc1d61355088d394b7cc2c93da47dcc5f63894f1edfe56ee5524908d6" +
+		"5d74efa18fbc5937b925580c714b399c410f75f7fc5b9687e866617a8ba633a0b82c14344d7f992d62dd3fddc7645b2e" +
+		"63746ca04d5e76d48c2bdfecdfd604074ba01f5b81cea070a9e1e0c95daec809c8a000b411b47008fd0d11a6953a8155" +
+		"f9e08063b903969e5a2914c644f0b15c2d101716b93987ed9daf56c9ebba948b2121d75fdbd7ab4ed9a9c0feb7897387" +
+		"623621539f5d664c2827f60ece6036dd794a218dcc7fc43e56784585a6e9ae814e1f0748f9c05016e51310161c651894" +
+		"661dd679e004c56597040cbcf128ebd4d75e7b1f720e2fbabf495f10a81fd46d4c37a2c5294c989b3d15f02a6e69788c" +
+		"9e47a0b49e31efb9059bb30db1371337714a9ad129530f169ab1c8856b32897c280de97f7eb8435ad8f4b3e942636813" +
+		"e312894caaaae6b46e21a1e690ec04b32cc47fe935b98c29b7e9a40afae227179dce6f0676259692e2f0b513bbb54dc4" +
+		"56e4d114fab19461dea9a095f7399ecea048baa3e8cf2500ac6571926757a6b2b3a8055aaf59947fd53fa3e9977c8281" +
+		"0f66629e5681d0e786bc51aeefdafb60a7ac1b3339e25504b9734d622b817fe7ead1353e55992ded5a0a82d10ff1045c" +
+		"c4c0340c5c6b96c5206340718a78ccba71e200143b920522e6470a1221eeb9695ab735c0b1cb139f5d549431e695b5c3" +
+		"8aa79d5e19a691f02765f55056c8de862b5aa172f562ca54b608ca1a7df3dc0b9d75f0722100604f03d8810a6b2a5871" +
+		"f86349220c3ced2f9341379c7d145da852f7725fe9320b9b19b08d99f61bdb36d2fb2dbe2529a764f32923b5ae17141c" +
+		"a4fba37d7fdb2da7614bb577861bb76bffa1f7bc54a8e1febcb9a5b1c089cba29b29f3c02aed683ad3aea6e91a6a82d5" +
+		"4e29b00df2749a20e59fd85eceb20f98cf6eca982d3cff47469fa557b8a0c99ef5646b9923dbba1338fa62a3d1280a73" +
+		"c97a885195bdf52539e2e4de38a3d53d12756fa56064466bbe56465675a92e9d22f70a63d673031b48d9ef2a33e296e0" +
+		"2faa7836f0e1613853ebc661d1286069af7fe25950955260f212b83b0aaa1b405ec4ae268ee006e3e943b7be9f8dfe22" +
+		"8131b54ad49b71920cdee260ab3129625d187bb2697062d4c43e4f73d6c147a3559a5ff79bbe108c939521d76a0ee7b9" +
+		"8c32178229c5d6676876494a42e5d3edde82f966ff2c53165b085fcaeac1ce27c3e2513e6716e50876eda13ba59e7d66" +
+		"00c2081950ad81e136132f67a19d05529cb5d3e60df80bb2edbd7679792c1bb218a78e37a0eb200f8f2a6cfa0c7ece73" +
+		"943aff119e20d02505bcee2c779bf331cce323a251346559be158bb8d6c35e94c0557540d4238d45c6b6d2c8b88315fc" +
+		"f4e517f056a48fb727b932277a81f5ee2dd0c4b88ab70f8d79b1f33846bfac7689a9e77fd4b54966aa873e7a906fe246" +
+		"c5be1fe4e15be83adf48fee6fad25cc3179e72263dda2b00ce630168a36cc51c2dc7b33a49267cd902c6a95aab879ddb" +
+		"280e0e11529e5103ae9b982f80a5ec8a4d3eeb336da141d07ceb192ecb4a8ee40c3f4b192d0b5d61301327c4bb83fb9c" +
+		"1cf9d4a66ea216f207c270023177ef00773bae209fd03089015b63ad98d06e4cfa058f6e0ebd7765ec43d403b6ef656e" +
+		"da4db945acc42463d8b27a4061a9fc256ebccc4a2c8fb515e41272d82559989e45497e953f69320b98822264a2aa01f8" +
+		"3860338ee2c3ef6429110720ccf4d338e0d5571db04aa5f2031b858ac2b737fcb235a88ec4ed976b71966a0df8bc2c25" +
+		"9e7e1c94804a963169e68f0d76db3d9cc8c893b1ebda10461d55bffc57fda620bdadee9c1fc44e9de68bc3aa507299ea" +
+		"0fed5cafc2e3ce6eaa05df7b7118ed6145e3c9d26cca2f0f313939d27f736ce3473b5edfe97984c669393e71418f8b2c" +
+		"765a36feaaa4cd86a71d14bd51c45e7f6ac5021ec900bcbde4e0cb5d1579ba4c14c7e32d14bf7d5fef47beff9bd9c8ac" +
+		"b1a0327d13ac282b1edca33ce11151df127db4a998eb171e432f4fc976ba6a1adca943ebf5f4f241d693974facab77a7" +
+		"b91a48167bdc15c229970a86cf319c3c115917ac0caafa9fea90db893daebc1bb90eef68165dafbb8c2d5c5ac9c6deea" +
+		"912451ce740d7abc0e92f520642e96296f40800a07efeb41a7a5f32e226c3565661047170a545bbc167dd1733c63b33b" +
+		"192ba7f3dfa609fd35ab3770e3ba744f9ed2825e4e821311467d1a3d2c488ebc234f3738a7a560d498ef2a83c81773f0" +
+		"e95b75e35a1fd19ff7ddc15e997ea3ac19f40601552052374d830c32e3f523bdcb72d34e1d6cc0717def2f0f80de5dbf" +
+		"890f3a4b8a9a9247872b8ef94c432ab8666982e400262f4401d050c440033ddb2617a5c3f5a84b276f97f187edea324a" +
+		"8731a351f20c405c331de446e4cbe473b43d38093c6097f23a05c4b0aa37816a4c1c54e499f96f930838bf6925a36e6f" +
+		"99428b320ffff040500414383d3ea20da63b36107890adcf434ef19bb417f4881ff774166bff5298de40aab779f55342" +
+		"7411cda061330fa554ef35152dfd8f88ba023bffec4460e6375948d28ba01c2c405e6f7c64bda80e17d0ea1a5b4da192" +
+		"1f4564baf623fd2f4b1838b7eb0aac7b5dff2cc0678f7ab3e0496fb567bdd5c64094e15e57764d273fd659b16fe8899f" +
+		"f42d9de4d649d28a1a0a2c1719c0181330e5b7c1b8cc0649337aa47e658bfa3b1a346862a968703bf8c1bce1be6b013c" +
+		"77b126faf32ab7aec3a0cd389dfd8a561961b39c8f58cd781ddd6589e362216d1ba8a9dbfb987e4864d8d7eacce21408" +
+		"9e94772aedfa0f1419fc12f3f9e0b815e3d2e8d7bf0c50ecd44659836993e2bd032e1e8d38583872055af98f90d97752" +
+		"bc6ae10d3f426406374e0bd37c2e9fb8bb9f2b1dfd0d51f42d715ead5c81eb80cf9e390c68d07597d7a769b2bee6ad07" +
+		"ef62f6e465e8a44fb4970b5ba3f44143455c62d875ad7e04ed79db1482e104a6facc5e058a3ae23359ca44b2ba6f757c" +
+		"016b1fe3ac0d45ccd4f6d808186850f6526310d87b741252366494d1867c4ca0002f68c3833e133411e082c72586d5b5" +
+		"c2793c7caff7989e3a0f09fe0609979b4c57defe454299fc02541c6b8493a29064e61b9286bb54ba37924b280f9cb41e" +
+		"13b4af97e1bbd399120826f3df2c4c64d919003c2063921146e54200c0824b6bb6f565d787813acf9a7f53eed088468a" +
+		"a363856811fef59c4839d29cd335ae70a5cd09d646fa73baeb8b56d5f20e7ec1ae2261f052ab55d00b4ed945873fde97" +
+		"2378857ac13751ba1eb0a12237a318fea2bcd2cde3eee4a2da126f50951bf1f2d2689158c5dee8e6f4e1e70517123b2c" +
+		"23ac320d2a788d2a1d2441041fe75f35cdb33ce52c6eb306e0cc4bf895797110353db1388cd67a8f37d838a0892f94d6" +
+		"798a1f2aceb114087a2376c0f19bcbbb52bbd8d606ae1c6d47355d0cfaf0c547a8164b8208c1c5271b32fb437532a794" +
+		"4bc50888c115859d086f5ea0195facfbefaed4f76af325fd75ed4593e1be1bf688eac48521025c97412149900d1983ee" +
+		"b8d034c50430041a05629cc29582c86245ad7061a3481ddba6d908aca5ace2d0c2efa670fa7e69ec7ec8691136c6c17f" +
+		"dd9a7e67fb66da6abf3c898ae72b4c8e08002b77f4e2f28bdd3c8ab9d971bb2759e498e8d53b4fec87bec29ea39987b6" +
+		"be68edcd8d4f1249b980ef153c8fb71219035d94bd8f40db2a615db0f4c7e1191f2e3b1bcac94005a909bf3c3caee168" +
+		"3ca192d04761f05d7de72e6af1e985923689ebc1990d04e73eb80571c86a5242a383f847478c4cd46c9388019b223f7f" +
+		"a1cdc2298dcd7a9fc9680bcbadb18e9d51d32e4a641ab80adee8bb203f6710f39e8de2eb391b57def1d5f03d2fec10f6" +
+		"a1724a8217dcbc252b39dc7e10940ca7185190b1bc17e273091ec8ec4ff085f07e9acb1244a02ffc588c23da466c868d" +
+		"569e090798f87f60f77fb639895cb73c0e1ea8a19ac193c0ad57f8143fbf639bfbe3d3519ecae243ecb589d6d05a8365" +
+		"53d724106025af72657445b787d43b8ce714eb902faa191180ce28c1ac37af86bf611cf9ee116985abd797b12ad59473" +
+		"a7a704b8ec5b8b819c4038f5eb81ce63b7b0b99508c088bfc85e61c8eec31c2918c326061f6bd7299dc2001acb4fc334" +
+		"173a7925b1fe893be91d4a5b28059bb41c5af988a78f503d86a55bba707c2cca5a18d5ad579c712c817b7f450906f919" +
+		"559603eb2094b806ba802e3c4be916b4a6011a2c96403c69cc27c70d7eea802f29165fd393eb4a5dc8e3302259bfdaa5" +
+		"782aaf1647684168a8c29066cc5a7c9f3d238b9fc9427207a39867739ac35c0282dabf7a26b36f720cefd0464ad4cb8a" +
+		"22a3c510434db90ad4202f2fe874b7bacd8558fc29436c1a4f4547ca318ecffaa68d9a2b0b079f8a66a501243c54a898" +
+		"32b120657a42a0a889fb9ab7bb81038dd21796a447bdd6a7524ed7cedbd09e757c18dab033f206e02463741f2d1fa4f1" +
+		"4421285516ce0e980e89d68bf7fedc30a2e58401a572de1ac792d621b2d9467bfe42662c3b14f19af161ddf67876e94b" +
+		"86fcc5981af3a11caf957938588f124c3d5e988340f231efd699ead32f9a8219aa741bf434c661c65a8641508ffee584" +
+		"a5bee8346cf0183879a83931d45cf953577491a86aae8b346e8549cd264690cb85f0650bfa9a974f8d779b6b6b44903a" +
+		"7a4e09693c29c0415c9363c704951707ed5963193f46d3c0e553dbadf95d933469b71135c6c89874af36d7540154ddb8" +
+		"7ef99339a79752a6314881cb26a86f0ba88cc87b1355a30f4437d3c4b1713d30b94d7395bdd9b1423f4daf9681345cb4" +
+		"7c4bd023357996e1bc707f6eb2cbbafc79f8488e2d2fe575712fb5a0d05c5994b09bb133626b61d2d87f0b128be8bcfd" +
+		"141036c8e506678f3d569e4aa84a31a67585b27c80191e8201c430965f0c8c64f370402358ed3011e55bb44d18705fa6" +
+		"179e7b0f970958445bb9cf2b58149a42321904ba6a2863a2e6803bfd19c97c36269704a36abb09688d4532d3d6dbe7bf" +
+		"72b319025a655edee7e138870c1c13a58076136f79d5b560e13e4354ca36870674d8cf91c90756a0ff351636a40644a2" +
+		"f1968a5da911ad0ef9628523cffc2d0341741d005a12523f77d23cdd5600889d2e3a2a8af9e9d903c89ed0e32c1fe373" +
+		"b905d32d7b1c9751da2661b2cfd4766af3ab72992fd661b8d4c88d05e3d1c8e23bce0a0fef7042842f1abefd0efce9bc" +
+		"7fb697372d8d21515864d29571026b3f439bd152de81792fae656174a78194913fd04230d68eb0318f589ce4d939b14d" +
+		"f29b0981e3ace16fbbd6b7525342cffbb5448b20dda3405796bf77a7db56fcd34dea48416b3d1630764b0127ecf7e4a6" +
+		"2b406c053403c1eb84062e490a141894eafafaf53ce94037aa9647f1d8d9ad04a3844b31b995633aa143ef91373f82e2" +
+		"7fb00e60c4555682bc79e1556e3c4c74c4dfeb0254969e5b907964df62654a149ed4224d5f1796928eabd6ea26824101" +
+		"b8d54b43b8db0e3fd697cac95e78af7b9e5c746ff11270113f91a7278b72d78347899b77a390dcec42e7e3eb76a2972a" +
+		"79e944c3eb2c1de7f4e3cff239326f43a76169268fd5723ca9054dbbeda331102794ff2fe9492602c3d3ea42cf1b6582" +
+		"27c125eb1daa797f14518dd8e3e9534f55dff494e169c64e289cab60db8be0f8ab6824ab36cc82876afb404f5fc4a98b" +
+		"f9d274cbd32ed9130922dc78a26c50eaf2327db277c451d0d96a74c0f971fa6dd247f88d47efce71a6862a3de91a8cd0" +
+		"96047704c89b9c9bbe24b7de84e37d85768386d112881897ac80379f330b27d673feff2581a76640d93606c69c8763f2" +
+		"8bda19456f1ed65fcff29be38cba7079773d5fbd8eece2e155886ed7a8fc70bf1dd4c4a6997ab187e05c4ed12c79e816" +
+		"4a741fecbf70dcf2d5934e41682759c9ee7dbfefa258abda4b65027448ce7955ea0a5d72c9e1145065a03fd5c14f419c" +
+		"78fdb6578dde7222488092dcc8104085e505b8a0de371d86ba1804bbc5a43ed28e7695ab8715b1144fe5099bb1d231ed" +
+		"69560f1c4960d1f176e860d7b965800985c52cfd8a653f26b84adc391ee5385e41d403d9d5611ffcd93033e88b55d6d4" +
+		"95ae2f4e322dfec461d32bdf1154c850a0c5f1febc114a5447af193a341d5770860672c36bda529c506be621b0fd2c06" +
+		"75b211924ce2e27e25f76d281e014f041dd471217daa0aa5c422bc1aa4c2b70b8fa4a0fff4f7b6938bb080b1afb57c31" +
+		"ade1dae33849aa4e2eaba4d59545d6ba87a7dcf748d177f9af148a4bf450ef83405fdf4151efe2c21441aa792a06371d" +
+		"017024172a47d797cfe86fd484e18edd11cf4f11d6519f8824b0b183039beba1814f6915f6507a71c31b6152c9681ae0" +
+		"b6773c1135344b9269a5e52c05522a1a09df03e99c250061afd79794a5f925d82fd1a6608b2876e248d9391e3edddee7" +
+		"287b679591f833b124dd4fa2f17123b4b33455c2801bef3eb07c0ac644fa8ae747aa684322aa9d2fc74e00b56ca0ccf8" +
+		"fea773ad79cecf6bc6ae204719af32ec2b424026a4cc285d73c6cf35f9bc08f4b5eb16d103aec47ee56e3d1203e82554" +
+		"220c76ff22d4e6702214964d3f49c3202d1786d8d8a6e1f8562a367bcf9386b005c33d6e955e6c5111fdb8412040b612" +
+		"de86632568d2844f6c68a240f2987a2d7373dd2813927cd2d36e1a2ee58df4b084a4ed7bc47077a65f5ce1a552682449" +
+		"fa0c154306ad987cad4b655924c5dc7cfe1f6df8e92200556dce1187968a055a8af3f7e4a514fefe7af82ef936e5bae8" +
+		"3f3c69e0510534599423e290fd6870309a647a2403a00d04075440d486a2549d5091d8a90e638ddb4f3d1cc2b0375c6b" +
+		"4a376595319298077c55f2190a68f56a5cef06838139ef219aa152bc594dfb53284506b1478ec1b7cb2826d7e4cc7d87" +
+		"fc3c7a52553399ab09405658dbda1215d2ce3f3b204e1916d01b1b5b280b9471cbe648667229b2fe0329f4c3d3f315e1" +
+		"2470c2670b4029dacc25c233971ba7ece26b957495627e64e94dda58d72f5caafed5d4e56f96165e07b90ebb899fbf16" +
+		"1fb0bd50eb700fe906c1a7a223ec4ebebadfd8df4c0e0f506d550cbb6f5b6199bee8a824d4d9894887c74fce11c3b6dc" +
+		"adbb25c83939315c1d24d1c3e84d565630f0c0e74bb8d4f21f362411430d341af1591ceb70270c9980b6f28efc510d19" +
+		"7546e179e16387c161528b3a5ee48c0e790441b7cc979deb218373c421880b70f8a2916e6e936c00ed1a83680509b5e4" +
+		"484a566cdcc31b24c0b3508ea7016889fac73ea10ef1ae4517f6ea127eb1929f93ee48f4d983eb5d91416120defab958" +
+		"0de832eab08e918694bca8ec528c2f72c643fb708ff8bbf3dfcdc2be07f505127324712d829769bef88aab75e3a6460d" +
+		"35a369fa5f85c04f91753954cc4e67816415b4df7074da2f5684289996797b985bcf9a7cb12cd3d51c5d6ec45cab6921" +
+		"2e09e7d70052078c0f10da0619c83b20f25ebbe20e73a276692e35fd8f93ec2afc7937dcafcc372bc610d2bba7c62178" +
+		"230842fbc55b6995ba5cdcbf7b467772861bb0506c4e512e2e44cd29cd4cc602b0fb52a94b9c0d65edcb242fcfce5858" +
+		"cd1a1cd6324013c330da81b40c411bc27afe7dfdc0abd81f6017b30768688aacf31e45f327b6a489353664fbfd30c11f" +
+		"989cdd91c752866b4c69be306389c2008d4e48ccdb60aabc9ea8480ac5fda8aaec0aa6e87d555e7f8155634457e1f522" +
+		"6c71a0df4331ea77a87f4269f386dbd28d5e5e1e5d4e902dd25f13ef1d85bc6408d3365e4124952e1357e6054feb954e" +
+		"a692b61abcc6da0960c946e5cec70937a54b809568d9c256b78868769e4a4eff31464fd32ad3bafb35ccb43e94c2575a" +
+		"5e780880362abd32267d87f727b1a03b0afa0e5ea83f369a653690def07834df8920c472dffa5fd7108fbd897fda24c2" +
+		"46da27cfd02d3ffb81fac90a4d548bb9ee02b2fad95cd0aaf8cebe40916fb945518195d7161fbb1ad3aff77da4b55f2b" +
+		"425b4637a520ce3047d985668fc97905371f20441ac5a4d8c42ad2b12c2db60f0b56aaf367a323fb6a6aa9107acc2385" +
+		"2a9d0ab2a1753b46fe42ab14db205ff061446a8233c5bfda53696b424f875e18c48d2fe3af8559247a824698e3f9679c" +
+		"0473edf829c8fef1131807da1e408aa04157d04ad935e7eab6ad983cee226dc902e325bf59a794e0c1971d4e6b1e7328" +
+		"10cf66be1cebd2a41d919e5aa3e3cfcaaaaad836d81cdcb697df3b2813eff810179f78e0e4f59c98c0c1ec54c458714f" +
+		"403f070eb9d944d98f60de6d462ff1d66b9c4f6945b2ecf54aa9781f6142c7bf287f8963fa8b8ef025db843e6ac566f1" +
+		"55381e63714df6ee05014da7094a4b91e6e3953f81cd2782c33ef067c587301d691dd64a08685352f744c65a481c113e" +
+		"01d7dce0d94a4bfa9431afd55ce092e65be11453fa1bbde4b7f982f748e904b32c23edde4fe2dbbddea7eb6d07a0b149" +
+		"88ff94e7756934e1251dbc5dd34e84f4dbf1d78421e42b0b2185571338194b8152096fde065c5d1828ad80bb48a4a84b" +
+		"41ffe0e0fb265255e870b377d768232d6e8230be5f482bd8fb308308b085d639203c9d8dca89bf3eecfb5bbf747a84ab" +
+		"42dc95b2f89aa1d91bc24759fb4e2059966e4ec22f26d72a2d4d29982b478c85a995418d86cee1bbfb151070568e76a9" +
+		"15ee525aad5fffee28e0e566cb192f7aaab3aa0e60081689f736d71db2eba793cd898068351aad41f16c2d40ca27aaa8" +
+		"e0b4b86445a0e4756811ebd151dbc71666c252651a7159b2fc94be00392624bf8c89466469f3402d9384b2b89dcf5971" +
+		"c64513348c4ceef922e05a75c8d15a31532ae3fe25f6c955ac6af3514abc20f6aa45dc5de5e6da78499846999cd1852c" +
+		"97168c40ac4d04ce22a9a65ce55dd9378b5b442db47dd26e9259e8582522e09588a0baf75b43493521232df4e50132a0" +
+		"c9093e6f650235b09bb30f570953be6c2ca3ade7707fd18f0ae74e346bd05b587e685196df4507cdebac91d125fc5eb6" +
+		"807f913fb5a279d0bbcfc868a3472d0f24f49ae5ea649fb4ee492ee3cc53959d65f9b75932799004369ac097ed217385" +
+		"7e90f0494f5f593c6c6e2188ab46ff89d3741211737fca01d1dbd436e479fc6e005d3ba92d4bb6f88f6127d01cdd40c0" +
+		"55d2dbc49ae19bfe0284c815a6a3b55ae8d8af7948d4f3dba216b794e6bd82250d2aa4024137d1828e23b1b5c4784acf" +
+		"453f016dc27e7731bd083c1f76e1e729d6d111b2dc81d6a0d5bd103ab26f5e5ef7d565cc88f8e340a4866801bf627e54" +
+		"d4ddf8fffd2eebf265a039b9eafb54215c84acfcc67d27bf9623aa1e171f9812aa0ebf670cb05fe004ade86f991f172a" +
+		"9853155d27e17d3c8414ea35bb9198dc6fbe00eb6be54f365144180a3ba256af151e2bd17b11e6b60fe351f8421df813" +
+		"02792b395db2537ba334285aab46b5d8431e9eab9cf1a455896f9879597f7d8b1b9e683cfe5df0f0d9fd4ec4f9cc3656" +
+		"cdbae3c5f1c8483ac384cc99b196fa8e8198d63804b6b8820cde43258aa58362df4758972536e099a991aa093c18142c" +
+		"fe4f0e94d6100cae0cb54144bf57e3cf4c6618f12637969a8526848e9068cd4f8b7cfb41205b51b3a1b96c7aa03467f6" +
+		"e0cde4e7c53df4c1bee57d73425124f96b8df3487e8a043437825bad5a416aaa3c35bc991be01be0ca8ba2ccef48f815" +
+		"94f53d33be671c5a6e423e504dbfe01518feb8fd75e028e8927d24bdf3569d45785fd7900193dedef8f78101bda7f59b" +
+		"c5b4e55910a7496f83563498d402d339d20c4e751d1833841e955d7f566fad74503a6cba9fb5a8704f257499731989e2" +
+		"77bc19cf1672b73c69f03a0f04673030f67fe2b5a7378a2bdffb78970c1b0634ae2c255055185109448ec6b0755c8042" +
+		"6debaad7e3ec19031bcc3d0bf8832fc6fa42dcacc8cf1d819466705de174099a2742d52a0748555cf48b09f7f49c91fd" +
+		"13362f4359ebfce2d939fe18a7fe3c1f7c9003e9e24356a07fe9fd3d284c91c47b2dfd9fb3fcc4e68efc0e08b93983ef" +
+		"075f92db2ace8310cb350701140b7aac2e57326977d667243d3e7bf9b694fee6b3cfc5e2965d32a00309dde7ffc9f247" +
+		"d3d61d6c42f8628526ced32f21bef1c320613ad028430ac67a08cbdecd4c6e6ee2c4efa4dc86bcfc1f16b29afef7b1b1" +
+		"214e5309a868c1189bab2593a494e0e39a0ff4febb4a6dabfc18065e629f91471e8b6835ff653cf3d8a68bc1b3ae5451" +
+		"6bd7da8028c0973d0077c98a285dedae47312a5d7098fa86c587fb271207da196fc4c067be616a8adaef2fbc5fd76524" +
+		"fe7485c0a3c4ed8683f5162c63b48674b32ffec17abff462477518aea556cfcfccf18a75373d80f0ca3f7f3dcc2e9c42" +
+		"f9d8bcb0171db69e06731e2ee47c2f7775553f4f8c34439f536b01b7afc83ba09ce837fda0f4da1d9223665f5b27f669" +
+		"c5e31ba7f0dce2e957e6ccccadc657ce7bbb3fe26b2888a69283a4296e7b3247cb6ff256c9a98855aedfd0ddb9ffa7db" +
+		"398bb14748a8afd701a5f412c29fe2d114e06f8940e03fcdf4e2998d1e323d29303048769114ae2d9f52d996328f0096" +
+		"562f7fbafed11392c51f448943802b015676acf2e48285c93bda40a10c4e468eb149c4af6bfc039134ad6165ae2b4778" +
+		"9f1600eb91b6fa740707ada3d07289ec6ffcd0d565e3ff0cdd0c5e64851bd62cd84cb85fec15c7b28b007ee5f884093b" +
+		"824258be785d3da6dd609c6e4e014c1a613dc91eac50327c2c4229d0c22151786fc5fd89b0ac72555e3631850680242f" +
+		"5c49967eefaa718ec0d831e9c9d4e831c87335c8961ecabd281c1f14b93496e65b421d82b63d90421f357dd0726fda72" +
+		"f3f90ef5d8a80c8d1c6df4d917a2a8070e6d09d1d566a44fa49250e4eb5722e88089d51d37de2ecdce93e591a2c0665c" +
+		"b574215ea896428da91bf4bdf625b1edba04f2342bdb51cb7a55f0f0e93396a35c02c2e6dd2b8e56da73b0e49b4170e9" +
+		"cd8fc0bfcd2b5bbf34fc5f5ee749c6914a119b4253b2dc82a4963ab1de9731ce362d27a7bcdf491dd397a342ee3b0ba2" +
+		"6b39bd715cab8e224800f6baacb330a72d7a6301318701df7675097f37e1cb2abf7412f5e30b459c2928ee0fe751c37d" +
+		"aa8ef82a2a678b764c886609f3f15a32ab70a78db07ea455c4111e9d79795f01dc3e636c1390f8a6b3a77afc30ad610c" +
+		"a2c15baa522c8ebb6dbff2df7fc15fb3fc08ec1e3ce1cd581f289b4396aca3ae0aa1b4b73668ddd98ab3f1a55da51f44" +
+		"be3928df27871a78e4bcbacc8df3d061e3f563d02cc67d9ad5cf24a04dbdfb2f7114424aa5929ce1e2ce74d52125e7eb" +
+		"c8ad0c722138198db1321deb5964b04167568b64448ac4c73d4fd5b97e504ae974ef545e7906fe24061fa49bd0c3d2bf" +
+		"eb0fa0e07970353b345d6ac6792264f323cb9960759bf30ebb7c513b6cd9cad585c7b6df69b839dc65e1902a5e9356f9" +
+		"2962847af48fb9df9a8a1adfca5b13c35215d21f8d5254c08c1031b6d049dabca63c975cc0da908a193fca73681b75bf" +
+		"59df320ebf7f05bd7355d6b8c91a62436ef7534c9204547390cfcbad4513fa653e4a98273b5fb4c0f7a7be4d4f70ca6f" +
+		"292f4d8c731ef33918ee01503d211ba37ee9aa06c82df92878755add8b71db9562351b0bbc299e09a3616376a89a0c20" +
+		"ff8edb7c89dd5a3a9d1c43a207d5a6c84e388fa53e7d29645a1c21802e3dca5937f5807bde595b5ac649c249d7de7853" +
+		"38e99e07cf591acd15d8423b3bccf46639b05e3e49be002627dcfbf284c2dbf2197022069aaaf8f52d10bd94ba15d082" +
+		"33ce5400e64333555f5326da20de3278c9d0b5d5954159758c3ca27fbcfedea4eaec2e1104a07a3a37ee75a32147d9b1" +
+		"16f93297c3cb263b5a12b8948b3fcfcddeadd1bfc6c99691778ac964cd7ba0c6c703fb43621e0b91ff3e9c2a1ccd2bcb" +
+		"8b1f6a355c6c4d01dfb9402b012c74a3be3fc75adf6d460aab87341b4775c1d3847680bc45c51c18517f4d48961a147f" +
+		"1bd0b32e1f6aab8de02b86529368e1fce88c581dc4e4aac4f05c1319fdd9e9bd4a61138247360a8d913280d8fc6453bf" +
+		"51410d50dce12959ba6826bb75be00a1dc591323a3c643fbed47be2a8b77893bcf519de67ef3a359b9dba72deb309edd" +
+		"b5c900fa7016870513e51d9aa28b3ae31c72b74514f1e349d7f269c14ce12e21a097f520de9d00d784e62526a3023ead" +
+		"8fdcbb4c332440e53facd8b97e46eb51973be3db2ac81e90b8728f8cd2e382cf508e27ce936374d501bafbbdb66c5512" +
+		"c073533bcfe3cc924df7d6917d1369aa6a8ef6f50c552dc632f68455899325f938c369da46e515d4bc63cbf384b49543" +
+		"89a015927d4c1e5838d8987b73bb61562421bcad0085ff1a75959cbe13672657aa2cbc5996e9dc8a7d4af80c38570bd9" +
+		"90277250d4d99a96447c7ba9e8b0f2e0edc9d5bb0fd67f5a3145b51eb71befea675ec84fd8f8689facd7096bbc0563d5" +
+		"cb1a824c7325d5d818119bca7b30afb83f1307fea84f03df59fd31544447302966584ebffc82273ee8816598d7750452" +
+		"dcc1ecf5919d50a51bb02f68276b454364436029906bdb70066a8bde04fb1c3386b86079c8f0625f5729220533310979" +
+		"eac05ff30d73f1711438fa617eaff7ec65eec4946862b47ff099256cf6ce68e1acfdc9790b25c53d8d4acc077e4e8b00" +
+		"cbfa6fd465c0758ce67a5c950eed568d01a7a6ae1a1d2316b859aabde1ced86b74ffba10de622aa63e1ef7cef6fa614a" +
+		"b3057b034bc5208fdbd7c024b612a738c6ca165ea09ed4c4ae499c1318232e7d57518168a2dac5f79acdb29c1030ced0" +
+		"869f2af07e51576c72c255b95b95325b1ca21f2eb4ff5a3961d41f0ef2f5ce1c15bd5fb91a15970cbc72a809517296df" +
+		"b4755a8fe704abec29b5f1aa98e5a6a35a6f4edfcf699b920ca22e104ed6b57fe32dd403a12e754c0cc32e481704a730" +
+		"8525cf6bd929d2fe30e1fe21bcff6b8757d080e4a8ade1f3cbc40f4eabfe7eafa4cf632a5fc6fd32650d549b59ac13eb" +
+		"f1085b53d2e6c399ee99496211cc6af2dffcd049ca16f7c2fd3fb43dec3724040f5f964ea8cf4653a078d051a06b0a5b" +
+		"67de25e432997f1e25a61548c3d8a636c4e4f33e76397d898aa9f76f1946c5569cc2b0be4b31346cdb617c0f2a6af2ab" +
+		"c44d3485219b784e19187c3931a94a60eca93c39a674a0424336478fcb5d540d9c27375018a3f6bcb80950c4bf379a96" +
+		"92f86cefd29ed5399cf947aebea111f431b95e465cbe04d6bfef6b03eef03ef33703daf045589f5dad17f879c429b9ef" +
+		"82c52549a83d7ff2906791859c31e738dc74b8e9056e0a5f78aa4b260a1f6001fb24e7f5de2ec245b7e8f3d4ee2611f8" +
+		"14c67e40fc52c5808d7cb7681335ff3889cf454c6844f13782e965e83d15e3214ce5c176bf9a1350f88602e8fc2b1b03" +
+		"844665ca39910a423daad16ffdf082b8f45296121b3c3effa8cac977ca144e11482522a7b020a868df9e15f1c0ef7942" +
+		"4c343e641f86f1425c35efd3413a0730670ae5be526154bf3f29b6619975eb8b50068f3ef22627379c8385d6a8fcd868" +
+		"3b1d697c91e2792ea99fab31ce3bef18c233e8c6245d31643262c77df01d60bc79b1eb5222345a29aa55140d28b6d164" +
+		"f62c521a8b81d243b29b449c2b7a6094be72e3fbb85645323efb7767a5183093dcb588ec6cbf381b07fb8e4887ce3f21" +
+		"4881966e040e2a034bddae970f65ae24a631bc8c288415b77a4be6a54f3e7dc26c1b93b5017c302dc4371dcd47c8b39f" +
+		"bc95df2e1524c49f1d94fc1cf916572965e6d88eb247674651b8f071414337cd7ae767aa6b9d690d51dd83907f52286e" +
+		"cc72c53b5481a0bd7d28000ee50d659b0444630804dfe229f249b0857758018212b7a6d6ca2af49951fdd7fbb2a5f568" +
+		"b686b067da00cac7f9566f29ef188a7b6f198675373991db7416b30e108d84d0f0a28f5a2d940db5a5632759482c0656" +
+		"ed31dcc87734a20a00cf7189626adb8c11573886f29e42c64daab54d2a210f04b28bd45597a24f9c3b5380ff68710e88" +
+		"9fa3ea138f9606e52bb5be9b4abc657e29d2bfcf6112b33be3f3e3434bf7e3f89a2b1a17a343e8da9ecd81ef70767fe2" +
+		"471c22fe01ff880f46f0ce55ec46b7ae1f40188b06897f2bcdcb12b7cfc187cd167d22891e3babe15f1f47e5b64a207f" +
+		"ecff51f48ce12184142fdee50bc02b1cca5b776ac33b126252ef649968ea0bc7ebfc92eff639588a2f9db937602a45be" +
+		"c9c35c6838bd8ab998337c67b6f10fc6f9e9ec7d34813e3b019c9904d4b38408e17eb257d63a3e8f9e6778b8cf7ebda4" +
+		"bd1f99dece86052ab6298ef16bb7fea1564ff84ab3446146b5f6fa9f9686e6ac2853be4916a1f405b767ede05cf1bae4" +
+		"fa7dba82471a240fa3299c8f6299b5316159d29cbdd80f5c1f08c3733e64f4b9996c5a1f56ab386ecf35f8863a94461a" +
+		"f18550b67197559e88d21a04cd03dfd65f6e6abb645e946179f60cd8442390fad6369df9ccc1faf55b78d61232c3c5ae" +
+		"3ac68843874829f8dd9132708474ab762ee9f15a34edfb99db359ba045641b12c63ae3552051842062dfdf8269a50b26" +
+		"465c220398330b35126c6840db3cb024b6ee09b94f2be29b0267beda8a03ba77ec6cd7e6bd7467923a333aace2f1bb99" +
+		"3d57472318fd89b176051571e28cd4728281917d78e9b8898dfd64ba2564103fa2b8aa7d94692ba5783caf64adf4ebdb" +
+		"73b5b3cd22f087ebfe6d835bff87aa4040d968aecd330830d864fd879d9a1bfde35a08a63b1de7c72b5b6a0f4d289430" +
+		"88b7d5fa59d0f92dc9af7b7b92303e3023284ef3f7b4ba100f60a975fc5837a3d3c54e82fb53124caa2e997db1d86c8b" +
+		"96e91ca3850230c2250dda7bb5cc6d40ded6b8a3ba6ea019625dbd4e8c022ae25ca402e5085b394e4bd52150cdc0bca4" +
+		"a01dbb34e2321fd1775830c13b36c6f3a768245345567bbe614edbb807a7c7978404a59d74caa38ab56a6841f696e95d" +
+		"0e1b5250fa3829a4017c3e91d52eae0f97536e37ae7c03b362a5c5fa890d1e0168efee5d5cb3a5274d5d09c773ab4620" +
+		"fbede92c56e4bcab866eabff3a5cd6ac8c892044e3d7a8240a266f8821ec4f1a89e8b6540ce4bf5275f0e1529a5e54fa" +
+		"1ecd46fac9faf875644749d6a0dbcaf9dbe6eee2748db29c2c60abbdacb4def79a10c421b2d78564e529b8479206f7f0" +
+		"984d87f17a52f6689ec88236b4afee928ff2a81ac80c4159c528f54279685ead234d158bf7d5af16ba4a94931b6e338a" +
+		"55ce4f2906212c32121036166ccf56f8706c66e377e93b43a1406a64fe9e7bb1c159090e4d4c1be951f9a3d51da5b0c6" +
+		"d1da171309d4016e638959d2d29bb768545f556c4932abc172fb9cbbfd61c50a3c044767033d51c845a924c5278f2e91" +
+		"e66e02182941cdee06e9439441fe06883fe977c6d9585353594c608e4ab5a319a65bc3045f15abe687cddd149898a55b" +
+		"209a580e5d9c26eb6c4a2c0b3259448d5a18839300ce4239a113a9accbeb15b94646ec6c7452e698555e758c2b5826c9" +
+		"2c3bafbafc933a096e62ba3a9e1ecc01e095884f7dca630913f6a2bf236664f98c24e316039db59eb842baa06bc7c9cf" +
+		"4c1fa0d68ca883f1d00b45d38ad1254bf28b4b42a66951f9a211da364c8136b63fb87594725a32e5d4c61f2a437866e6" +
+		"ad47a67484ee73c361f7725390d8eab9c74edfc98ab4423e6ec1b15f39a403e31e7e482eb036aee543faee34dbc2423f" +
+		"8686d202237f12fa33795c9d0bbeaebac5059af6d686b95489877af1df4ba77450883764308c213dbfbe3bd438735a73" +
+		"d1d55874e2f3e0a1dcae84fcefaf27646487ce3c210f9f158fe4943bf5a4989459588af1dcfa95027db81ea1de2df591" +
+		"8eeb18329315dc458b84098a59c852228827f4185146c24de734c8e6d0f4e2682a1b64a03f93c22f637b6df58d376753" +
+		"7fe7bb74756b5e2ab3087f53237cd475eb05e2abcadc3378aaf4e979241c93c75762b5783da6bc4f601d9877886dd13e" +
+		"bf51a89463763af467728d2aca3bfb1bd0329b2590ec338fb895a2a8dfebeb5d10382ade1852fc58572697b90675c094" +
+		"c349a87794548b5b55e62a2646d9526c277e42a2928c82d78605fa4737f5b8e1fcb1ee993c5a6a7dc8c9ed370e7822a6" +
+		"0475cc5c170b993de9f84e6130e3c778e4f6252b79c14c58d28fc7429825c6377fe0b71db1df8655d5fab877c04feb42" +
+		"fcd918abf0e3c541d709615d031faba83410f6098705e9fac851ce4bcddcbf469c1afa3d6f9c2f78b6f4f823bad7a61d" +
+		"00bd64067a9fa4462f00085fed1a00f04d1e39403e9b98bce19091eb52cdd88539b9c9eb4e31c71ebe57432ad97679d8" +
+		"a018780c873c117477ee49f40aadb8f87676bafab1c309d802eee3815d36d00a70de9a43023e3cfb74b36d0640ab576b" +
+		"74b8c0076f797291ac023cb0bf6b0392e21912444e19983da98baa0be2ae7199505a0d95c81481acc881e6243682b612" +
+		"ad09e224fbb9ee608a587d100398716a4be4b5c3c2ee122884d4a528bbd602360c09900fc4acaa422d4476f259c32044" +
+		"e56b6a24a34491e4cb58abef580e08e3c124fa8e4caeebdcea0b84e477e6439f725382bfa8ef10ea39489ee63a742666" +
+		"f85cf32089648b21b6a34f1280052ff0a472e4be6f662675d13689c8df8ae66a2ed53e1188702ff8da54077a044134f8" +
+		"dbc367879f1604770c78d74fd287b575f2219ab0ee6915e1f51b0cc78e7f91728e72d1421cb2d11f4244e4a0789a153b" +
+		"85b0a6fb492cf7ebf53f4ccc3192617165428bfe882d1bc56baca54adafbe901be369ab8e26073db9093d801f3a275c1" +
+		"8fd745f800405d78dd964e8f5c1d98bd7a44143ac986b706f8ee96f0645ab21e4036816c9a69b6a54cdfe67c14241d11" +
+		"45fcf15082f69c664b362cab47fcb01717dc1abf982ece5d43d7c69c8123e7848c24c4e68225f7c5f68a555edeca0c53" +
+		"1867732ee0ab5e6ae0d9b693938a03483fe52aa5bfd360412fedffc38dcc196a9c91e1999bc08bea884298b3e2d52ceb" +
+		"5b6accbe9de53700ffdc698a1e2ee70e32b461bdb4e81a38e051712d708646b42bc9312b7421f55333a1cd9b14f019fb" +
+		"ce619694878176280ac4f61ddc43e085e9b0ff329af98f29ff9be544b4db9252e3d317adc8977a0e61baec100074fa87" +
+		"41079650b7653029538f56c60aacd1300d751e9040ff72fbbbb8a59ef677e1c8f46da8a8fbddcea960bbb2ee34a6505d" +
+		"160ca7c0c0839b1531b40707568b557903145e68ae496abf7c64cc34b8a6cafbb388234fbe3cdb8d6d0ab2deb5835838" +
+		"b7428a72fb8a38d32b64f7f93ee2a64f8f442f932296d4fc3ce8f52aca7e9a22b0df4a0f479d780538682dca33387546" +
+		"424e1aea9421e57d3ef150addb58f4f77ddf55b06178f5d07ee983ace6fb13f1a54af24bbfc06e4df4e4e97d9f4a4c3a" +
+		"20dc8f3e8883d46f48fe2f257d5bc0fc20ccf4644b157e8118acf5fa6ec48a3b12ddecab3af0ad83669c91261476c127" +
+		"f8f0afdf9e20da60e81b7d7a7cf89722910fca570d4431a18a1dc82b6f3d555fbf3f91fd007e8aa2d2ccaedc7155f979" +
+		"0dddaef4533efdab7642d04573631dfd393f1f6f844264386b2adc8c4eb030df066dfdfbca9dc0f5b7e032fb9cf691e5" +
+		"6fa65e07bfa98c2c538b47d968e9418cfff95000b7ed0ca2daa7db012f0603fab78261ef57c95baad79f92b004415e78" +
+		"61d9c9a602cf5436f265aa656f26c588db65aaddfc1ce1c4c374007ddd7e9278cb30e3b8fec5190670667fbf42efcd64" +
+		"388139f3df6f3a95cefd5a27194bb5dd86d2b102a5623102df2aee8eb441a1bd296dd8293d7ffcea8e1c184cc04f4281" +
+		"2733e576182582c2c88460eacc15a10ce712bb8d8b4bbe4a4e3a617bdb5394872a6b1807cd550d76a217e607de0b93cc" +
+		"f6e6b76cf84291890554e08680b168a13ad135e14167dc43e8a8cdecf83d36e247e926a40f1e832b3305939546185268" +
+		"555367e0cd91418bec8360aca327867b920b13cbf1cde2afad4c1702b65c86ba6c9be24d3a7badda7fb88a9ac1efd1a8" +
+		"8b5e195ed4eaf85cfb0a5841cd872dccdac4d634cfa5c6f6eef7961222a791dde565aa8ca66abe325cc695d9b97ef694" +
+		"e059182e718ddf9581d8bc680e237c035c27480e1400769c8aa0913130c4ebcabc415d5855c684cd96fd851951f859f6" +
+		"468215f60496fdaee7c6c54c31b053f397e6cf8f54cfe175aa06d5d8da31b6e1c06c0d5a51cb2d0b7a690e6719a6f8e9" +
+		"4620cab5c45984321da95a88dec6cbe181c172999f1d8ccb0b12ceb58ea7b97eace991c09fa22b5d8c801ae321f1de62" +
+		"afd60fa75a8d7178f3eab03bccb8cba7923ed057c4505bdf79b1494950a653a98a7862f474fad115fd6dac7d80f1b5a6" +
+		"f32b29e8047a9c99b410c97227203ec65ccb3ca97d0ac50e62035218cde6dd3e41bef4b344e1199e3e730bdb2f9dbe51" +
+		"a00f5766f0243fcdbe04f336624278749d7bf12141c2fb53a9a06f816a3999f6ff178443921abd0058bcd45e83ba049e" +
+		"6935ae3f4991007f861fe43f2b1029074e2c6af61d0af7b92285e27ec71e5b2baff8a7e1aef37951969d15c916fba17f" +
+		"e73e74eb8ef6decc1e655986ed1978cd1337a79af62fa485b3d844582ae9221907785785a20639b53e29fa2d41b43575" +
+		"f8216a0f58abf510c5e7c9ffd4590bb190a892187b620fd8f560d3ae6005c3a95425480aeedebb67dbe678878c054654" +
+		"a69c63c798f94da4fa281d0c388606a1083144d2a83c009851b3cb4648e77be43797f31b036101f2f7552e108e574d39" +
+		"f32a56bce575f91cfddfd5e9eac2634554fce820de4514ba49790488a6f3de403a9bc8ca00108e47604f2688a4bd5994" +
+		"bfcfd07932034c023da5c78ec4beb7fc58b43141ca62be93221974528230323e724bf8424734387205fc809a700e4bcd" +
+		"a1bb5e1c765a856f19a58597d3cb8cb8ad97c607036495dfd944c4591b98da9f57544b7bf68932dfc60f5ffde0e87e4c" +
+		"6ddad0fb59a6b30d4e3e8ec137522490154ffb2628e68aadea3bd9a2ac1e59c6b2915f8f2b40f2d5e1788a7537c0a621" +
+		"dbb39343d2520f297bf18e29f9d3e8a5375f84264664456c0ade62f22e7cce07a4dc085e8d4fa413d5b296d3b33438f2" +
+		"d4cecfd55a7838c3333e78f1eb1201b6a18e875526215b703942cde7c046339c4254ff4afc0acf78eb3d44c3b72360d8" +
+		"959ea2fe106403b852a8488c433c0d8bafcea44134b9497502b749faa00c3f8d797bc30ec3a6bfbbb69b3a06a2328670" +
+		"8adc6dd9ecaca488012991b080d6fd643fd8c4ea31d613bae32ad075a502084d7bf3a4ad7e6192224281f6aee480c7c2" +
+		"ffd6aee8f62c6659cd90eea20df3aff726333c43f75fcb53aa778f31e9ef59a73cd33e75e227d51691f87341f5ef2286" +
+		"bd7d47bedcaef694b67876a9d25c8e0acaedc0f4a7ce1343112c6889f424af2262550225c1529aa9af315a181a423bd8" +
+		"5cad6cc1cb95f827bb5a84263ccba5ba74da6909579f60fa26bb97f7fae211bf45e9f5e3d794211dd1dabef1f068af5f" +
+		"b56b06ce0100d8dc0b680e043491da13a06e6bd3c19ee75374a1e11e2c2a97298069e313d72817c5db01b9a8992b7ff7" +
+		"c40ddde4d00566a64e20d74ef8eacc02f24c41c9df175dff063d5ded16d631250c075b3830c566c5a411577de9f48035" +
+		"4a4330ec64386a5275284ab293d6b8d16f67df78e3dc353e861c60e71a620e291c7086136fb1642dc140464ef20510c1" +
+		"b66984733696f54a985f31f94f4552f556e31a23ae47807fe507e9cc6c77bd2afe621540c6940ab2e2840c326b8bd8a5" +
+		"dc89e0415ebd697512a2d380dc3641d5d9563fe7eccdc7eac5a5f1cdd4623ee101f8b6f32f7c06044c8068864367ef93" +
+		"9712185bd83b96b79e76316dc04ac7e312dab4db1420e7f678eb5f54576f9bdb85f24871170d3ea5c450ed9c32dfe516" +
+		"18a7d7ae7aec6704bafd8f387904e8640b4b20616d57e77f3df2168541a7b908b6b5b817f81ece2db219d54489309e2c" +
+		"bac174ce6d0004a82d98bf06dda43036ca074cbb3b07695fefc64caeb76285bbfbc10a2b51d5ce10827c2508cc6381bb" +
+		"3191a913aff104ef48a75b0772493d6ed5ba2f051edc4cd96dc38b37fc8952bd52e7f5b842e03345e6426a5f793ba9b3" +
+		"a6dde3e60ae73a1ea81056d7454e9c1a5ccd9500daf50e91ad628fdabc80ff2c768349bc98ec0279894fb7cc6d4f7abb" +
+		"9e833931a5dcff5d91e968a8269ecdcbb952a52a234e302444fe8d506c97603649fd5c23b417894e7999bc6596db6b8d" +
+		"e61463f5a5bef0531a18f30d89c49a6702a3cdc4f0308ddeadc04adc74e7c04aaf43c0b53f8a00c363c3fbb613910be1" +
+		"996049a299a452c723eb27b7bc0d850d59b12221e30e2d5f5070ce79dda8c445072faff94a5c14baa80da69e978c41ec" +
+		"7e1dfc3c1d70f809cb04735423bf3696aff7ef72e04b938a97aace6feb103247fcd82d5df7b4caf42816367f94d514d0" +
+		"fed50d89e27e6fae131a6c02c4deb7fe74559bed5d0ea86e35401e825af571aff83de5dffca6ed88320a1d329eced8d5" +
+		"e50ff003f4e9c9047275278786e20a186c1951fb13e49a18024a844fae0f15944a67027b0f44485de49a6af962ae56f5" +
+		"9c4fb425847616d438af01103d3aecccb1dd44c88c140ba9b566e30f9e16213cff2f41dfd9c57de4a0aa96f8d9814a87" +
+		"4915f7473f9d7b88ec46af159ce4ee0be20fd10e6ebc4b80104690e48ecec1188cc727a9f83f677230819a3eaacfcf48" +
+		"50f6582453e9b3e40f1110c3b645ae590fa63665569cd9f059e53979501a8cdcbc6a32616f8798bd74cafd55a187949a" +
+		"896c1488183af8f41779462db4786e90d5add7cc8ec1b47ae7e632e597d0aa123b808940c3eb0cc1e9957e36d595aec7" +
+		"99accb0deb961c264e22281aca139f5f71e2bdfcd3772063980dfe7110fe5f061c7aab46f7be9be1aa1689637c755fbf" +
+		"4a883994a2c93155ee2f40ce5c8fbe94812e83ac5a914aa0b515cd707bb1895889956a3db2371f95d7807479c9ab7df1" +
+		"4ad4b1056239309d8222a335251c69b9c83f4ed30770a20802d23637dffdf4a4fe52d5254ea4277ac90dfbf94d934c48" +
+		"2b6717f08bbf8b8292f9ed9bb939d72bd6069a5d1fb1793a7a0eb8d6b5d3161bf0c036624fc5924c5c8a475eeb9dfb4e" +
+		"eca36be776f994cef482fda70292d28643900e5913dc5e361a950ebb777a2f6c1bd5c1e8e112da66e8fba90a78304c1a" +
+		"96b01d0a91c80141047906dd80ad2f0261b7f03d4e56a65925f32fb5d538036572b59fb830b2b65d540239220d3f72ce" +
+		"cad4c805e31230c5981baaafd9905b9951677913265731bb7fa9bb02d001ee02042216a9d8fbffc9f699fa104ba1c5c1" +
+		"36d84e2c0f34966f97bf6d334cafe128f72db4e2d847bff39499cd0a2b83275904ffc9bbe2fa3100edccf17be0e27852" +
+		"df622d2ab0e3f8b7e0f248f0296161d0f27aaa4775aa8ae6cf461900540feb3fc0edfa9fbc7ab664d90f32deb2024653" +
+		"407a4b9c0a6dacd8ae84a10a339cede29e72dd57d0cf62527720e558a30557361ab703a1d873cc8a70f7d2f078e03f5a" +
+		"679213be8f45c4ad79f2d5cbbe41b09319ea746ef1083fba095703f457062525f50e369e066af01d190e7d161a4d94c0" +
+		"0e161effb482b2194b73d370d9374689ab19d3a3299a7042814096da8c5841184a251045d699bb4c33071a5cc425a135" +
+		"00c02a3977c53359049f58e7ccfccb115e4e5a806a6ed3eb0b983e7bb768cc5491b282efcefff04879454a9318bdee8f" +
+		"8070387b972124f91d2b97a9297682bf84e460716b0a16eed7490734d5e01704bb909b7e47831ca8aeecd3b05aef7520" +
+		"488a167759735ceb5db8b3e098f6271332e3e9faf1c94cc0fdaf5d1b24351ca6e7a82a3b9f97e93090f4037e89f8eee8" +
+		"76997cbb10f4683aa5dbb6327d614c034c9c806312e0e95b8c523cdda54e93a6ceacb4ea35e7607eb9461cf4cf438192" +
+		"b43d258c82dfd3d91d0310685ec8b2d81ad8d165c4772c7c9a3a759c256362483c9a6b57234b47c52b7b75f32dde3f91" +
+		"f2fd8471472a981502871de30c45a84e80fb5ba57fad3eaf767c06b49e01c640035221ef9b7aa1032ff193c796cad268" +
+		"cd701870564f2e680b093a932d62493b3bebab91eac7e06240e0578b8752202bdda15502b0e8686dacd810d550d55d32" +
+		"84988bd61b54c6622bda1cdab0d35471e76217dbfa66f92188eaa846439bb8fa70fc2d91c515afa01a399d4e9820b3b8" +
+		"42b75bd5e45efe7c8f559a79f7bc5422c487ccdfcbb5908e88ffdf68f81b508e7b440bfc332d7e1a2d6f4a47d567330c" +
+		"85f83dd079418b4f4864f311d6f22e5ee8dc5e79e86e28a7a056a1f95e50735668318ffba2e22a5490ec05e985b2b384" +
+		"5f15c9b3744c2e70e2ac7c8d305a7049fef07861366174f99bef8d6727ed1445e50741bbc0758a23caecb62f2769d345" +
+		"a1b2f12139ef408cfd6a176a14c9b41518112867cf2fc6e4bb06c8fb80b151d72914bb5c893c7e93604963266f9542b4" +
+		"d6d059c6a1df162ea1244dac987de250b24f72abb7c8e9b031cf85e0af5ae7395b85290adfdc9d9924151a1dcd2b0a56" +
+		"21482d4a558226661dd7fd1e2b14994621eb8e2ab2c34e272fc4f3ef47814216ff57da7f45fe9aff783ac23413391597" +
+		"a868ef227d768cd413b27c6ee4907919c6d4248f02d489de467ae39304f2d1b5e3c2cb0e56bd1ef9cc61df941e488500" +
+		"c677d8b201b192cf7432fe56961ced0b868682d5719b60d6cb27405978dfd375506c9e48a37febd34cd9134fb3365bd1" +
+		"e096f107df1841c7e97ce52bcd69cfb4746c5126c287c49276631249c155d152eb72d9e8a809386d86ac1c6abe0b6f54" +
+		"f30d1afe74d01c0d45df7b1457defc734d06f55c5f1c4baf14e9e730da6c7fa8f30c07376f61846cea1767e894fd084b" +
+		"c40d9b02e569e104ea8083c8e14497afefaa9c297353bcafb0d17ca89f8d99def753099eaeee22a69fc9006938716286" +
+		"55f99e0521ca502060075d7588816ec89fe3cc79b2f42eaa57e2ce7acab5a1307945af7c6f5fc9bc2c3bbdbe43fbd7b2" +
+		"7fef3343bd2b5ac0dbdd23529a788db29bdf49a4981f84167a6bf9b488b0af68d6c5f4b73037967714040f105bac9fa8" +
+		"1e644f30a48c02e09b3d6640ae986e84d46f85b0bc0f255f2a48fa27d1fec24ea0092fc2f1a30d18f967ce2676b1cc35" +
+		"240bc8ecf309f17997560d7bf710cd27a02ec2fe8785cae817f74ea107ed704cb8d55baac3853c2d970951b480c8d775" +
+		"b254aa63ec763092d44ad29b1d7288ef1793f759affdfa1b186092cbfb9921040b167ee89698e0c8d25bef4e4bdf3d5c" +
+		"2ba484e07546c4d1e36139e2ab74db5cab194fb1021fab6c57e4f0bb63a5c3ffacd466709a26a52e5f90437c5bd621fd" +
+		"1ba9fef68bd389a27fd0acfa2ee5c886e536c4d6902ccdcc4bd6772ba1732ce7361b23ec72845723ed9c2551971f224b" +
+		"54c58dcb451fc7d4186c26f5568d8802b85d25be575db49082486156ff06ad43075276b229a82c721246fd6611753a09" +
+		"14201bf180a9d30f32c29f94425cb13ab67155ee9f681c6096ee600da92ebcf849ed5e458ddebb0d02a0ece90a1f8877" +
+		"e893210ac2669fb52a68495718c1fd9439144a8039517b9d54fb20526d8c9f25514efe1a75953de6c3aba6613f435137" +
+		"a1798f636538de8dff263168136a4b4379edea832fda897d39f0ba5ffda52e59becaea6e8d30658d18f8d2e5b7b0a7a5" +
+		"2d712a74d2658209813e1747a75a6ff639bc4d3adb20c9db90c636207e9944ec073b774cc6e6d3d9119bb3627bc4c9e7" +
+		"034ee10df04f061a9c1ac75e07b06e9a63aab00675e6ef2380f0335ed0cb6a3e803245704a9cac50b571dbe2a4e0d199" +
+		"3b8a216f743aa176d8ba1a5231293fed785f43f2c9b08d1aeb7e8dcd4cd903e0eb5b4636b710aba2c272a064dab5ee5b" +
+		"239b379798c4996d19e2b9c3b6a3ffd3e29366907dff67849b5e88b3657d35f85424ad32ad54ec82504d3973026d8dc0" +
+		"6cf950721fc3eb4a317c6d801a7ad2b530b7c908ed2c522e36bd266404cc91501e98a761a62f28dcaeda6b4eabe9ab5b" +
+		"b29e396fbddef9b2ce0a361f257f89e845c069f4bfb7854887b206c26ced7105d029e748b7b9d8dc1a13874afa352168" +
+		"443b895a44f48b33a4986918c322b5bf628579412e44f42197ad04daff544256a6a60e8179eebc540d6247aff6f243ff" +
+		"2c17f75349b90509fd320e389afc6f7fe81b2a2edc4b0af1d8422d6f26da9a8205c9b16903c840f673df74009d90230c" +
+		"9e243e2e5df8ff9900f71002488591b41354f3c746bf3e77e8533460d3e0bc50151a0565320e2aa885c986289da38de5" +
+		"0bd729318db13e66dce22196e98919f4375f612e257a27fa19f0761412b01b228072b87e9ac93845f04264a80ea0878f" +
+		"fc2520b0031cb8e0299c5011ab1d4813fe55cbbdfe78639d82873c8588d1cf6d31548683e5ed889e96ba23b59f187214" +
+		"84107fbb70ac8dfa1e050db9a35a642620859aa86f740c51c64db758bbb1406bc7cc5149203c93f31819cdefcbeabefe" +
+		"868abfc256a69649d819d858aea16c4966db1e7aa122d2a1a44cd584029c5c0df962e52e71487c8448fd6f575f547330" +
+		"dd667cbca85bb2c8f418291b467fd90147a4c9e50741c953a0e95d857f7b7f3744bb63cac48d9b365b65dc8b32f276c0" +
+		"d13f7f1196218aabe1a91d47896f5d416271efa9d9d8dc77658c0b07a6a8aa8d751da547b204d35b2832f0c4fbfc2a18" +
+		"356aad4e31cd4884894297dfd4cce0aba10c41794145f9758fd58932dcd5d2e2d78b3f587f81386bf82ab4aadfe3a8f3" +
+		"220c952418f4f466b0a1f3919215b4056974e3107cdbca68dade83e142555e8943303228a23eacb8108916c84578d096" +
+		"6109673cc69f9cdbdd97572f4e4345ed3c5def1a6681f6bd48041f86b5c34a270d6490ff6bb5b976b530b26a8c69f2fd" +
+		"89c06281b1b4379edc1859381b5e6128613b93779e012756ae8ee2d2dae701bef97308456ae795505e24a44f5b76675d" +
+		"7fb16d3b48045889514bf36f79e9fc51ccd102cba6500c5de631b9bf052bef17d6bdb580d4605a053d4a8ea21e40731d" +
+		"f130911a19bbf6e1826a3caa639e845f5bd7bdcf4fb9181c3c457fe6f2c9d1ca122d9276d1261477dc389c41f240a258" +
+		"e4b9d01c101dc0e80abffc437558659771fd6f214f7fef09b2dfa901f8ba5d4dc4fb259f174eb3f169d75e1c8e99c25c" +
+		"40eedaf619abfa1e9a8761a62302f7f089f49b0d1dfaa02d9cfbb8fd1ae2c8c41490f16063b3e24fa010caf822574e56" +
+		"0a65b02a69ac672243f890bb4f5963d4118e710719e527d4233734c36173b43c57c7fb613a9228bdee7eefdfd3b738fd" +
+		"688a602b39e44d90254be50ca835b29a79969d3d1d835cc2de1787adb44d2333f97c01f7ef48593b9a84cfcc91a91c17" +
+		"966902d39726e5a0b166dbf20a6839e6f8c61fa5de5bfd1aba09453ee0a0c0c8527460b8801a5f054095cdffb41cc427" +
+		"a9d09503561c86ded5c32e3d6e07f7fb9d0a7068cacdae01c04567ab6f3e96e9de94306ec9cb1fac853dda922be7ff72" +
+		"b9c906e1872827b3821792326187cedc62c2ca704f3d50f038e96796eb6ebe92493a8e674d6e23799a53663c878f3eb9" +
+		"2bf033f7be9d47c975437002aef7b1d29e830b730902f5dabdf907261f1e73df1effcf03ec9100053c3c0a629c169e50" +
+		"9a51e92fcf70a30845fd7ab3d15ef7b24e4da9b8af84a827d654a5908ccc54afa2bae34eb524b727fa38e2f4e4282d94" +
+		"d2316a8e0a2e5d94dd818ec07898281c2df9d97201ef00de7b21dc5c1098db6eb22276ed35a3a2188b60b590f08307e2" +
+		"c38de39fde3348d5df01395bce823a7236d4992e2235180e14cef71885a1f4c366fd327fb0fef7393c8a6efc35ba46f"
+	dsfmt19937_jump = "" +
+		"8d4d6b01f387cfbeb7815c460b160b8901873b8c94a290a2224a93f176d9ca9e75d688fc3f91d06a71cb86c1e1cf32ea" +
+		"bda910d564afd3a4e90053d55d0b186657bf96e93f8b2275d38e7cb7da6fa8ec8892cf65444c144529d45478dd200826" +
+		"aff4f7629daa6761f3236d93ce982e0feca18090d6921b88bd46179129d2599f415d1a3b313d58d77c41fb99f259301e" +
+		"96a8db91ce401d563930cdff85a6d054f001c982f3b600e89c82e888b156bcbc05097edcd6c95cdb93b17d6fd11c4840" +
+		"1cfc269eb074ef73be31f810c893a69e875c8080564492b74b1970d38eeb3881269b320bc5be7047e55089f168f3e33c" +
+		"1138e1e61adb34c2a895ff4b326dd021e2a433dddb70d0b499167ad98c721213d8cfdfe5fafdc732b47796361a95976e" +
+		"4f7f9118e1fd3ab40d669632836c696c9190c1fe65073796de7fa5192ea73b86852ef61ad6b152e04fd99821e414f487" +
+		"b36dcd250e013c40f49ca3395a17f078dd92796d8ac092bdd108f69fcf7e1f20f2c51bc9be80ef7621c44dc66309cdeb" +
+		"7828fdda3bf9b33723dd3870ef5a32f0cf6d18da8514b36e3815b749aa2336cb0a22309bc49dfbdb53dc7a18ed7bfd25" +
+		"3a6a33da2b108e96eac968d95c5722687563a8ad9a57a7254ead8fe18fc0b16a3d84019a4e69e45245cf8afe71a93e18" +
+		"c121505cb170fc72fc27dbe40ea1b1f917336d4ab15b6516c83a06443e35c8ee89b86dfdc10107e9980156775577ec52" +
+		"d6d5e9d2856a7add0a27ebcad6a803af49805311342ee6057282abec4e287d6b5c87fa56e5aa8f74fe8343e49e6c7395" +
+		"499c08446a83d38344ab766ee75cfe86ca6a5e94639052218cef199bb48fbb725308ab6786ebcac2fca3b7838ef95b93" +
+		"d5b895f74ac02a07ef2092959335e7b5aa5f32707d137de7188557cd672ef24dcf3dd5af700c90fd8460d57d88c44c00" +
+		"ca8cac37ba9834b56fff3ac1b64a6cc53389379d2aee05a417eba2d7c0d3cf2e0580e89613dd453f74f063c08f49fd40" +
+		"7f2346871169f37bf7cd3d1dc2ac41ee859d942dcb13ca597ff2afadcb56467267c500d8b79bee0492fccae4f20d5c97" +
+		"eae887a8b6614417065e5426ee828f4d75e4aec4dbd160d73673ba88bb75e7a19f3e92f0548b087fd9af4f8853f03e47" +
+		"afe81c1410c9f21ff7549a858683abc17b73dc5786caf7fd7e5e5842bae43db369bca0af8f7c283f3ab0f46971d3fb37" +
+		"bf7ef833a4d917292035fe31ba21a61391fdb2c73bb5185a044f72123564b0d6fc2a6306b593fd289c99af1474136e9a" +
+		"ccc9b43c56510b04206f03eec92b923497872dd91031e5189f7c5fa14a533ff43f3ce8dea492ccea97ba1c5771a2ef64" +
+		"e1ad20ad2a73d7580c7cbebc19df0db622a1d262a06834e6e2d7e3bda73b5819df364171d89dfbf9c4534affef5ce79b" +
+		"daf36183fc1286c5562426083b16577182a53d59b1d27b5ebcf1ff368a93106f5c95029a46300c5101d66474edecf7b0" +
+		"21a50594c5a7f7f6dbd9cd2840ee2807a17da4d3df27e7cdbe1d51063db0a4f400134517269606a89b5867b796c1699c" +
+		"d9d1d451d52a9e769d2426e15c4581271cc20e7e08302c0937c48b0e911a8c9bee7573a2f20af357323f77d65f6c8130" +
+		"7cc3150ef2db5974c6966a7a7c4603274f258e8041c22529fea871dd4f72752d87df425b634326136f376b3cba74df8d" +
+		"1a14e9c4467c7bae6cf6c439d67dcc61bd7f003daf0a3500f067bf36516c8ee1a95fe82fd3794d2e4499d82b6c751556" +
+		"268990c4b4d1d1c5bbe375c5106ec3db8f10dcacaa8a7d4bc1db2b70a2e5679c7efa38c79af47aad538faca6247b614c" +
+		"51188fba2aed1739d8d402497ef5e6d768dc8a6e3ee3c6bd8d8e60dc2aaacef2b264fdd2df33f9aed4e067e6c4f3cd41" +
+		"e3724c3f94e649b8107e5c05531a9bfe3d5a4520bf8b51db9729228062963aacf32597dafb7941848bca4d74d92fc48c" +
+		"2269344060d17e87fab1f3c3eba076f5a1bb8a8faf303b8913c00b598f2af4e23dc894f8c8a394ef828de1582e9120f5" +
+		"76a569d908582ab489920d7def2bbdf88f3cb58ce38ac7c47d690cb54420edf38b4327ea3e6bbf6863c2627c264b7978" +
+		"11115d3ca07b686a4bf2978eca568b5245d01ce7087637cf4795e4b27e96f2ca424ee9e8829f10b04b3b5001935fc89f" +
+		"d368f1bf9444f8512a5c9b68370a88f8746a01d165d5b1c65afa09b144abfe691c4009af771fc0478043d5878b992532" +
+		"d6c130cdf7333e00fdd37c1d19f13bc744c2b97982b3f306364e8a541aab1542640f39cbf004b98871319e7bb5c0fa6a" +
+		"553e1f1fc4f85f6e933b39739d05285bd01a1650306d1fce7703435a96ea129b13cb8b5b3ac4f8a283f39fb33f299674" +
+		"5d057c9b3f75acd059b91b2b2a5f53f38e72686002a56009fb96896e10d377f5201441f0931309766c0e185f6e107031" +
+		"31b1241425753c94d53b22b24cd96a9493bf88f6e56f1f2814919c9d6a91b5c8d3c95a90db6d4c977db4d5bdf4de06d8" +
+		"4a30b521bd6dd73a5f64765786a408b4b5109f2513d09a5cbfb6885589d78750c706978040806f8fd329d7c87c7c33ab" +
+		"fb2d5d15ce9c1e8fed79a3fe27a540875eabc741a2170641e9a43d5181330b0ace4be0f91b24de3001c41b22da51903c" +
+		"0e8172c24f8596fd1c6a35850a4f49f78dbc6b8ad46f2c263eca1983ab802bf1ea0e0bebdd7da0df2d84a3ea3e775f96" +
+		"f398f9cdd2893911cde83c2108cf32370e556fa5188191652af4329f7b723ab6026db20f4962a189b6c402ee34b8c5cd" +
+		"6c5eb0382f4aa323ea3011fb56b77ccaf0b288a966e4703dd39bcea4348582b48f3d830ab71abbfac6f96807982c5c9c" +
+		"20a41d029dbb41972bb6dc4e1a8d63a6ea2bede1f3363b4b26f2343771c68d1423952e3a0e1bb4f0e001900a92d158b4" +
+		"ee6fb830019c8d89663611e4e24470a61ad74332233af6ee3a32a02aacad459d06f45e941be7a0cad8886d5886d9bb72" +
+		"5412c9e1db2ecb7a4c4992f2e7015cc89522138477dec505d673a211a5afc5d8f1640a47740e7a4c7511bab03c6bc251" +
+		"7674afbbc3cea1354c79d449f646b706083389938073ba304ecf8be8352be446a9972c3618d9a555e5db3d202aeba151" +
+		"7b6ea5a202ef607a7b3aad8c832c6a99c80f1d21e038bf48c11a1f23f5193735f3e8744eb1254a2c881d9b844f4ce859" +
+		"632f6861d5efa48a8a2445a19abcf31db82fea7307e9900c117d235608fd80e2765ae364e64ed48c0da42e4a10851878" +
+		"db827f82f80c905fe0297b37cf058d311d0907a99fff5e06a3a065a36ae573e523d968669ecb6be7141cff46a061fa85" +
+		"036a2d0d30ed39131081ab029054c0ab9283df445fb20fa4a513735b285dd4cf74aa58c96ac224171da7d67dfc464495" +
+		"74e629fc7b7aae39a9925eb56155837348e6a4118f56356f4587fa3e0a74ef46519fc51241de36be03665be9827288ba" +
+		"f8ffa08a221fdd8fa7cbba7f9da86401ccfbd8080504b1a58528ef7439f600419e2b9d755112daa9ca396415567a80cf" +
+		"507e0f1"
+)
@@ -0,0 +1,108 @@
+package source32_test
+
+import (
+	"fmt"
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+	"testing"
+)
+
+var sfmtParams = []struct {
+	mexp   int
+	params source32.SFMTParams
+}{
+	{607, source32.SFMT607Params},
+	{1279, source32.SFMT1279Params},
+	{2281, source32.SFMT2281Params},
+	{4253, source32.SFMT4253Params},
+	{11213, source32.SFMT11213Params},
+	{19937, source32.SFMT19937Params},
+	{44497, source32.SFMT44497Params},
+	{86243, source32.SFMT86243Params},
+	{132049, source32.SFMT132049Params},
+	{216091, source32.SFMT216091Params},
+}
+
+var sfmtKey = []uint32{0x1234, 0x5678, 0x9abc, 0xdef0}
+
+func TestSFMT(t *testing.T) {
+	for _, p := range sfmtParams {
+		t.Run(fmt.Sprint(p.mexp), func(t *testing.T) {
+			/*
+			 * Data from running a C build of the reference algorithm (SFMT 1.5), seeded with init_by_array().
+			 */
+			rng, err := source32.NewSFMTFromStream(p.params, sfmtKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if want := fmt.Sprintf("SFMT%d", p.mexp); rng.String() != want {
+				t.Errorf("String() mismatch. want: %v, got: %v", want, rng.String())
+			}
+
+			grandtest.CheckVectors(t, rng, fmt.Sprintf("testdata/sfmt%d.txt", p.mexp))
+		})
+	}
+
+	t.Run("Jump", func(t *testing.T) {
+		for _, p := range sfmtParams {
+			if p.mexp != 607 && p.mexp != 19937 && p.mexp != 216091 {
+				continue
+			}
+
+			rng, err := source32.NewSFMTFromStream(p.params, sfmtKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rng.Jump()
+			grandtest.CheckVectors(t, rng, fmt.Sprintf("testdata/sfmt%d_jump.txt", p.mexp))
+		}
+	})
+
+	t.Run("FillUint32", func(t *testing.T) {
+		for _, p := range sfmtParams[:6] {
+			rng, _ := source32.NewSFMT(p.params, 1)
+			want := make([]uint32, 10000)
+			for i := range want {
+				want[i] = rng.Uint32()
+			}
+
+			rng.Restart()
+			got := make([]uint32, len(want))
+			// odd sizes, to cross lanes and blocks
+			for i, n := 0, 1; i < len(got); n += 1000 {
+				if i+n > len(got) {
+					n = len(got) - i
+				}
+				rng.FillUint32(got[i : i+n])
+				i += n
+			}
+
+			for i := range want {
+				if want[i] != got[i] {
+					t.Fatalf("SFMT%d mismatch at index %d. want: %v, got: %v", p.mexp, i, want[i], got[i])
+				}
+			}
+		}
+	})
+
+	t.Run("Conformance", func(t *testing.T) {
+		for _, p := range sfmtParams {
+			params := p.params
+			t.Run(fmt.Sprint(p.mexp), func(t *testing.T) {
+				grandtest.RunSourceTests(t, func() grand.Source {
+					rng, _ := source32.NewSFMT(params, 1)
+					return rng
+				})
+			})
+		}
+	})
+
+	t.Run("InvalidParams", func(t *testing.T) {
+		if _, err := source32.NewSFMT(source32.SFMTParams{}, 1); err == nil {
+			t.Error("No error for the zero SFMTParams")
+		}
+	})
+}
@@ -0,0 +1,322 @@
+package source32
+
+import (
+	"errors"
+
+	"github.com/jtejido/grand/internal/gf2"
+	"github.com/jtejido/grand/source64"
+)
+
+const (
+	tinymt_mexp     = 127
+	tinymt_min_loop = 8
+	tinymt_pre_loop = 8
+	// the number of restarts of the search of the tempering matrix.
+	tinymt_tmat_tries = 16
+
+	tinymt32_sh0         = 1
+	tinymt32_sh1         = 10
+	tinymt32_sh8         = 8
+	tinymt32_mask uint32 = 0x7fffffff
+)
+
+// TinyMT32Params holds the parameters of a TinyMT32 generator, i.e. the two matrices of the
+// recursion (Mat1, Mat2) and the tempering matrix (Tmat).
+type TinyMT32Params struct {
+	Mat1, Mat2, Tmat uint32
+}
+
+// TinyMT32DefaultParams is the parameter set used in the examples of the reference code.
+var TinyMT32DefaultParams = TinyMT32Params{Mat1: 0x8f7011ee, Mat2: 0xfc78ff1f, Tmat: 0x3793fdff}
+
+// Validate returns an error if the characteristic polynomial of the recursion is not primitive
+// (i.e. the period is not 2^127-1).
+func (p TinyMT32Params) Validate() error {
+	if _, ok := p.characteristicPolynomial(); !ok {
+		return errors.New("The characteristic polynomial of the TinyMT32 parameters is not primitive")
+	}
+
+	return nil
+}
+
+// CharacteristicPolynomial returns the characteristic polynomial of the recursion, in hexadecimal
+// (the coefficient of x^127 first), as printed by the dynamic creator (TinyMTDC).
+// Parameter sets with different polynomials generate independent streams.
+func (p TinyMT32Params) CharacteristicPolynomial() string {
+	f, _ := p.characteristicPolynomial()
+	return f.String()
+}
+
+// Returns the characteristic polynomial, and whether it is primitive.
+// As 2^127-1 is a prime, the polynomial is primitive iff it is irreducible of degree 127.
+func (p TinyMT32Params) characteristicPolynomial() (gf2.Poly, bool) {
+	st := [4]uint32{1, 0, 0, 0}
+	seq := make([]uint8, 2*tinymt_mexp)
+	for i := range seq {
+		p.nextState(&st)
+		seq[i] = uint8(st[0] & 1)
+	}
+
+	f := gf2.MinimalPolynomial(seq)
+	return f, f.Degree() == tinymt_mexp && gf2.IsIrreducible(f)
+}
+
+// Delta returns the sum of the dimension defects of the output, i.e. the sum over v = 1..32 of
+// floor(127/v) - k(v), where k(v) is the dimension of equidistribution with v-bit accuracy.
+// As in the reference code, it is computed on the F2-linear version of the tempering
+// (the addition is replaced by an exclusive or). The lower, the better.
+func (p TinyMT32Params) Delta() int {
+	return newTinyMT32Outputs(p).delta(p.Tmat, tinymt_mexp*32)
+}
+
+// tinyMT32Outputs holds the first 127 outputs of the F2-linear version of TinyMT32, as vectors of
+// coefficients on the 127 bits of the initial state: base[n][b] is bit b of the n-th output
+// without the tempering matrix, lsb[n] is the bit that selects the tempering matrix.
+type tinyMT32Outputs struct {
+	base [tinymt_mexp][32][2]uint64
+	lsb  [tinymt_mexp][2]uint64
+}
+
+func newTinyMT32Outputs(p TinyMT32Params) *tinyMT32Outputs {
+	ans := new(tinyMT32Outputs)
+	for j := 0; j < tinymt_mexp; j++ {
+		// the bits of the state are the 31 low bits of status[0], then status[1], status[2] and status[3].
+		var st [4]uint32
+		if j < 31 {
+			st[0] = 1 << uint(j)
+		} else {
+			st[1+(j-31)/32] = 1 << uint((j-31)%32)
+		}
+
+		w, bit := j/64, uint64(1)<<uint(j%64)
+		for n := 0; n < tinymt_mexp; n++ {
+			p.nextState(&st)
+			t1 := st[0] ^ (st[2] >> tinymt32_sh8)
+			t0 := st[3] ^ t1
+			for b := 0; b < 32; b++ {
+				if t0>>uint(b)&1 != 0 {
+					ans.base[n][b][w] |= bit
+				}
+			}
+			if t1&1 != 0 {
+				ans.lsb[n][w] |= bit
+			}
+		}
+	}
+
+	return ans
+}
+
+// Returns the sum of the dimension defects, or any value >= limit once it is reached.
+func (o *tinyMT32Outputs) delta(tmat uint32, limit int) (ans int) {
+	for v := 1; v <= 32 && ans < limit; v++ {
+		basis := gf2.NewBasis(tinymt_mexp)
+		max := tinymt_mexp / v
+		k := 0
+	outputs:
+		for ; k < max; k++ {
+			// the v most significant bits of the k-th output
+			for b := 31; b > 31-v; b-- {
+				x := o.base[k][b]
+				if tmat>>uint(b)&1 != 0 {
+					x[0] ^= o.lsb[k][0]
+					x[1] ^= o.lsb[k][1]
+				}
+				if !basis.Add(x[:]) {
+					break outputs
+				}
+			}
+		}
+		ans += max - k
+	}
+
+	return
+}
+
+// Searches a tempering matrix by hill climbing: starting from a random one, bits are flipped as long
+// as it lowers the sum of the dimension defects. This restarts until the sum is 0, at most
+// tinymt_tmat_tries times, and returns the best matrix found.
+func (o *tinyMT32Outputs) search(rng *source64.SplitMix64) (tmat uint32) {
+	best := tinymt_mexp * 32
+	for i := 0; i < tinymt_tmat_tries && best > 0; i++ {
+		t := uint32(rng.Uint64())
+		d := o.delta(t, tinymt_mexp*32)
+		for improved := true; improved && d > 0; {
+			improved = false
+			for b := 0; b < 32; b++ {
+				if e := o.delta(t^1<<uint(b), d); e < d {
+					t, d, improved = t^1<<uint(b), e, true
+				}
+			}
+		}
+
+		if d < best {
+			tmat, best = t, d
+		}
+	}
+
+	return
+}
+
+// SearchTinyMT32Params runs the dynamic creator: it returns count parameter sets with primitive,
+// pairwise different characteristic polynomials, so each set generates an independent stream
+// (e.g. one per worker). The same seed always gives the same parameter sets.
+//
+// Mat1 and Mat2 are drawn at random until the recursion has a primitive characteristic polynomial,
+// then Tmat is searched to minimize Delta().
+//
+// Mutsuo Saito and Makoto Matsumoto, Tiny Mersenne Twister (TinyMT).
+// http://www.math.sci.hiroshima-u.ac.jp/~m-mat/MT/TINYMT/index.html
+func SearchTinyMT32Params(seed int64, count int) []TinyMT32Params {
+	rng := source64.NewSplitMix64(uint64(seed))
+	seen := make(map[string]bool)
+	ans := make([]TinyMT32Params, 0, count)
+	for len(ans) < count {
+		x := rng.Uint64()
+		p := TinyMT32Params{Mat1: uint32(x >> 32), Mat2: uint32(x)}
+		f, ok := p.characteristicPolynomial()
+		if !ok || seen[f.String()] {
+			continue
+		}
+
+		outputs := newTinyMT32Outputs(p)
+		p.Tmat = outputs.search(rng)
+		seen[f.String()] = true
+		ans = append(ans, p)
+	}
+
+	return ans
+}
+
+// Implements the Tiny Mersenne Twister (TinyMT32), developed by Mutsuo Saito and Makoto Matsumoto.
+//
+// TinyMT is a small F2-linear generator with 127-bits of state and a period of 2^127-1, meant for
+// applications which need many generators: each parameter set (mat1, mat2, tmat) defines a different
+// generator, and sets with different characteristic polynomials generate independent streams.
+// TinyMT32Table holds precomputed parameter sets, and SearchTinyMT32Params finds new ones.
+//
+// Mutsuo Saito and Makoto Matsumoto, Tiny Mersenne Twister (TinyMT).
+// http://www.math.sci.hiroshima-u.ac.jp/~m-mat/MT/TINYMT/index.html
+type TinyMT32 struct {
+	baseSource32
+	params TinyMT32Params
+	state  [4]uint32
+}
+
+// A single value is used as the seed of tinymt32_init(), longer streams are used as the key
+// of tinymt32_init_by_array().
+func NewTinyMT32FromStream(params TinyMT32Params, seed []uint32) (*TinyMT32, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans, err := newTinyMT32(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewTinyMT32(params TinyMT32Params, seed int64) (*TinyMT32, error) {
+	ans, err := newTinyMT32(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.Seed(seed)
+	return ans, nil
+}
+
+func newTinyMT32(params TinyMT32Params) (*TinyMT32, error) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(TinyMT32)
+	ans.spi = ans
+	ans.params = params
+	return ans, nil
+}
+
+func (mt *TinyMT32) setSeed(seed []uint32) {
+	p := &mt.params
+	st := []uint32{0, p.Mat1, p.Mat2, p.Tmat}
+	if len(seed) == 1 {
+		st[0] = seed[0]
+		for i := 1; i < tinymt_min_loop; i++ {
+			st[i&3] ^= uint32(i) + 1812433253*(st[(i-1)&3]^(st[(i-1)&3]>>30))
+		}
+	} else {
+		mixKey(st, seed, 1, 1, tinymt_min_loop)
+	}
+
+	// period certification
+	if st[0]&tinymt32_mask == 0 && st[1] == 0 && st[2] == 0 && st[3] == 0 {
+		st = []uint32{'T', 'I', 'N', 'Y'}
+	}
+
+	copy(mt.state[:], st)
+	for i := 0; i < tinymt_pre_loop; i++ {
+		p.nextState(&mt.state)
+	}
+
+	mt.stream = append([]uint32{}, mt.state[:]...)
+	mt.Restart()
+}
+
+func (mt *TinyMT32) Seed(seed int64) {
+	seeds := make([]uint32, 4)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < len(seeds) {
+		v := seeder.Uint32()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	mt.setSeed(seeds)
+}
+
+func (mt *TinyMT32) Restart() {
+	copy(mt.state[:], mt.stream)
+	mt.resetState()
+}
+
+// Returns the parameter set of the generator.
+func (mt *TinyMT32) Params() TinyMT32Params {
+	return mt.params
+}
+
+func (p *TinyMT32Params) nextState(st *[4]uint32) {
+	y := st[3]
+	x := (st[0] & tinymt32_mask) ^ st[1] ^ st[2]
+	x ^= x << tinymt32_sh0
+	y ^= (y >> tinymt32_sh0) ^ x
+	st[0] = st[1]
+	st[1] = st[2]
+	st[2] = x ^ (y << tinymt32_sh1)
+	st[3] = y
+	mask := -(y & 1)
+	st[1] ^= p.Mat1 & mask
+	st[2] ^= p.Mat2 & mask
+}
+
+func (mt *TinyMT32) Uint32() uint32 {
+	mt.params.nextState(&mt.state)
+
+	t0 := mt.state[3]
+	t1 := mt.state[0] + (mt.state[2] >> tinymt32_sh8)
+	t0 ^= t1
+	return t0 ^ (mt.params.Tmat & -(t1 & 1))
+}
+
+func (mt *TinyMT32) String() string {
+	return "TinyMT32"
+}
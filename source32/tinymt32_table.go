@@ -0,0 +1,263 @@
+package source32
+
+// TinyMT32Table holds 256 parameter sets with pairwise different characteristic polynomials, for
+// applications which need many independent generators (e.g. one per worker).
+// They are the output of SearchTinyMT32Params(1, 256).
+var TinyMT32Table = []TinyMT32Params{
+	{Mat1: 0x88b894e1, Mat2: 0x401ed25b, Tmat: 0xee46338e},
+	{Mat1: 0xbc730140, Mat2: 0x50141d01, Tmat: 0x89dd6f25},
+	{Mat1: 0xe23e7fe1, Mat2: 0xb4b4114d, Tmat: 0x6b2ddaa9},
+	{Mat1: 0xb5c1a86f, Mat2: 0xf40ff011, Tmat: 0x7f162a6b},
+	{Mat1: 0x3fd4fbfa, Mat2: 0xf3a8a5b3, Tmat: 0xf8cfdfd4},
+	{Mat1: 0xf77e0dcd, Mat2: 0x6c34f9c3, Tmat: 0x766ec51f},
+	{Mat1: 0x146ada6d, Mat2: 0x496f7827, Tmat: 0xfa2c1d7b},
+	{Mat1: 0x1490e6b7, Mat2: 0x11d4a93f, Tmat: 0x8bac5d60},
+	{Mat1: 0x08893469, Mat2: 0xf121183b, Tmat: 0x6c86fe6e},
+	{Mat1: 0x65f53fc0, Mat2: 0xef3d39c7, Tmat: 0x66503d2d},
+	{Mat1: 0x041fe2b3, Mat2: 0x5f3a75fd, Tmat: 0x4c15ac6b},
+	{Mat1: 0x3eab7ddf, Mat2: 0x78d92969, Tmat: 0x307525e8},
+	{Mat1: 0xcc6824da, Mat2: 0xf1d6efd7, Tmat: 0x1f4192f9},
+	{Mat1: 0xa4293797, Mat2: 0xc975da29, Tmat: 0x5e7f5f04},
+	{Mat1: 0x300c96f7, Mat2: 0x48699e67, Tmat: 0xec16750d},
+	{Mat1: 0x3849fdb1, Mat2: 0xd8613157, Tmat: 0x0c23cdae},
+	{Mat1: 0xb9e10b47, Mat2: 0x672fbd2d, Tmat: 0xae5dd3e9},
+	{Mat1: 0x66811123, Mat2: 0xc7163f5b, Tmat: 0xfbb07a0e},
+	{Mat1: 0x010e0235, Mat2: 0xb9271fe1, Tmat: 0x34702483},
+	{Mat1: 0x666a8f06, Mat2: 0xebaa4719, Tmat: 0x705ef5f6},
+	{Mat1: 0xd12df99e, Mat2: 0x0308df03, Tmat: 0xe9652588},
+	{Mat1: 0xca6e2d87, Mat2: 0xbd9db0a1, Tmat: 0xf935674e},
+	{Mat1: 0x48c0d198, Mat2: 0x433a9cad, Tmat: 0xb37af99a},
+	{Mat1: 0x926cb2ba, Mat2: 0x701fc323, Tmat: 0x180c1357},
+	{Mat1: 0x6c6ef5ec, Mat2: 0xb0166377, Tmat: 0xb431dc09},
+	{Mat1: 0x2ae9e2b8, Mat2: 0xe73f4607, Tmat: 0x7497025c},
+	{Mat1: 0x0930d606, Mat2: 0x7f76a769, Tmat: 0x450f9c04},
+	{Mat1: 0x0305eed3, Mat2: 0x69a1050f, Tmat: 0xe4d53b91},
+	{Mat1: 0x40fc6980, Mat2: 0x9429e031, Tmat: 0xb5b1abc8},
+	{Mat1: 0x82bccb25, Mat2: 0xd2e4a255, Tmat: 0x978504a2},
+	{Mat1: 0x1b151637, Mat2: 0x3a829ed1, Tmat: 0x44a0d717},
+	{Mat1: 0x3431a7a3, Mat2: 0x086fff23, Tmat: 0x9b8e9c5e},
+	{Mat1: 0x438bcba4, Mat2: 0x677365ff, Tmat: 0xaaf5fbcc},
+	{Mat1: 0xdc25fe50, Mat2: 0x4e4e910d, Tmat: 0x6aaf2745},
+	{Mat1: 0xf22f2184, Mat2: 0x998ebd29, Tmat: 0x5c0b4c9b},
+	{Mat1: 0x0f546859, Mat2: 0x4496d24f, Tmat: 0x6070913d},
+	{Mat1: 0x3ec2c86d, Mat2: 0x907ec0e7, Tmat: 0x279b1b8a},
+	{Mat1: 0x4f4a0684, Mat2: 0x0bcc174f, Tmat: 0xd4c40355},
+	{Mat1: 0x8678eef8, Mat2: 0x3b70b39d, Tmat: 0xa1b180b2},
+	{Mat1: 0x61928538, Mat2: 0x118fedd1, Tmat: 0xa0eb1503},
+	{Mat1: 0x8b1fcaf3, Mat2: 0x792cbe1b, Tmat: 0x43b21304},
+	{Mat1: 0xb057e54b, Mat2: 0xfa2e46d3, Tmat: 0x98a35518},
+	{Mat1: 0xa740b6fa, Mat2: 0xe84d9a27, Tmat: 0x1196cc62},
+	{Mat1: 0x4bc2491b, Mat2: 0xa140436d, Tmat: 0x6e05318c},
+	{Mat1: 0x2d096ab1, Mat2: 0xcd2c257d, Tmat: 0x1d70e14d},
+	{Mat1: 0x5c1e7823, Mat2: 0x44515fe3, Tmat: 0x31bad68e},
+	{Mat1: 0x277ea449, Mat2: 0x47ed9315, Tmat: 0xeb998505},
+	{Mat1: 0x55e87a29, Mat2: 0x195ee83d, Tmat: 0xd49ce6ff},
+	{Mat1: 0x5770827e, Mat2: 0xcf2f8645, Tmat: 0xcc8dc513},
+	{Mat1: 0xa2763c17, Mat2: 0x7ae3f277, Tmat: 0x61afbcb8},
+	{Mat1: 0xddbae4f2, Mat2: 0xb5c19ad3, Tmat: 0xb935d78b},
+	{Mat1: 0x05f23428, Mat2: 0xe0ba2745, Tmat: 0xa919b0d3},
+	{Mat1: 0x397d2d39, Mat2: 0x856839cf, Tmat: 0x9742b8b1},
+	{Mat1: 0x3fc6b331, Mat2: 0x5e0e69d9, Tmat: 0x7a990c25},
+	{Mat1: 0xaafac0ee, Mat2: 0x73506499, Tmat: 0xbfe8fd19},
+	{Mat1: 0xdde1970f, Mat2: 0xd556a36f, Tmat: 0x5c1198e2},
+	{Mat1: 0xb7c58748, Mat2: 0x4f07da53, Tmat: 0x2bf12aea},
+	{Mat1: 0xb9c5e3a4, Mat2: 0xbf77cce7, Tmat: 0xb0366677},
+	{Mat1: 0xc1477d14, Mat2: 0x9a63f005, Tmat: 0xf4324d2f},
+	{Mat1: 0x69188591, Mat2: 0x78035c19, Tmat: 0xe94211ee},
+	{Mat1: 0x558605ef, Mat2: 0xd5462925, Tmat: 0x11b68e16},
+	{Mat1: 0x5fe5581f, Mat2: 0x56d8d74f, Tmat: 0x1d1cd6ee},
+	{Mat1: 0xdc8f4572, Mat2: 0x8c101f25, Tmat: 0x000dc99d},
+	{Mat1: 0xfdda85f0, Mat2: 0xe99fb875, Tmat: 0x5b722a31},
+	{Mat1: 0x1adcd720, Mat2: 0xd7b6ea79, Tmat: 0xface2ff4},
+	{Mat1: 0x9a24078b, Mat2: 0xd152560f, Tmat: 0x7d57ed7e},
+	{Mat1: 0xd2b79288, Mat2: 0x7e3387e9, Tmat: 0xc121fbdc},
+	{Mat1: 0x950aaa55, Mat2: 0xc7aaa5cf, Tmat: 0xf7f95c0d},
+	{Mat1: 0xd7cb7384, Mat2: 0x56bbb543, Tmat: 0x7f14a0c4},
+	{Mat1: 0xccefdd90, Mat2: 0x0cf8abcf, Tmat: 0x402d2737},
+	{Mat1: 0xfbb561ca, Mat2: 0x3df6d0bb, Tmat: 0x8a6df1c8},
+	{Mat1: 0xf217418a, Mat2: 0xd35b582b, Tmat: 0x349a2a0b},
+	{Mat1: 0x8567b55c, Mat2: 0x65fbb519, Tmat: 0x5186d814},
+	{Mat1: 0x8eb17e7d, Mat2: 0xaf6f4b0d, Tmat: 0x385fcf2a},
+	{Mat1: 0xb1cdbee3, Mat2: 0xcac03351, Tmat: 0x7e55c226},
+	{Mat1: 0xa9775304, Mat2: 0x548d0323, Tmat: 0x3b991e7c},
+	{Mat1: 0x5da1799b, Mat2: 0x91eb4fed, Tmat: 0xd15230a8},
+	{Mat1: 0xb201e454, Mat2: 0x5557c521, Tmat: 0x30a14658},
+	{Mat1: 0x43d7ef27, Mat2: 0xcbee2135, Tmat: 0xc6cea2fb},
+	{Mat1: 0x22bde21e, Mat2: 0xd16440c3, Tmat: 0x78ae3652},
+	{Mat1: 0xa243eb2e, Mat2: 0x1bde19eb, Tmat: 0xc2db737f},
+	{Mat1: 0x79adbaac, Mat2: 0x6f838177, Tmat: 0x31dadd65},
+	{Mat1: 0x14562bc3, Mat2: 0x7737f05f, Tmat: 0xd28a49ec},
+	{Mat1: 0xd8107c42, Mat2: 0x581bd521, Tmat: 0x46df6bdc},
+	{Mat1: 0x1aade157, Mat2: 0xdcbb5471, Tmat: 0x770b9abf},
+	{Mat1: 0xc3fb75f0, Mat2: 0x73c3fe23, Tmat: 0x23d0e60e},
+	{Mat1: 0x3c99045b, Mat2: 0x73f0d715, Tmat: 0x1d3eb08f},
+	{Mat1: 0xb6b104b6, Mat2: 0x5a006cc9, Tmat: 0x56be2458},
+	{Mat1: 0xd52f6f62, Mat2: 0xe9386f59, Tmat: 0x63e5ffcc},
+	{Mat1: 0x4e621cec, Mat2: 0x370539db, Tmat: 0x62b486ff},
+	{Mat1: 0x371a66c4, Mat2: 0x99149ed7, Tmat: 0xf0000008},
+	{Mat1: 0x435234ae, Mat2: 0x65d9bd9d, Tmat: 0x35e99abc},
+	{Mat1: 0xc04a595d, Mat2: 0x18ba847d, Tmat: 0x08765c74},
+	{Mat1: 0xf20c3f31, Mat2: 0xf90b7edf, Tmat: 0x23c4d657},
+	{Mat1: 0x89465358, Mat2: 0xdb147395, Tmat: 0x4e72d009},
+	{Mat1: 0x20dc8f86, Mat2: 0xb24e092b, Tmat: 0x1b2d7963},
+	{Mat1: 0x1470d154, Mat2: 0xdb5247e5, Tmat: 0x9564372a},
+	{Mat1: 0x8583018b, Mat2: 0x0d110a17, Tmat: 0xd02c3e8b},
+	{Mat1: 0x36b45056, Mat2: 0x46a4338f, Tmat: 0x4ec0c72e},
+	{Mat1: 0xba370d8c, Mat2: 0x08f23e4d, Tmat: 0x8e96dfd9},
+	{Mat1: 0x0b9a3e4b, Mat2: 0x0c9a4e5b, Tmat: 0x6835f91e},
+	{Mat1: 0x60b3e4bf, Mat2: 0xd27e6b55, Tmat: 0x208c1ce0},
+	{Mat1: 0x9c6d5d29, Mat2: 0x3d8f8235, Tmat: 0x275d8b18},
+	{Mat1: 0x04595ead, Mat2: 0xb3d3cfcf, Tmat: 0xb706d7ca},
+	{Mat1: 0x9b0b9fdb, Mat2: 0x6746c0bf, Tmat: 0x8fa67ceb},
+	{Mat1: 0x119b7227, Mat2: 0x2582f80f, Tmat: 0x5d83055b},
+	{Mat1: 0x22fa8dc4, Mat2: 0xa9e91ed9, Tmat: 0xd44a21ea},
+	{Mat1: 0x2f2face5, Mat2: 0xe1e9d319, Tmat: 0x2d51badd},
+	{Mat1: 0xe4286fcb, Mat2: 0x3e5ac751, Tmat: 0xeeb1c5be},
+	{Mat1: 0x2ba58614, Mat2: 0x4749ecc7, Tmat: 0x5208b173},
+	{Mat1: 0x8ceb506b, Mat2: 0xbc5d151f, Tmat: 0x4806d8c8},
+	{Mat1: 0x5c843a23, Mat2: 0x90a5104f, Tmat: 0xe6b458e9},
+	{Mat1: 0x33eb0599, Mat2: 0x7cf11655, Tmat: 0x0d1fe2f8},
+	{Mat1: 0x261b5536, Mat2: 0xb5a700dd, Tmat: 0x88094e00},
+	{Mat1: 0x86ec159c, Mat2: 0x9927306d, Tmat: 0xf2db5992},
+	{Mat1: 0x9d242113, Mat2: 0xc5d5fcf3, Tmat: 0xc667a740},
+	{Mat1: 0x4911804a, Mat2: 0xc236d12d, Tmat: 0x17b30953},
+	{Mat1: 0xd4f864ca, Mat2: 0x91139069, Tmat: 0x8d7af4a9},
+	{Mat1: 0xae58e202, Mat2: 0x446dec0d, Tmat: 0x739fd3f1},
+	{Mat1: 0x7f09261c, Mat2: 0xd8dd8883, Tmat: 0xe7f6bd24},
+	{Mat1: 0x7a2185b4, Mat2: 0xd0b065e7, Tmat: 0x2a1aae2f},
+	{Mat1: 0x7c19e100, Mat2: 0x7489b91d, Tmat: 0x820804f9},
+	{Mat1: 0x38a8b8b3, Mat2: 0xec9f210f, Tmat: 0x8d9ffc64},
+	{Mat1: 0x14916314, Mat2: 0xb2a7c27d, Tmat: 0xfece3904},
+	{Mat1: 0x69b3afd3, Mat2: 0xa7399b8f, Tmat: 0x6b923927},
+	{Mat1: 0xf9251bce, Mat2: 0x6bf4e7ef, Tmat: 0x908f4e8e},
+	{Mat1: 0xf20d0ba1, Mat2: 0x2d07e6d7, Tmat: 0x4dfaf9a4},
+	{Mat1: 0x74ec86cc, Mat2: 0xfacc0635, Tmat: 0x8b59e3c7},
+	{Mat1: 0xeb833ff5, Mat2: 0x78042fc3, Tmat: 0x9d0bc1cd},
+	{Mat1: 0x0c7d32b1, Mat2: 0x1ac24723, Tmat: 0xd162988b},
+	{Mat1: 0xd1408530, Mat2: 0x4cdf8c23, Tmat: 0x15b1614c},
+	{Mat1: 0xef943caf, Mat2: 0xf1444bfd, Tmat: 0xb64b2d84},
+	{Mat1: 0x2e354d95, Mat2: 0xb94e4d0d, Tmat: 0x4d5c811e},
+	{Mat1: 0x444c5266, Mat2: 0x2d3a6637, Tmat: 0xdb2cba6f},
+	{Mat1: 0x701f1605, Mat2: 0x7254f1c7, Tmat: 0xfb8a8a58},
+	{Mat1: 0x4d623708, Mat2: 0xead2140f, Tmat: 0x97aba891},
+	{Mat1: 0xf83a57c5, Mat2: 0xfa6e7585, Tmat: 0x8c2fc602},
+	{Mat1: 0x86a6f890, Mat2: 0x7e221ea9, Tmat: 0xae0f9a90},
+	{Mat1: 0xdbcf6299, Mat2: 0x9a3063bf, Tmat: 0x5fce328c},
+	{Mat1: 0xc8f7896b, Mat2: 0x37d1823f, Tmat: 0xd385a41d},
+	{Mat1: 0x5e7b7fed, Mat2: 0x064f17c7, Tmat: 0x7a0b715d},
+	{Mat1: 0x505073bb, Mat2: 0x81f2ee3f, Tmat: 0xee33062a},
+	{Mat1: 0x7f7fb990, Mat2: 0x1cf92a65, Tmat: 0xa7dc6036},
+	{Mat1: 0x3c29e2b5, Mat2: 0x414238f5, Tmat: 0x5b6a2031},
+	{Mat1: 0x03c621e2, Mat2: 0xc3a610f1, Tmat: 0x153c7a1e},
+	{Mat1: 0x892c7462, Mat2: 0xd4be2f23, Tmat: 0x95d2b2c9},
+	{Mat1: 0xedeb8c5d, Mat2: 0xf06e276d, Tmat: 0xcfe097e1},
+	{Mat1: 0x833512b6, Mat2: 0xeafea23d, Tmat: 0x947fd5d2},
+	{Mat1: 0x446ff844, Mat2: 0x55aa7f93, Tmat: 0xdfb0c398},
+	{Mat1: 0xff82f78d, Mat2: 0xe8ff46dd, Tmat: 0xf4cae77b},
+	{Mat1: 0x27b6bb72, Mat2: 0x9f913409, Tmat: 0x448fb18c},
+	{Mat1: 0xc1d8cb11, Mat2: 0xbe35408f, Tmat: 0x74e8aade},
+	{Mat1: 0x029fc637, Mat2: 0xc063c90d, Tmat: 0x3e852603},
+	{Mat1: 0xe510af43, Mat2: 0x59ba65df, Tmat: 0x753b6cf7},
+	{Mat1: 0xc5350883, Mat2: 0x4e9494bd, Tmat: 0x0e20993f},
+	{Mat1: 0x7e879fa7, Mat2: 0x5e2c516d, Tmat: 0x3a7a7fec},
+	{Mat1: 0x308801ef, Mat2: 0x441cadef, Tmat: 0xa50e5a71},
+	{Mat1: 0xf4cc346f, Mat2: 0x19ddf4f9, Tmat: 0x63ce01d8},
+	{Mat1: 0xb8282531, Mat2: 0xf29c16cb, Tmat: 0x6d9af27d},
+	{Mat1: 0x0b0b4245, Mat2: 0xc4bd6271, Tmat: 0x96f64b3b},
+	{Mat1: 0x19f94d08, Mat2: 0xce2e3487, Tmat: 0x524efb8f},
+	{Mat1: 0x96092b0a, Mat2: 0xe4e80fe1, Tmat: 0x4b7e26c1},
+	{Mat1: 0xf343c83e, Mat2: 0x71d09d93, Tmat: 0x4a9a979c},
+	{Mat1: 0x3c6803d1, Mat2: 0x711235e3, Tmat: 0x2473c42d},
+	{Mat1: 0xa73277eb, Mat2: 0x5c5931c3, Tmat: 0x0fea7162},
+	{Mat1: 0xf8910f97, Mat2: 0x8f39949d, Tmat: 0xec1cc08d},
+	{Mat1: 0x719badd5, Mat2: 0xae7a258f, Tmat: 0xadc2da6a},
+	{Mat1: 0xbf245f1f, Mat2: 0x99360a81, Tmat: 0xdeebdc08},
+	{Mat1: 0xb70267bb, Mat2: 0x9306139f, Tmat: 0x129c39df},
+	{Mat1: 0x52addc3c, Mat2: 0xe2ff6ec1, Tmat: 0xc454de06},
+	{Mat1: 0x911cdffd, Mat2: 0x41158433, Tmat: 0xec338f71},
+	{Mat1: 0x17cb1243, Mat2: 0x758a79f9, Tmat: 0xd228f64a},
+	{Mat1: 0x2701638c, Mat2: 0xa7f7e213, Tmat: 0xfd337fdb},
+	{Mat1: 0xdb247b48, Mat2: 0x62757f31, Tmat: 0x650f5d1a},
+	{Mat1: 0x94969827, Mat2: 0x9420f4a1, Tmat: 0x95762c46},
+	{Mat1: 0xdd6390e6, Mat2: 0x61a89b1b, Tmat: 0xc94e1e83},
+	{Mat1: 0x81fa9181, Mat2: 0x4c45269b, Tmat: 0x1e55191e},
+	{Mat1: 0x2b815320, Mat2: 0x5a8d7cdf, Tmat: 0xeef6b8db},
+	{Mat1: 0xfe3b6409, Mat2: 0x0cde45cf, Tmat: 0xa2b2765c},
+	{Mat1: 0x17230eee, Mat2: 0x560dadbd, Tmat: 0xa73df5a5},
+	{Mat1: 0x76cec38e, Mat2: 0x11aede21, Tmat: 0x2b813225},
+	{Mat1: 0xaeee171d, Mat2: 0x57961e65, Tmat: 0x2a1359b2},
+	{Mat1: 0x7f761179, Mat2: 0xcf6d5dd9, Tmat: 0xb658c4a2},
+	{Mat1: 0xe4d44f4b, Mat2: 0x05eed331, Tmat: 0xe5da7c60},
+	{Mat1: 0x724b617e, Mat2: 0xffdface9, Tmat: 0xe9ab8dbd},
+	{Mat1: 0xd67c8e49, Mat2: 0xcebcbfef, Tmat: 0x11789f1a},
+	{Mat1: 0x8771d826, Mat2: 0xf0fb4d41, Tmat: 0x432b734f},
+	{Mat1: 0xeda839a0, Mat2: 0xb062dc27, Tmat: 0x6c97f1a3},
+	{Mat1: 0x9282869b, Mat2: 0xf032adb9, Tmat: 0x0e95cf6d},
+	{Mat1: 0x2a71c9a6, Mat2: 0x1d21e849, Tmat: 0xdc9439d3},
+	{Mat1: 0xba94cbde, Mat2: 0x16bb5d71, Tmat: 0x3a856f65},
+	{Mat1: 0x29682140, Mat2: 0x4d88ff03, Tmat: 0xd81ababf},
+	{Mat1: 0xff013b0b, Mat2: 0xd33cb611, Tmat: 0xd3431d3c},
+	{Mat1: 0xab3aaecb, Mat2: 0x0e68b7fd, Tmat: 0x3012ca5f},
+	{Mat1: 0x9cda2d80, Mat2: 0x7fa9b673, Tmat: 0x3dfbe2b3},
+	{Mat1: 0x256d614a, Mat2: 0x93dd0691, Tmat: 0x4839570a},
+	{Mat1: 0xb8aafd4a, Mat2: 0xe83abc9f, Tmat: 0x3875f730},
+	{Mat1: 0xc285cd99, Mat2: 0xee736f23, Tmat: 0x96e05e6d},
+	{Mat1: 0x6fb2737c, Mat2: 0x66623915, Tmat: 0x8c467758},
+	{Mat1: 0x2dc20921, Mat2: 0xbe08548d, Tmat: 0x826125f5},
+	{Mat1: 0x1f46edbc, Mat2: 0x21175cb5, Tmat: 0x89396ec8},
+	{Mat1: 0xc1beb06c, Mat2: 0x40640483, Tmat: 0xd3904407},
+	{Mat1: 0x87ada305, Mat2: 0x1f145b4f, Tmat: 0xc9c6660d},
+	{Mat1: 0xce93631b, Mat2: 0x0bb5f0a5, Tmat: 0xa507766c},
+	{Mat1: 0x2bb06c87, Mat2: 0xa0ab54a1, Tmat: 0xe9e41a47},
+	{Mat1: 0xfe1cb089, Mat2: 0xf1d60501, Tmat: 0xf074f8ca},
+	{Mat1: 0xd6d40f5e, Mat2: 0x3b01e3f9, Tmat: 0x0b116d2f},
+	{Mat1: 0xc3bb7335, Mat2: 0x6c831271, Tmat: 0x5bb00df1},
+	{Mat1: 0x023e0023, Mat2: 0x7519490d, Tmat: 0x22ffeb3e},
+	{Mat1: 0xdf0bf36b, Mat2: 0x304d5bdb, Tmat: 0xea5844af},
+	{Mat1: 0x0d3e0ac0, Mat2: 0x18bd2b19, Tmat: 0x987bd9dd},
+	{Mat1: 0x957bc85e, Mat2: 0xc3c9d7e1, Tmat: 0xd2cd195d},
+	{Mat1: 0x9bfcfd38, Mat2: 0xab22006b, Tmat: 0x37ffd3c2},
+	{Mat1: 0xb348e88f, Mat2: 0x68773dab, Tmat: 0x6f579ccc},
+	{Mat1: 0x55404cb3, Mat2: 0xef2c8f79, Tmat: 0xf618cdee},
+	{Mat1: 0xa51b1137, Mat2: 0xe1bd53e5, Tmat: 0x2be2b61a},
+	{Mat1: 0x0655ae55, Mat2: 0x22044447, Tmat: 0x4678c956},
+	{Mat1: 0xeeac1a1b, Mat2: 0x63f9fd41, Tmat: 0x6f7db9d6},
+	{Mat1: 0xda0c6249, Mat2: 0xa9ed3857, Tmat: 0xbba95244},
+	{Mat1: 0x4477a5b7, Mat2: 0xdf9046f7, Tmat: 0x561a83dc},
+	{Mat1: 0x46a7e224, Mat2: 0x2a7cb37d, Tmat: 0x3bfd54ba},
+	{Mat1: 0x8b9e5132, Mat2: 0x47247245, Tmat: 0x58443403},
+	{Mat1: 0xafa173d2, Mat2: 0xa614b13d, Tmat: 0xfa315b28},
+	{Mat1: 0x2154e26b, Mat2: 0xaf2a7c79, Tmat: 0x96cf2990},
+	{Mat1: 0xbcb284ef, Mat2: 0x27384735, Tmat: 0x007bda2e},
+	{Mat1: 0xccdfbdcd, Mat2: 0x7864544f, Tmat: 0xd9d0e360},
+	{Mat1: 0xce00b19f, Mat2: 0x5f730671, Tmat: 0x3e4f544e},
+	{Mat1: 0xe457697d, Mat2: 0x2be97a83, Tmat: 0x47987f08},
+	{Mat1: 0x71d0f8b5, Mat2: 0x7bcf4799, Tmat: 0xe99b7c13},
+	{Mat1: 0x3638a934, Mat2: 0x177d0cb9, Tmat: 0x538dc8b6},
+	{Mat1: 0xd2679751, Mat2: 0xb1288a8b, Tmat: 0xc063434b},
+	{Mat1: 0xb159a0b5, Mat2: 0xce2f3d01, Tmat: 0x4b4500f5},
+	{Mat1: 0xf25217b4, Mat2: 0xb71d40ff, Tmat: 0x999e2a96},
+	{Mat1: 0xa36c5a50, Mat2: 0xcdb6fad3, Tmat: 0x6d34f46f},
+	{Mat1: 0xce2c1cca, Mat2: 0x9a22b43b, Tmat: 0x9e2098a3},
+	{Mat1: 0x693221f3, Mat2: 0x3153b4ad, Tmat: 0x5c57baef},
+	{Mat1: 0xf766bfaf, Mat2: 0x0bd3e617, Tmat: 0x84e800eb},
+	{Mat1: 0xf96924e0, Mat2: 0x40f20c5f, Tmat: 0xb2a889b3},
+	{Mat1: 0x18c155d5, Mat2: 0x86e4601b, Tmat: 0x22486637},
+	{Mat1: 0xca1b2394, Mat2: 0x460b1b61, Tmat: 0x3d37d0ef},
+	{Mat1: 0xd916b649, Mat2: 0xf97fe90b, Tmat: 0x485f4db2},
+	{Mat1: 0x5608cd7d, Mat2: 0x540d445d, Tmat: 0x58b71e15},
+	{Mat1: 0xe3466a8f, Mat2: 0xb31f6731, Tmat: 0x122c71e5},
+	{Mat1: 0x333762ff, Mat2: 0xdd6860cf, Tmat: 0xa93aede7},
+	{Mat1: 0x25c1f770, Mat2: 0x9115f49f, Tmat: 0x56c5ec21},
+	{Mat1: 0x1789aaf6, Mat2: 0xc7df96d5, Tmat: 0xb719ebc7},
+	{Mat1: 0xec82109d, Mat2: 0x54427c1d, Tmat: 0xcb8e6588},
+	{Mat1: 0xf4614f72, Mat2: 0xac7d7099, Tmat: 0xf1619cc0},
+	{Mat1: 0xc5b21514, Mat2: 0x2df34a7d, Tmat: 0x578632ae},
+	{Mat1: 0x188c2e97, Mat2: 0x09c4d53d, Tmat: 0x0121dd03},
+	{Mat1: 0x7f048b5a, Mat2: 0xe20aee2f, Tmat: 0xbc7933a9},
+	{Mat1: 0x29aa0d17, Mat2: 0xe99b113b, Tmat: 0x852a578f},
+	{Mat1: 0xe93d481e, Mat2: 0xfa1f506d, Tmat: 0xc9c84ae3},
+	{Mat1: 0xfaed85c1, Mat2: 0x658d3d6d, Tmat: 0x950ff516},
+	{Mat1: 0xbabe0e52, Mat2: 0xdd93cfc5, Tmat: 0xd9ff315b},
+	{Mat1: 0xbb385a99, Mat2: 0x694edd53, Tmat: 0xfb1ae6c6},
+}
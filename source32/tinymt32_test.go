@@ -0,0 +1,110 @@
+package source32_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+	"testing"
+)
+
+func TestTinyMT32(t *testing.T) {
+	/*
+	 * Data from running a C build of the reference algorithm (TinyMT 1.1), seeded with tinymt32_init(1).
+	 */
+	rng, err := source32.NewTinyMT32FromStream(source32.TinyMT32DefaultParams, []uint32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []uint32{
+		0x97b6d625, 0x3a86e2e1, 0xdd7305b1, 0x8e4ef1b0,
+		0xd60a5515, 0xe3b751f6, 0x7e073136, 0x82e5df8b,
+		0xa5e6b3a8, 0x2d91deed, 0x265623d3, 0x6c9fddbb,
+		0x348b833e, 0xfac220be, 0xdbf51b68, 0xc126a687,
+		0xae21bed2, 0xed853a63, 0xf3ecfab0, 0x02a2960b,
+		0x826f0acf, 0xad5eb023, 0x6d588b28, 0xe0fce871,
+		0xb40166b3, 0xf25371d6, 0x49e551fe, 0xf08c2465,
+		0xcb60edd4, 0x20f366d3, 0x099e39e2, 0x15157529,
+		0x08598aea, 0x08e392e8, 0x97dbe5cb, 0xf23c5e1d,
+		0xf315e1c2, 0x7aa072d3, 0x2518d670, 0x79b1596b,
+	}
+
+	r := grand.New(rng)
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("InitByArray", func(t *testing.T) {
+		/*
+		 * Data from the reference code, with a parameter set from TinyMT32Table, seeded with tinymt32_init_by_array().
+		 */
+		rng, err := source32.NewTinyMT32FromStream(source32.TinyMT32Params{Mat1: 0x88b894e1, Mat2: 0x401ed25b, Tmat: 0xee46338e}, []uint32{0x123, 0x234, 0x345, 0x456})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []uint32{
+			0xb97de904, 0x4fb8b347, 0x3354db4d, 0x5d0f07d5,
+			0xc07c5d56, 0xefab5670, 0x8d918d07, 0x1527df3c,
+			0x48a6033f, 0x0aa11139, 0x1bcb909a, 0x473e4ecc,
+			0xc45d1e48, 0x1ee933e4, 0x493a20d6, 0x59114eb0,
+			0xbafec2cd, 0xa9352686, 0xa52f1661, 0x2156c907,
+			0xf80821ce, 0xd239f256, 0x066df056, 0xeda5743f,
+			0xc16e4245, 0x2b8c2079, 0x85a4ba6a, 0xefe16a47,
+			0xe4368ed1, 0x7c34c124, 0x0f95ee1a, 0x6ea2867d,
+			0xd4579cbd, 0x02f6d1d3, 0xf4e8225f, 0x32f41b60,
+			0xcab77290, 0xcd15a1f4, 0xe6164352, 0x8ef543a6,
+		}
+
+		for i := 0; i < len(expected); i++ {
+			rg := rng.Uint32()
+			if expected[i] != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+			}
+		}
+	})
+
+	t.Run("Params", func(t *testing.T) {
+		p := source32.TinyMT32DefaultParams
+		// the characteristic polynomial of the reference code parameters
+		if want := "d8524022ed8dff4a8dcc50c798faba43"; p.CharacteristicPolynomial() != want {
+			t.Errorf("CharacteristicPolynomial() mismatch. want: %v, got: %v", want, p.CharacteristicPolynomial())
+		}
+
+		if p.Delta() != 0 {
+			t.Errorf("Delta() mismatch. want: 0, got: %v", p.Delta())
+		}
+
+		if _, err := source32.NewTinyMT32(source32.TinyMT32Params{}, 1); err == nil {
+			t.Error("No error for the zero TinyMT32Params")
+		}
+
+		p.Mat1 ^= 1
+		if _, err := source32.NewTinyMT32(p, 1); err == nil {
+			t.Error("No error for TinyMT32Params with a reducible characteristic polynomial")
+		}
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		polys := make(map[string]bool)
+		for i, p := range source32.TinyMT32Table {
+			if err := p.Validate(); err != nil {
+				t.Fatalf("TinyMT32Table[%d]: %v", i, err)
+			}
+
+			f := p.CharacteristicPolynomial()
+			if polys[f] {
+				t.Fatalf("TinyMT32Table[%d]: duplicate characteristic polynomial %v", i, f)
+			}
+			polys[f] = true
+		}
+
+		for i, p := range source32.SearchTinyMT32Params(1, 2) {
+			if p != source32.TinyMT32Table[i] {
+				t.Errorf("SearchTinyMT32Params mismatch at index %d. want: %+v, got: %+v", i, source32.TinyMT32Table[i], p)
+			}
+		}
+	})
+}
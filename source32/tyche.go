@@ -0,0 +1,125 @@
+package source32
+
+const (
+	tyche_r = 3
+	// the constants of the initial state, before the 20 rounds of init().
+	tyche_c uint32 = 2654435769
+	tyche_d uint32 = 1367130551
+)
+
+// The state of Tyche and Tyche-i, and the initialization of the reference code from a 64-bit seed and
+// a 32-bit index.
+type tycheState struct {
+	a, b, c, d uint32
+}
+
+func (ts *tycheState) init(seed []uint32, mix func()) {
+	ts.a = seed[1]
+	ts.b = seed[0]
+	ts.c = tyche_c
+	ts.d = tyche_d ^ seed[2]
+	for i := 0; i < 20; i++ {
+		mix()
+	}
+}
+
+// One quarter-round of ChaCha.
+func (ts *tycheState) mix() {
+	ts.a += ts.b
+	ts.d = rotateLeft(ts.d^ts.a, 16)
+	ts.c += ts.d
+	ts.b = rotateLeft(ts.b^ts.c, 12)
+	ts.a += ts.b
+	ts.d = rotateLeft(ts.d^ts.a, 8)
+	ts.c += ts.d
+	ts.b = rotateLeft(ts.b^ts.c, 7)
+}
+
+// The inverse of mix(), used by Tyche-i: each line undoes one line of mix() in the reverse order, so c -= d
+// comes before d is rotated back.
+func (ts *tycheState) mixInverse() {
+	ts.b = rotateLeft(ts.b, 25) ^ ts.c
+	ts.c -= ts.d
+	ts.d = rotateLeft(ts.d, 24) ^ ts.a
+	ts.a -= ts.b
+	ts.b = rotateLeft(ts.b, 20) ^ ts.c
+	ts.c -= ts.d
+	ts.d = rotateLeft(ts.d, 16) ^ ts.a
+	ts.a -= ts.b
+}
+
+// Implements Tyche, from Samuel Neves and Filipe Araujo, which iterates the quarter-round of ChaCha on a
+// 128-bit state and returns one of its words.
+//
+// The state size is 128-bits; as the quarter-round is a bijection there is no single period, but the expected
+// period from a random state is about 2^127. The all-zero state is a fixed point of the quarter-round, which the
+// seeding of the reference code can't reach.
+//
+// Samuel Neves and Filipe Araujo, Fast and Small Nonlinear Pseudorandom Number Generators for Computer Simulation.
+// Parallel Processing and Applied Mathematics, 2012, LNCS 7203, 92--101.
+type Tyche struct {
+	baseSource32
+	tycheState
+}
+
+// The stream holds the 64-bit seed (the low word first) and the 32-bit index of the reference init(),
+// the missing values being 0.
+func NewTycheFromStream(seed []uint32) (*Tyche, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(Tyche)
+	ans.spi = ans
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewTyche(seed int64) *Tyche {
+	ans := new(Tyche)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (ty *Tyche) setSeed(seed []uint32) {
+	ty.stream = make([]uint32, tyche_r)
+	copy(ty.stream, seed)
+	ty.Restart()
+}
+
+func (ty *Tyche) Seed(seed int64) {
+	seeds := make([]uint32, tyche_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < tyche_r {
+		v := seeder.Uint32()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	ty.setSeed(seeds)
+}
+
+func (ty *Tyche) Restart() {
+	ty.init(ty.stream, ty.mix)
+	ty.resetState()
+}
+
+func (ty *Tyche) Uint32() uint32 {
+	ty.mix()
+	return ty.b
+}
+
+// Returns two consecutive outputs of Uint32(), the first one in the high 32 bits.
+func (ty *Tyche) Uint64() uint64 {
+	return uint64(ty.Uint32())<<32 | uint64(ty.Uint32())
+}
+
+func (ty *Tyche) String() string {
+	return "Tyche"
+}
@@ -0,0 +1,76 @@
+package source32
+
+// Implements Tyche-i, the variant of Tyche that iterates the inverse of the quarter-round of ChaCha,
+// which has more parallelism and so is faster.
+//
+// The state size is 128-bits; as for Tyche, there is no single period but the expected period from a random
+// state is about 2^127, and the seeding of the reference code can't reach the all-zero fixed point.
+//
+// Samuel Neves and Filipe Araujo, Fast and Small Nonlinear Pseudorandom Number Generators for Computer Simulation.
+// Parallel Processing and Applied Mathematics, 2012, LNCS 7203, 92--101.
+type TycheI struct {
+	baseSource32
+	tycheState
+}
+
+// The stream holds the 64-bit seed (the low word first) and the 32-bit index of the reference init(),
+// the missing values being 0.
+func NewTycheIFromStream(seed []uint32) (*TycheI, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(TycheI)
+	ans.spi = ans
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewTycheI(seed int64) *TycheI {
+	ans := new(TycheI)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (ty *TycheI) setSeed(seed []uint32) {
+	ty.stream = make([]uint32, tyche_r)
+	copy(ty.stream, seed)
+	ty.Restart()
+}
+
+func (ty *TycheI) Seed(seed int64) {
+	seeds := make([]uint32, tyche_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < tyche_r {
+		v := seeder.Uint32()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	ty.setSeed(seeds)
+}
+
+func (ty *TycheI) Restart() {
+	ty.init(ty.stream, ty.mixInverse)
+	ty.resetState()
+}
+
+func (ty *TycheI) Uint32() uint32 {
+	ty.mixInverse()
+	return ty.a
+}
+
+// Returns two consecutive outputs of Uint32(), the first one in the high 32 bits.
+func (ty *TycheI) Uint64() uint64 {
+	return uint64(ty.Uint32())<<32 | uint64(ty.Uint32())
+}
+
+func (ty *TycheI) String() string {
+	return "TycheI"
+}
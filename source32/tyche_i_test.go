@@ -0,0 +1,51 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+)
+
+func TestTycheI(t *testing.T) {
+	rng, err := source32.NewTycheIFromStream([]uint32{0x89abcdef, 0x01234567, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from a C port of the MIXi and init() of Neves and Araujo (MIXi being the exact inverse of MIX),
+	 * seeded with init(0x0123456789abcdef, 7), returning a. Not checked against the reference code.
+	 */
+	expected := []uint32{
+		0x980285ee, 0x012958d2, 0xe8f5260e, 0xebcccfc4,
+		0xb4c07f60, 0xcecf6eed, 0xcbf3128f, 0x26b22e17,
+		0x07125952, 0x1b755f49, 0xf56b911b, 0xfc0b78ed,
+		0xc5856e10, 0xa900d4e1, 0x3d5a3494, 0x594925dc,
+		0x7ec5e89f, 0x7d3d59ac, 0xa2720d82, 0x8b09d5db,
+		0x2289b182, 0x11981287, 0x7d263efc, 0x71d48fc4,
+		0x98a2c67b, 0xcc2c5e2f, 0x1f6c025d, 0xd8579b79,
+		0x80483ee5, 0xa923e239, 0xd5d7fe68, 0x1ffe7633,
+		0x304a8566, 0x431a11a8, 0xb7dbc205, 0x828c2b5d,
+		0xfd1f5cc2, 0xaedcf71b, 0xf72cb922, 0x13650049,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Uint64", func(t *testing.T) {
+		var src grand.Source64 = rng
+		src.Restart()
+		for i := 0; i+1 < len(expected); i += 2 {
+			want := uint64(expected[i])<<32 | uint64(expected[i+1])
+			if rg := src.Uint64(); want != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", want, rg)
+			}
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+)
+
+func TestTyche(t *testing.T) {
+	rng, err := source32.NewTycheFromStream([]uint32{0x89abcdef, 0x01234567, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from a C port of the MIX and init() of Neves and Araujo, seeded with init(0x0123456789abcdef, 7),
+	 * returning b. Not checked against the reference code.
+	 */
+	expected := []uint32{
+		0x15969cc9, 0x80139b36, 0x7d1da76d, 0x22f961ac,
+		0x5ffe9dca, 0xe412287d, 0x451d27d1, 0x5a38f296,
+		0x9f397b3d, 0x95be91ed, 0xf0c73998, 0x21f3ab7a,
+		0xc0930ec9, 0x28d6cbc5, 0xa63b3587, 0xd546505a,
+		0x8ac32478, 0x54f73cfc, 0x524f4bdf, 0x386ec60d,
+		0x237f1c98, 0x209749d5, 0xd3880b20, 0x79674599,
+		0xdbf1a027, 0xb6b8342f, 0x922a0109, 0x13b7d6fc,
+		0xb40e7311, 0x34cef45b, 0x84c9f558, 0x3cf730ab,
+		0xbb4fb19a, 0x2eeec3d6, 0x7abcaaa0, 0x1d94db26,
+		0x997b47b8, 0x082ade98, 0x80dda775, 0x1d142a01,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Uint64", func(t *testing.T) {
+		var src grand.Source64 = rng
+		src.Restart()
+		for i := 0; i+1 < len(expected); i += 2 {
+			want := uint64(expected[i])<<32 | uint64(expected[i+1])
+			if rg := src.Uint64(); want != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", want, rg)
+			}
+		}
+	})
+}
@@ -4,11 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
-)
 
-const (
-	two17 int = 131072
-	two53 int = 9007199254740992
+	"github.com/jtejido/grand/internal/modmath"
 )
 
 func checkEmptySeed(seed []uint32) error {
@@ -63,6 +60,17 @@ func fillState(state, seed []uint32) {
 	}
 }
 
+// Checks that the state of a generator for which the all-zero state is a fixed point is not all 0.
+func checkZeroState(state []uint32) error {
+	for _, v := range state {
+		if v != 0 {
+			return nil
+		}
+	}
+
+	return errors.New("The state must not be all 0")
+}
+
 func scramble(n, mult uint64, shift, add uint) uint64 {
 	return mult*(n^(n>>shift)) + uint64(add)
 }
@@ -77,77 +85,58 @@ func rotateLeft(x uint32, k int) uint32 {
 	return x<<s | x>>(n-s)
 }
 
-func checkMRGSeed(seed []uint32, r int, m1, m2 uint32) error {
-	for i := 0; i < r; i++ {
-		if seed[i] == 0 {
-			return fmt.Errorf("seed values must not be 0.")
+// Checks the seed of a combined multiple recursive generator, where the seed of the j-th
+// component is made of the next orders[j] values.
+// The seed values of a component must be less than its modulus and must not be all 0.
+func checkMRGSeed(seed []uint32, orders []int, moduli []uint32) error {
+	var r int
+	for _, k := range orders {
+		r += k
+	}
+
+	if len(seed) < r {
+		return fmt.Errorf("The stream must have at least %d values", r)
+	}
+
+	var i int
+	for j, k := range orders {
+		zero := true
+		for _, v := range seed[i : i+k] {
+			if v >= moduli[j] {
+				return fmt.Errorf("The seed values of component %d must be less than %d", j+1, moduli[j])
+			}
+
+			if v != 0 {
+				zero = false
+			}
 		}
 
-		if (i == 0 || i == 1 || i == 2) && seed[i] >= m1 {
-			return fmt.Errorf("The first 3 seed values must be less than %d", m1)
-		} else if seed[i] >= m2 {
-			return fmt.Errorf("The last 3 seed values must be less than %d", m2)
+		if zero {
+			return fmt.Errorf("The seed values of component %d must not be all 0.", j+1)
 		}
+
+		i += k
 	}
 
 	return nil
 }
 
-//multiply the first half of substream by A with a modulo of m1 and the second half by B with a modulo of m2
-func multMatVect(substream []uint32, A [][]uint32, m1 uint32, B [][]uint32, m2 uint32) {
-	vv := make([]uint32, 3)
+// multiply the first half of substream by A with a modulo of m1 and the second half by B with a modulo of m2
+func multMatVect(substream []uint32, A modmath.Matrix, m1 uint32, B modmath.Matrix, m2 uint32) {
+	vv := make([]uint64, 3)
 	for i := 0; i < 3; i++ {
-		vv[i] = substream[i]
+		vv[i] = uint64(substream[i])
 	}
-	matVecModM(A, vv, vv, m1)
+	A.MulVec(vv, vv, uint64(m1))
 	for i := 0; i < 3; i++ {
-		substream[i] = vv[i]
+		substream[i] = uint32(vv[i])
 	}
 
 	for i := 0; i < 3; i++ {
-		vv[i] = substream[i+3]
+		vv[i] = uint64(substream[i+3])
 	}
-	matVecModM(B, vv, vv, m2)
+	B.MulVec(vv, vv, uint64(m2))
 	for i := 0; i < 3; i++ {
-		substream[i+3] = vv[i]
+		substream[i+3] = uint32(vv[i])
 	}
 }
-
-func matVecModM(A [][]uint32, s, v []uint32, m uint32) {
-
-	x := make([]uint32, len(v))
-	for i := 0; i < len(v); i++ {
-		x[i] = 0
-		for j := 0; j < len(s); j++ {
-			x[i] = multModM(int(A[i][j]), int(s[j]), int(x[i]), int(m))
-		}
-	}
-
-	for i := 0; i < len(v); i++ {
-		v[i] = x[i]
-	}
-}
-
-func multModM(a, s, c, m int) uint32 {
-	var a1 int
-	v := a*s + c
-
-	if v >= two53 || v <= -two53 {
-		a1 = (a / two17)
-		a -= a1 * two17
-		v = a1 * s
-		a1 = (v / m)
-		v -= a1 * m
-		v = v*two17 + a*s + c
-	}
-
-	a1 = (v / m)
-	v -= a1 * m
-
-	if v < 0 {
-		v += m
-		return uint32(v)
-	}
-
-	return uint32(v)
-}
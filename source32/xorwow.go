@@ -0,0 +1,206 @@
+package source32
+
+import (
+	"sync"
+
+	"github.com/jtejido/grand/internal/gf2"
+)
+
+const (
+	// the xorshift state v[0..4], followed by the Weyl sequence d.
+	xorwow_r = 6
+	xorwow_n = 5
+	// the increment of the Weyl sequence.
+	xorwow_weyl uint32 = 362437
+	// Jump() skips 2^xorwow_jump values, the distance between the subsequences of cuRAND.
+	xorwow_jump = 67
+	// 2^-32 and 2^-53, the scales of curand_uniform() and curand_uniform_double().
+	xorwow_inv32 float32 = 1.0 / (1 << 32)
+	xorwow_inv53 float64 = 1.0 / (1 << 53)
+)
+
+// xorwowSkip holds the characteristic polynomial of the xorshift part, and x^(2^67) modulo it.
+// They are computed once, when first needed.
+var xorwowSkip struct {
+	once      sync.Once
+	chi, jump gf2.Poly
+}
+
+func xorwowPolys() (chi, jump gf2.Poly) {
+	xorwowSkip.once.Do(func() {
+		v := [xorwow_n]uint32{1}
+		seq := make([]uint8, 2*32*xorwow_n)
+		for i := range seq {
+			xorwowStep(&v)
+			seq[i] = uint8(v[xorwow_n-1] & 1)
+		}
+
+		xorwowSkip.chi = gf2.MinimalPolynomial(seq)
+		xorwowSkip.jump = gf2.Mod(gf2.Monomial(1), xorwowSkip.chi)
+		for i := 0; i < xorwow_jump; i++ {
+			xorwowSkip.jump = gf2.MulMod(xorwowSkip.jump, xorwowSkip.jump, xorwowSkip.chi)
+		}
+	})
+
+	return xorwowSkip.chi, xorwowSkip.jump
+}
+
+// Advances the xorshift part of the generator.
+func xorwowStep(v *[xorwow_n]uint32) {
+	t := v[0] ^ (v[0] >> 2)
+	v[0] = v[1]
+	v[1] = v[2]
+	v[2] = v[3]
+	v[3] = v[4]
+	v[4] = (v[4] ^ (v[4] << 4)) ^ (t ^ (t << 1))
+}
+
+// Replaces the xorshift state v by q(A)v, where A is the transition matrix: if q = x^k mod the
+// characteristic polynomial of A, this advances v by k steps.
+func xorwowApply(v []uint32, q gf2.Poly) {
+	var st, ans [xorwow_n]uint32
+	copy(st[:], v)
+	for i := 0; i <= q.Degree(); i++ {
+		if q.Coeff(i) != 0 {
+			for j := range ans {
+				ans[j] ^= st[j]
+			}
+		}
+		xorwowStep(&st)
+	}
+
+	copy(v, ans[:])
+}
+
+// Implements XORWOW, the default generator of NVIDIA's cuRAND: Marsaglia's xorshift generator on 160 bits
+// of which the output is added to a Weyl sequence of step 362437. NewCuRANDXORWOW() has the semantics of
+// curand_init(seed, subsequence, offset), whose subsequences are 2^67 values apart, and Uint32() returns
+// the values of curand().
+//
+// The skips use the characteristic polynomial of the xorshift part (the Weyl sequence is advanced by
+// 362437*offset): Jump() moves to the next subsequence.
+//
+// The state size is 192 bits; the period is 2^32*(2^160-1).
+//
+// George Marsaglia, Xorshift RNGs. Journal of Statistical Software, 2003, 8-14, 1--6.
+// https://docs.nvidia.com/cuda/curand/device-api-overview.html
+type XORWOW struct {
+	baseJumpableSource32
+	v [xorwow_n]uint32
+	d uint32
+}
+
+// The stream holds the xorshift state v[0..4] (filled if shorter, which must not be all 0), followed by d.
+func NewXORWOWFromStream(seed []uint32) (*XORWOW, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp := make([]uint32, xorwow_r)
+	fillState(tmp, seed)
+	err = checkZeroState(tmp[:xorwow_n])
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(XORWOW)
+	ans.spi = ans
+	ans.setSeed(tmp)
+	return ans, nil
+}
+
+// NewXORWOW returns the generator of curand_init(seed, 0, 0).
+func NewXORWOW(seed int64) *XORWOW {
+	ans := new(XORWOW)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+// NewCuRANDXORWOW returns the generator of curand_init(seed, subsequence, offset): the state seeded as cuRAND
+// does, skipped ahead by subsequence*2^67 + offset values.
+func NewCuRANDXORWOW(seed, subsequence, offset uint64) *XORWOW {
+	ans := new(XORWOW)
+	ans.spi = ans
+	ans.setCuRANDSeed(seed, subsequence, offset)
+	return ans
+}
+
+func (xw *XORWOW) setSeed(seed []uint32) {
+	xw.stream = append([]uint32{}, seed...)
+	xw.Restart()
+}
+
+func (xw *XORWOW) setCuRANDSeed(seed, subsequence, offset uint64) {
+	// Break up seed, apply salt
+	s0 := uint32(seed) ^ 0xaad26b49
+	s1 := uint32(seed>>32) ^ 0xf7dcefdd
+	// Simple multiplication to mix up bits
+	t0 := 1099087573 * s0
+	t1 := 2591861531 * s1
+	state := []uint32{
+		123456789 + t0,
+		362436069 ^ t0,
+		521288629 + t1,
+		88675123 ^ t1,
+		5783321 + t0,
+		6615241 + t1 + t0,
+	}
+
+	if subsequence != 0 || offset != 0 {
+		chi, jump := xorwowPolys()
+		q := gf2.MulMod(gf2.PowMod(jump, subsequence, chi), gf2.PowMod(gf2.Monomial(1), offset, chi), chi)
+		xorwowApply(state[:xorwow_n], q)
+		// 2^67 values add a multiple of 2^32 to d.
+		state[xorwow_n] += xorwow_weyl * uint32(offset)
+	}
+
+	xw.setSeed(state)
+}
+
+// Seed uses the seed as curand_init(seed, 0, 0) does.
+func (xw *XORWOW) Seed(seed int64) {
+	xw.setCuRANDSeed(uint64(seed), 0, 0)
+}
+
+func (xw *XORWOW) Restart() {
+	xw.substream = append([]uint32{}, xw.stream...)
+	xw.RestartSubstream()
+}
+
+func (xw *XORWOW) RestartSubstream() {
+	copy(xw.v[:], xw.substream)
+	xw.d = xw.substream[xorwow_n]
+	xw.resetState()
+}
+
+// Jump advances the start of the current substream by 2^67 values, to the next subsequence of cuRAND.
+func (xw *XORWOW) Jump() {
+	_, jump := xorwowPolys()
+	xorwowApply(xw.substream[:xorwow_n], jump)
+	xw.RestartSubstream()
+}
+
+func (xw *XORWOW) Uint32() uint32 {
+	xorwowStep(&xw.v)
+	xw.d += xorwow_weyl
+	return xw.v[xorwow_n-1] + xw.d
+}
+
+// Float32 returns the value of curand_uniform(), in (0, 1].
+func (xw *XORWOW) Float32() float32 {
+	return float32(xw.Uint32())*xorwow_inv32 + xorwow_inv32/2
+}
+
+// Float64 returns the value of curand_uniform_double(), in (0, 1]: two values of Uint32() give 53 bits.
+func (xw *XORWOW) Float64() float64 {
+	x := uint64(xw.Uint32())
+	y := uint64(xw.Uint32())
+	z := x ^ (y << (53 - 32))
+	return float64(z)*xorwow_inv53 + xorwow_inv53/2
+}
+
+func (xw *XORWOW) String() string {
+	return "XORWOW"
+}
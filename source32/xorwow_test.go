@@ -0,0 +1,101 @@
+package source32_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand/source32"
+)
+
+func TestXORWOW(t *testing.T) {
+	rng := source32.NewCuRANDXORWOW(1234, 0, 0)
+
+	/*
+	 * Data from curand_init(1234, 0, 0, &state) and curand(&state), computed by an independent model of
+	 * curand_kernel.h (jumps by GF(2) matrix powers), not captured on a device.
+	 */
+	expected := []uint32{
+		0x253d5e15, 0x6f558604, 0xdee6daf2, 0x7485b394, 0xdb6a6a85, 0x95195c6b, 0x338f343f, 0xc9682545,
+		0x4cff66d5, 0x5de5c108, 0x93ad0e4a, 0xb0400511, 0x80d73481, 0xb97a90a5, 0xc9ced284, 0x95269734,
+		0x245d7680, 0x65cd15d4, 0x16510965, 0xa897cd6a, 0xc5ab68df, 0x2b8a0a07, 0x617b4c5b, 0xf0d0ac6e,
+		0x1b0051d5, 0xeeab7cdc, 0x70018679, 0xbf5c79ad, 0x1ebe3670, 0x8628ac52, 0x0bf0f9e0, 0x46d2163a,
+		0xe0fa1bf5, 0x6f408426, 0x6dbbf695, 0xef93cdfd, 0x8f57c1a9, 0x4f3fbb5f, 0xb85a7c9a, 0xb7f6deb5,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := rng.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Seed", func(t *testing.T) {
+		rng := source32.NewXORWOW(1234)
+		if got := rng.Uint32(); got != expected[0] {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[0], got)
+		}
+	})
+
+	t.Run("Subsequence", func(t *testing.T) {
+		// curand_init(1234, 1, 0) and curand_init(1234, 3, 10).
+		cases := []struct {
+			subsequence, offset uint64
+			expected            []uint32
+		}{
+			{1, 0, []uint32{0xd1f7605a, 0xed29ae49, 0x83030ed9, 0x899fbaf4}},
+			{3, 10, []uint32{0x67e8c6b1, 0x962bc824, 0x5349ec6c, 0x6067fd7c}},
+		}
+
+		for _, c := range cases {
+			rng := source32.NewCuRANDXORWOW(1234, c.subsequence, c.offset)
+			for i := range c.expected {
+				if rg := rng.Uint32(); c.expected[i] != rg {
+					t.Errorf("Mismatch. want: %v, got: %v", c.expected[i], rg)
+				}
+			}
+		}
+
+		rng := source32.NewCuRANDXORWOW(1234, 0, 10)
+		rng.Jump()
+		rng.Jump()
+		rng.Jump()
+		if got := rng.Uint32(); got != cases[1].expected[0] {
+			t.Errorf("Jump() should move to the next subsequence. want: %v, got: %v", cases[1].expected[0], got)
+		}
+	})
+
+	t.Run("Offset", func(t *testing.T) {
+		// curand_init(0xdeadbeefcafe, 0, 1<<40).
+		want := []uint32{0x3fdfde9e, 0xf755b12e, 0x30bf0a3b, 0x98606343}
+		rng := source32.NewCuRANDXORWOW(0xdeadbeefcafe, 0, 1<<40)
+		for i := range want {
+			if rg := rng.Uint32(); want[i] != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", want[i], rg)
+			}
+		}
+
+		rng = source32.NewCuRANDXORWOW(1234, 0, 25)
+		if got := rng.Uint32(); got != expected[25] {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[25], got)
+		}
+	})
+
+	t.Run("Float", func(t *testing.T) {
+		rng := source32.NewCuRANDXORWOW(1234, 0, 0)
+		want32 := float32(expected[0])/(1<<32) + 1.0/(1<<33)
+		if got := rng.Float32(); got != want32 {
+			t.Errorf("Mismatch. want: %v, got: %v", want32, got)
+		}
+
+		z := uint64(expected[1]) ^ uint64(expected[2])<<21
+		want64 := float64(z)/(1<<53) + 1.0/(1<<54)
+		if got := rng.Float64(); got != want64 {
+			t.Errorf("Mismatch. want: %v, got: %v", want64, got)
+		}
+	})
+
+	t.Run("ZeroState", func(t *testing.T) {
+		if _, err := source32.NewXORWOWFromStream([]uint32{0, 0, 0, 0, 0, 1}); err == nil {
+			t.Errorf("The xorshift state should not be all 0.")
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package source32
+
+// An all-purpose 32-bit generator, recommended by the authors over XoShiRo128StarStar.
+//
+// This is a member of the Xor-Shift-Rotate family of generators. Memory footprint is 128
+// bits.
+//
+// http://xoshiro.di.unimi.it/xoshiro128plusplus.c
+// http://xoshiro.di.unimi.it/
+type XoShiRo128PlusPlus struct {
+	baseXoShiRo128
+}
+
+func NewXoShiRo128PlusPlusFromStream(seed []uint32) (*XoShiRo128PlusPlus, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	ans := new(XoShiRo128PlusPlus)
+	ans.spi = ans
+	if len(seed) < xoshiro128_r {
+		tmp := make([]uint32, xoshiro128_r)
+		fillState(tmp, seed)
+		ans.setSeed(tmp)
+	} else {
+		ans.setSeed(seed)
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewXoShiRo128PlusPlus(seed int64) *XoShiRo128PlusPlus {
+	ans := new(XoShiRo128PlusPlus)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (xoshiro *XoShiRo128PlusPlus) Uint32() uint32 {
+	result := rotateLeft(xoshiro.state[0]+xoshiro.state[3], 7) + xoshiro.state[0]
+
+	t := xoshiro.state[1] << 9
+
+	xoshiro.state[2] ^= xoshiro.state[0]
+	xoshiro.state[3] ^= xoshiro.state[1]
+	xoshiro.state[1] ^= xoshiro.state[2]
+	xoshiro.state[0] ^= xoshiro.state[3]
+
+	xoshiro.state[2] ^= t
+
+	xoshiro.state[3] = rotateLeft(xoshiro.state[3], 11)
+
+	return result
+}
+
+func (xoshiro *XoShiRo128PlusPlus) String() string {
+	return "XoShiRo128PlusPlus"
+}
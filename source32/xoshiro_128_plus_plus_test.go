@@ -0,0 +1,42 @@
+package source32_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source32"
+	"testing"
+)
+
+func TestXoShiRo128PlusPlus(t *testing.T) {
+	/*
+	 * Data from running the executable compiled from the author's C code:
+	 * http://xoshiro.di.unimi.it/xoshiro128plusplus.c
+	 */
+	rng, err := source32.NewXoShiRo128PlusPlusFromStream([]uint32{0x012de1ba, 0xa5a818b8, 0xb124ea2b, 0x18e03749})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint32{
+		0x083a6347, 0xaf13e949, 0xc170e7f6, 0x1fff4fb2,
+		0x683f45ee, 0x0447edcf, 0x42e85ced, 0xaf636b74,
+		0xb0087a5e, 0x75bf2669, 0xd1bce8bd, 0x421fc05e,
+		0x1c6c405f, 0x14ddbffd, 0xaeacb705, 0x977ae584,
+		0x2ac01aac, 0xc474ec71, 0xe0888022, 0xf94bc227,
+		0x32775b57, 0x44142b05, 0x525f6d9b, 0xa2721e61,
+		0x1bfe5c72, 0x17be23c2, 0x3231cc54, 0x8776866e,
+		0x9ede2587, 0x0f7f144e, 0xb6f2ff9d, 0x1556365b,
+		0x9e68aef3, 0x254010c3, 0x0b885bdd, 0x7c3f26bb,
+		0xc8266de6, 0xcd2e6587, 0x0cbec249, 0xa69b37ba,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+}
@@ -0,0 +1,150 @@
+package source64
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	mwc128_a uint64 = 0xffebb71d94fcdaf9
+	mwc192_a uint64 = 0xffa04e67b3c95d86
+	mwc256_a uint64 = 0xff377e26f82da74a
+)
+
+var (
+	mwc128_params = newMWCParams(mwc128_a, 1, 64, 96)
+	mwc192_params = newMWCParams(mwc192_a, 2, 96, 144)
+	mwc256_params = newMWCParams(mwc256_a, 3, 128, 192)
+)
+
+// mwcParams describes a multiply-with-carry generator with base b = 2^64, multiplier a and lag r.
+//
+// As all MWC generators, it simulates a multiplicative LCG with the prime modulus m = a*b^r - 1
+// and the multiplier b^-1 mod m: the state (x(n-r+1), ..., x(n), c(n)) maps to
+//
+//	s(n) = c(n) + a*(x(n-r+1) + x(n-r+2)*b + ... + x(n)*b^(r-1))
+//
+// and s(n+1) = b^-1 * s(n) mod m. The jumps are multiplications by b^-k mod m.
+type mwcParams struct {
+	a uint64
+	r int
+	// m is the modulus of the LCG, jump and longJump are the multipliers of the jumps.
+	m, jump, longJump *big.Int
+}
+
+// Returns the parameters of the MWC generator with multiplier a and lag r,
+// with jumps of 2^jump and 2^longJump steps.
+func newMWCParams(a uint64, r int, jump, longJump uint) *mwcParams {
+	A := new(big.Int).SetUint64(a)
+	m := new(big.Int).Lsh(A, uint(64*r))
+	m.Sub(m, big.NewInt(1))
+
+	// b^-1 = a*b^(r-1) mod m, since a*b^r = 1 mod m
+	inv := new(big.Int).Lsh(A, uint(64*(r-1)))
+	pow2 := func(e uint) *big.Int {
+		return new(big.Int).Exp(inv, new(big.Int).Lsh(big.NewInt(1), e), m)
+	}
+
+	return &mwcParams{a: a, r: r, m: m, jump: pow2(jump), longJump: pow2(longJump)}
+}
+
+// This is a base for the 64-bit multiply-with-carry generators from Sebastiano Vigna.
+// http://prng.di.unimi.it/
+type baseMWC64 struct {
+	baseJumpableSource64
+	params *mwcParams
+	// state holds the r words of the lag, the oldest first, followed by the carry.
+	state [4]uint64
+}
+
+// The stream holds the r words of the lag (the oldest first) followed by the carry c,
+// which must satisfy 0 < c < a-1 (this excludes the degenerate states).
+// If the stream is too short, it is filled and the carry is reduced into that range.
+func (bm *baseMWC64) setSeed(seed []uint64) error {
+	r, a := bm.params.r, bm.params.a
+	if len(seed) <= r {
+		tmp := make([]uint64, r+1)
+		fillState(tmp, seed)
+		tmp[r] = tmp[r]%(a-2) + 1
+		seed = tmp
+	}
+
+	if c := seed[r]; c == 0 || c >= a-1 {
+		return fmt.Errorf("The carry must be greater than 0 and less than %d", a-1)
+	}
+
+	bm.stream = append([]uint64{}, seed[:r+1]...)
+	bm.Restart()
+	return nil
+}
+
+func (bm *baseMWC64) Seed(seed int64) {
+	r := bm.params.r
+	seeds := make([]uint64, r+1)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < len(seeds) {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// reduce the carry into (0, a-1)
+	seeds[r] = seeds[r]%(bm.params.a-2) + 1
+
+	// Initialize the pool content.
+	bm.setSeed(seeds)
+}
+
+func (bm *baseMWC64) Restart() {
+	bm.substream = append([]uint64{}, bm.stream...)
+	bm.RestartSubstream()
+}
+
+func (bm *baseMWC64) RestartSubstream() {
+	copy(bm.state[:], bm.substream)
+	bm.resetState()
+}
+
+// Jump advances the start of the current substream by 2^64 (MWC128), 2^96 (MWC192)
+// or 2^128 (MWC256) calls to Uint64().
+func (bm *baseMWC64) Jump() {
+	bm.advance(bm.params.jump)
+}
+
+// LongJump advances the start of the current substream by 2^96 (MWC128), 2^144 (MWC192)
+// or 2^192 (MWC256) calls to Uint64().
+// It can be used to generate starting points for distributed computations, each of which
+// then uses Jump() for its substreams.
+func (bm *baseMWC64) LongJump() {
+	bm.advance(bm.params.longJump)
+}
+
+// Multiplies the start of the current substream by mult, as a state of the equivalent LCG.
+func (bm *baseMWC64) advance(mult *big.Int) {
+	r := bm.params.r
+	A := new(big.Int).SetUint64(bm.params.a)
+
+	s := new(big.Int)
+	for i := r - 1; i >= 0; i-- {
+		s.Lsh(s, 64)
+		s.Or(s, new(big.Int).SetUint64(bm.substream[i]))
+	}
+	s.Mul(s, A)
+	s.Add(s, new(big.Int).SetUint64(bm.substream[r]))
+
+	s.Mul(s, mult)
+	s.Mod(s, bm.params.m)
+
+	x, c := s.QuoRem(s, A, new(big.Int))
+	bm.substream[r] = c.Uint64()
+	mask := new(big.Int).SetUint64(^uint64(0))
+	w := new(big.Int)
+	for i := 0; i < r; i++ {
+		bm.substream[i] = w.And(x, mask).Uint64()
+		x.Rsh(x, 64)
+	}
+
+	bm.RestartSubstream()
+}
@@ -0,0 +1,99 @@
+package source64
+
+const (
+	xoroshiro1024_r = 16
+)
+
+var (
+	xoroshiro1024_pw = [...]uint64{
+		0x931197d8e3177f17, 0xb59422e0b9138c5f, 0xf06a6afb49d668bb, 0xacb8a6412c8a1401,
+		0x12304ec85f0b3468, 0xb7dfe7079209891e, 0x405b7eec77d9eb14, 0x34ead68280c44e4a,
+		0xe0e4ba3e0ac9e366, 0x8f46eda8348905b7, 0x328bf4dbad90d6ff, 0xc8fd6fb31c9effc3,
+		0xe899d452d4b67652, 0x45f387286ade3205, 0x03864f454a8920bd, 0xa68fa28725b1b384,
+	}
+)
+
+// This implements 64-bit generators with 1024-bits of state.
+// http://xoshiro.di.unimi.it/
+type baseXoRoShiRo1024 struct {
+	baseJumpableSource64
+	xoroshiro1024Core
+}
+
+// The state of xoroshiro1024 and its update, shared by the scramblers and the LXM generators.
+type xoroshiro1024Core struct {
+	state [16]uint64
+	index uint64
+}
+
+func (bx *baseXoRoShiRo1024) setSeed(seed []uint64) {
+	bx.stream = append([]uint64{}, seed...)
+	bx.Restart()
+}
+
+func (bx *baseXoRoShiRo1024) Seed(seed int64) {
+	seeds := make([]uint64, xoroshiro1024_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < xoroshiro1024_r {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	bx.setSeed(seeds)
+}
+
+func (bx *baseXoRoShiRo1024) Restart() {
+	bx.substream = append([]uint64{}, bx.stream...)
+	bx.RestartSubstream()
+}
+
+func (bx *baseXoRoShiRo1024) RestartSubstream() {
+	for i := 0; i < xoroshiro1024_r; i++ {
+		bx.state[i] = bx.substream[i]
+	}
+
+	bx.index = 0
+	bx.resetState()
+}
+
+// The jump size is the equivalent of 2^512 calls to Uint64().
+// It can provide up to 2^512 non-overlapping subsequences.
+func (bx *baseXoRoShiRo1024) Jump() {
+	bx.RestartSubstream()
+	s := make([]uint64, xoroshiro1024_r)
+
+	for i := 0; i < len(xoroshiro1024_pw); i++ {
+		for b := 0; b < 64; b++ {
+			if (xoroshiro1024_pw[i] & (1 << uint64(b))) != 0 {
+				for j := 0; j < len(bx.state); j++ {
+					s[j] ^= bx.state[(uint64(j)+bx.index)&15]
+				}
+			}
+			bx.Uint64()
+		}
+	}
+
+	// The state is stored starting from the current index, so the substream can be restarted at index 0.
+	bx.substream = append([]uint64{}, s...)
+
+	bx.RestartSubstream()
+}
+
+// Advances the state, returning the two words used by the scramblers:
+// s0 (the word at the new index) and s15 (the word at the previous index).
+func (xc *xoroshiro1024Core) next() (s0, s15 uint64) {
+	q := xc.index
+	xc.index = (xc.index + 1) & 15
+	s0 = xc.state[xc.index]
+	s15 = xc.state[q]
+
+	t := s15 ^ s0
+	xc.state[q] = rotateLeft(s0, 25) ^ t ^ (t << 27)
+	xc.state[xc.index] = rotateLeft(t, 36)
+
+	return
+}
@@ -5,16 +5,32 @@ const (
 )
 
 var (
-	xoroshiro128_pw = [...]uint64{
+	xoroshiro128_pw = []uint64{
 		0xdf900294d8f554a5, 0x170865df4b3201fc,
 	}
+	// XoRoShiRo128PlusPlus uses different shifts and rotations, so it needs its own jump polynomial.
+	xoroshiro128pp_pw = []uint64{
+		0x2bd7a6a6e99c2ddc, 0x0992ccaf6a6fca05,
+	}
 )
 
+// The state of xoroshiro128 1.0 and its update, shared by the scramblers and the LXM generators.
+type xoroshiro128Core struct {
+	state [2]uint64
+}
+
+func (xc *xoroshiro128Core) next() {
+	s0 := xc.state[0]
+	s1 := xc.state[1] ^ s0
+	xc.state[0] = rotateLeft(s0, 24) ^ s1 ^ (s1 << 16) // a, b
+	xc.state[1] = rotateLeft(s1, 37)                   // c
+}
+
 // This implements 64-bit generators with 128-bits of state.
 // http://xoshiro.di.unimi.it/
 type baseXoRoShiRo128 struct {
 	baseJumpableSource64
-	state [2]uint64
+	xoroshiro128Core
 }
 
 func (bx *baseXoRoShiRo128) setSeed(seed []uint64) {
@@ -53,11 +69,16 @@ func (bx *baseXoRoShiRo128) RestartSubstream() {
 // The jump size is the equivalent of 2^64 calls to Uint64().
 // It can provide up to 2^64 non-overlapping subsequences.
 func (bx *baseXoRoShiRo128) Jump() {
+	bx.jump(xoroshiro128_pw)
+}
+
+func (bx *baseXoRoShiRo128) jump(pw []uint64) {
+	bx.RestartSubstream()
 	s := make([]uint64, xoroshiro128_r)
 
-	for i := 0; i < len(xoroshiro128_pw); i++ {
+	for i := 0; i < len(pw); i++ {
 		for b := 0; b < 64; b++ {
-			if (xoroshiro128_pw[i] & (1 << uint64(b))) != 0 {
+			if (pw[i] & (1 << uint64(b))) != 0 {
 				for j := 0; j < len(bx.state); j++ {
 					s[j] ^= bx.state[j]
 				}
@@ -15,9 +15,27 @@ var (
 // http://xoshiro.di.unimi.it/
 type baseXoShiRo256 struct {
 	baseJumpableSource64
+	xoshiro256Core
+}
+
+// The state of xoshiro256 and its update, shared by the scramblers and the LXM generators.
+type xoshiro256Core struct {
 	state [4]uint64
 }
 
+func (xc *xoshiro256Core) next() {
+	t := xc.state[1] << 17
+
+	xc.state[2] ^= xc.state[0]
+	xc.state[3] ^= xc.state[1]
+	xc.state[1] ^= xc.state[2]
+	xc.state[0] ^= xc.state[3]
+
+	xc.state[2] ^= t
+
+	xc.state[3] = rotateLeft(xc.state[3], 45)
+}
+
 func (bx *baseXoShiRo256) setSeed(seed []uint64) {
 	bx.stream = append([]uint64{}, seed...)
 	bx.Restart()
@@ -52,6 +70,7 @@ func (bx *baseXoShiRo256) RestartSubstream() {
 }
 
 func (bx *baseXoShiRo256) Jump() {
+	bx.RestartSubstream()
 	s := make([]uint64, xoshiro256_r)
 
 	for i := 0; i < len(xoshiro256_pw); i++ {
@@ -67,5 +86,5 @@ func (bx *baseXoShiRo256) Jump() {
 
 	bx.substream = append([]uint64{}, s...)
 
-	bx.Restart()
+	bx.RestartSubstream()
 }
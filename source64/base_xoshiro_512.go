@@ -53,6 +53,7 @@ func (bx *baseXoShiRo512) RestartSubstream() {
 }
 
 func (bx *baseXoShiRo512) Jump() {
+	bx.RestartSubstream()
 	s := make([]uint64, xoshiro512_r)
 
 	for i := 0; i < len(xoshiro512_pw); i++ {
@@ -68,5 +69,5 @@ func (bx *baseXoShiRo512) Jump() {
 
 	bx.substream = append([]uint64{}, s...)
 
-	bx.Restart()
+	bx.RestartSubstream()
 }
@@ -0,0 +1,153 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+var sources = []struct {
+	name string
+	src  func() grand.Source
+}{
+	{"CombinedMRG", func() grand.Source {
+		src, _ := source64.NewCombinedMRG(source64.MRG32k3AParams, 1)
+		return src
+	}},
+	{"GoALFG", func() grand.Source { return source64.NewGoALFG(1) }},
+	{"JSF", func() grand.Source { return source64.NewJSF(1) }},
+	{"L128X1024MixRandom", func() grand.Source { return source64.NewL128X1024MixRandom(1) }},
+	{"L64X128MixRandom", func() grand.Source { return source64.NewL64X128MixRandom(1) }},
+	{"L64X256MixRandom", func() grand.Source { return source64.NewL64X256MixRandom(1) }},
+	{"LFSR258", func() grand.Source { return source64.NewLFSR258(1) }},
+	{"MIXMAX17", func() grand.Source {
+		src, _ := source64.NewMIXMAX(source64.MIXMAX17Params, 1)
+		return src
+	}},
+	{"MIXMAX240", func() grand.Source {
+		src, _ := source64.NewMIXMAX(source64.MIXMAX240Params, 1)
+		return src
+	}},
+	{"MIXMAX256", func() grand.Source {
+		src, _ := source64.NewMIXMAX(source64.MIXMAX256Params, 1)
+		return src
+	}},
+	{"MRG63k3A", func() grand.Source { return source64.NewMRG63k3A(1) }},
+	{"MT19937", func() grand.Source { return source64.NewMT19937(1) }},
+	{"MWC128", func() grand.Source { return source64.NewMWC128(1) }},
+	{"MWC192", func() grand.Source { return source64.NewMWC192(1) }},
+	{"MWC256", func() grand.Source { return source64.NewMWC256(1) }},
+	{"RANLUX48", func() grand.Source {
+		src, _ := source64.NewRANLUX48(source64.RANLUX48Luxury[4], 1)
+		return src
+	}},
+	{"RANLUXPlusPlus", func() grand.Source {
+		src, _ := source64.NewRANLUXPlusPlus(source64.RANLUXPlusPlusDefaultLuxury, 1)
+		return src
+	}},
+	{"RomuDuo", func() grand.Source { return source64.NewRomuDuo(1) }},
+	{"RomuDuoJr", func() grand.Source { return source64.NewRomuDuoJr(1) }},
+	{"RomuQuad", func() grand.Source { return source64.NewRomuQuad(1) }},
+	{"RomuTrio", func() grand.Source { return source64.NewRomuTrio(1) }},
+	{"SFC", func() grand.Source { return source64.NewSFC(1) }},
+	{"Squares", func() grand.Source { return source64.NewSquares(1) }},
+	{"SplitMix64", func() grand.Source { return source64.NewSplitMix64(1) }},
+	{"TinyMT64", func() grand.Source {
+		src, _ := source64.NewTinyMT64(source64.TinyMT64DefaultParams, 1)
+		return src
+	}},
+	{"WyRand", func() grand.Source { return source64.NewWyRand(1) }},
+	{"XorShift1024Star", func() grand.Source { return source64.NewXorShift1024Star(1) }},
+	{"XorShift1024StarPhi", func() grand.Source { return source64.NewXorShift1024StarPhi(1) }},
+	{"XoRoShiRo1024PlusPlus", func() grand.Source { return source64.NewXoRoShiRo1024PlusPlus(1) }},
+	{"XoRoShiRo1024Star", func() grand.Source { return source64.NewXoRoShiRo1024Star(1) }},
+	{"XoRoShiRo1024StarStar", func() grand.Source { return source64.NewXoRoShiRo1024StarStar(1) }},
+	{"XoRoShiRo128Plus", func() grand.Source { return source64.NewXoRoShiRo128Plus(1) }},
+	{"XoRoShiRo128PlusPlus", func() grand.Source { return source64.NewXoRoShiRo128PlusPlus(1) }},
+	{"XoRoShiRo128StarStar", func() grand.Source { return source64.NewXoRoShiRo128StarStar(1) }},
+	{"XoShiRo256Plus", func() grand.Source { return source64.NewXoShiRo256Plus(1) }},
+	{"XoShiRo256PlusPlus", func() grand.Source { return source64.NewXoShiRo256PlusPlus(1) }},
+	{"XoShiRo256StarStar", func() grand.Source { return source64.NewXoShiRo256StarStar(1) }},
+	{"XoShiRo512Plus", func() grand.Source { return source64.NewXoShiRo512Plus(1) }},
+	{"XoShiRo512PlusPlus", func() grand.Source { return source64.NewXoShiRo512PlusPlus(1) }},
+	{"XoShiRo512StarStar", func() grand.Source { return source64.NewXoShiRo512StarStar(1) }},
+}
+
+// The results are accumulated here so the compiler can't optimize the calls away.
+var (
+	sink32   uint32
+	sink64   uint64
+	sinkF64  float64
+	sinkBool bool
+	sinkInt  int
+)
+
+func BenchmarkUint32(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sink32 = r.Uint32()
+			}
+		})
+	}
+}
+
+func BenchmarkUint64(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sink64 = r.Uint64()
+			}
+		})
+	}
+}
+
+func BenchmarkFloat64(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sinkF64 = r.Float64()
+			}
+		})
+	}
+}
+
+func BenchmarkBool(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sinkBool = r.Bool()
+			}
+		})
+	}
+}
+
+func BenchmarkIntn(b *testing.B) {
+	for _, s := range sources {
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.New(s.src())
+			for i := 0; i < b.N; i++ {
+				sinkInt = r.Intn(1000)
+			}
+		})
+	}
+}
+
+func BenchmarkJump(b *testing.B) {
+	for _, s := range sources {
+		js, ok := s.src().(grand.JumpableSource)
+		if !ok {
+			continue
+		}
+		b.Run(s.name, func(b *testing.B) {
+			r := grand.NewJumpable(js)
+			for i := 0; i < b.N; i++ {
+				r.Jump()
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package source64
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/jtejido/grand/internal/modmath"
+)
+
+// MRGComponent defines a multiple recursive generator of order k = len(A):
+//
+//	x(n) = (A[0]*x(n-1) + A[1]*x(n-2) + ... + A[k-1]*x(n-k)) mod M
+//
+// Negative coefficients must be given as M - |a|.
+type MRGComponent struct {
+	M uint64
+	A []uint64
+}
+
+// CombinedMRGParams defines a combined multiple recursive generator (see CombinedMRG).
+type CombinedMRGParams struct {
+	Name       string
+	Components []MRGComponent
+	// Jump() advances the stream by 2^JumpExponent values.
+	JumpExponent uint
+}
+
+// Presets of the generators from L'Ecuyer's papers.
+// MRG32k3A, MRG32k3P and MRG63k3A (the types) are faster implementations of the same streams.
+var (
+	// Good Parameter Sets for Combined Multiple Recursive Random Number Generators.
+	// Operations Research, 1999, 47-1, 159--164
+	MRG32k3AParams = CombinedMRGParams{
+		Name: "MRG32k3A",
+		Components: []MRGComponent{
+			{M: 4294967087, A: []uint64{0, 1403580, 4294967087 - 810728}},
+			{M: 4294944443, A: []uint64{527612, 0, 4294944443 - 1370589}},
+		},
+		JumpExponent: 76,
+	}
+
+	// Fast Combined Multiple Recursive Generators with Multipliers of the Form a=±2q±2r.
+	// Proceedings of the 2000 Winter Simulation Conference, Dec. 2000, 683--689
+	// This is also known as MRG31k3p.
+	MRG32k3PParams = CombinedMRGParams{
+		Name: "MRG32k3P",
+		Components: []MRGComponent{
+			{M: 2147483647, A: []uint64{0, 1 << 22, 1<<7 + 1}},
+			{M: 2147462579, A: []uint64{1 << 15, 0, 1<<15 + 1}},
+		},
+		JumpExponent: 72,
+	}
+
+	// Good Parameter Sets for Combined Multiple Recursive Random Number Generators.
+	// Operations Research, 1999, 47-1, 159--164
+	MRG63k3AParams = CombinedMRGParams{
+		Name: "MRG63k3A",
+		Components: []MRGComponent{
+			{M: mrg63k3a_m1, A: []uint64{0, mrg63k3a_a12, mrg63k3a_m1 - mrg63k3a_a13n}},
+			{M: mrg63k3a_m2, A: []uint64{mrg63k3a_a21, 0, mrg63k3a_m2 - mrg63k3a_a23n}},
+		},
+		JumpExponent: mrg63k3a_jump,
+	}
+)
+
+// This implements a combined multiple recursive generator from user-defined parameters,
+// following L'Ecuyer's construction.
+//
+// The J components are computed independently, and combined as
+//
+//	z(n) = (x1(n) - x2(n) + x3(n) - ...) mod M1
+//
+// Uint64() returns z(n) if z(n) > 0, or M1 otherwise, so the output lies in [1, M1].
+// If M1 < 2^32, Uint32() returns the same value, otherwise it returns the halves of Uint64().
+// The jump matrices are derived from the parameters, i.e. A^(2^JumpExponent) mod M for each component.
+//
+// The stream holds the seeds of the components one after another (the oldest value first),
+// the seed of each component must be less than its modulus and must not be all 0.
+//
+// Pierre L'Ecuyer, Combined Multiple Recursive Random Number Generators.
+// Operations Research, 1996, 44-5, 816--822
+type CombinedMRG struct {
+	baseJumpableSource64
+	params CombinedMRGParams
+	// state holds the last k values of each component, the oldest value first.
+	state [][]uint64
+	jumps []modmath.Matrix
+	// narrow is true if the output fits in 32-bits.
+	narrow bool
+	norm   float64
+	r      int
+}
+
+func NewCombinedMRGFromStream(params CombinedMRGParams, seed []uint64) (*CombinedMRG, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans, err := newCombinedMRG(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(seed) < ans.r {
+		tmp := make([]uint64, ans.r)
+		fillState(tmp, seed)
+		// reduce the filled values so they are valid seeds
+		for i, j := 0, 0; j < len(ans.state); j++ {
+			for range ans.state[j] {
+				if i >= len(seed) {
+					tmp[i] = tmp[i]%(ans.params.Components[j].M-1) + 1
+				}
+				i++
+			}
+		}
+		seed = tmp
+	}
+
+	err = ans.setSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewCombinedMRG(params CombinedMRGParams, seed int64) (*CombinedMRG, error) {
+	ans, err := newCombinedMRG(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.Seed(seed)
+	return ans, nil
+}
+
+func newCombinedMRG(params CombinedMRGParams) (*CombinedMRG, error) {
+	if len(params.Components) == 0 {
+		return nil, errors.New("at least one component is required")
+	}
+
+	ans := new(CombinedMRG)
+	ans.spi = ans
+	ans.params.Name = params.Name
+	ans.params.JumpExponent = params.JumpExponent
+	ans.params.Components = make([]MRGComponent, len(params.Components))
+	ans.state = make([][]uint64, len(params.Components))
+	ans.jumps = make([]modmath.Matrix, len(params.Components))
+
+	for j, c := range params.Components {
+		if c.M < 2 {
+			return nil, fmt.Errorf("The modulus of component %d must be greater than 1", j+1)
+		}
+
+		k := len(c.A)
+		if k == 0 || c.A[k-1] == 0 {
+			return nil, fmt.Errorf("The last coefficient of component %d must not be 0", j+1)
+		}
+
+		for _, a := range c.A {
+			if a >= c.M {
+				return nil, fmt.Errorf("The coefficients of component %d must be less than %d", j+1, c.M)
+			}
+		}
+
+		ans.params.Components[j] = MRGComponent{M: c.M, A: append([]uint64{}, c.A...)}
+		ans.state[j] = make([]uint64, k)
+		ans.jumps[j] = modmath.Companion(c.A, c.M).Pow2(params.JumpExponent, c.M)
+		ans.r += k
+	}
+
+	ans.narrow = params.Components[0].M < 1<<32
+	ans.norm = 1 / (float64(params.Components[0].M) + 1)
+	return ans, nil
+}
+
+func (mrg *CombinedMRG) orders() []int {
+	ans := make([]int, len(mrg.state))
+	for j := range mrg.state {
+		ans[j] = len(mrg.state[j])
+	}
+
+	return ans
+}
+
+func (mrg *CombinedMRG) moduli() []uint64 {
+	ans := make([]uint64, len(mrg.params.Components))
+	for j, c := range mrg.params.Components {
+		ans[j] = c.M
+	}
+
+	return ans
+}
+
+func (mrg *CombinedMRG) setSeed(seed []uint64) (err error) {
+	err = checkMRGSeed(seed, mrg.orders(), mrg.moduli())
+	if err != nil {
+		return
+	}
+
+	mrg.stream = append([]uint64{}, seed[:mrg.r]...)
+
+	mrg.Restart()
+	return nil
+}
+
+func (mrg *CombinedMRG) Restart() {
+	mrg.substream = append([]uint64{}, mrg.stream...)
+	mrg.RestartSubstream()
+}
+
+func (mrg *CombinedMRG) RestartSubstream() {
+	var i int
+	for j := range mrg.state {
+		i += copy(mrg.state[j], mrg.substream[i:])
+	}
+
+	mrg.resetState()
+}
+
+func (mrg *CombinedMRG) Jump() {
+	var i int
+	for j, c := range mrg.params.Components {
+		k := len(c.A)
+		mrg.jumps[j].MulVec(mrg.substream[i:i+k], mrg.substream[i:i+k], c.M)
+		i += k
+	}
+
+	mrg.RestartSubstream()
+}
+
+func (mrg *CombinedMRG) Uint64() uint64 {
+	m1 := mrg.params.Components[0].M
+	var z uint64
+	for j, c := range mrg.params.Components {
+		s := mrg.state[j]
+		k := len(s)
+
+		var x uint64
+		for i, a := range c.A {
+			if a != 0 {
+				x = modmath.MulAdd(a, s[k-1-i], x, c.M)
+			}
+		}
+
+		copy(s, s[1:])
+		s[k-1] = x
+
+		if j&1 == 0 {
+			z = modmath.Add(z, x%m1, m1)
+		} else {
+			z = modmath.Sub(z, x%m1, m1)
+		}
+	}
+
+	if z == 0 {
+		return m1
+	}
+
+	return z
+}
+
+func (mrg *CombinedMRG) Uint32() uint32 {
+	if mrg.narrow {
+		return uint32(mrg.Uint64())
+	}
+
+	return mrg.baseJumpableSource64.Uint32()
+}
+
+// Returns the normalized output z(n)/(M1+1), a float64 in the open interval (0,1).
+// This advances the same stream as Uint64().
+func (mrg *CombinedMRG) Float64() float64 {
+	return float64(mrg.Uint64()) * mrg.norm
+}
+
+func (mrg *CombinedMRG) Seed(seed int64) {
+	seeds := make([]uint64, mrg.r)
+	seeder.Seed(seed)
+	var i int
+	for _, c := range mrg.params.Components {
+		mask := uint64(1)<<uint(bits.Len64(c.M-1)) - 1
+		for range c.A {
+		again:
+			f := seeder.Uint64() & mask
+			if f == 0 || f >= c.M {
+				goto again
+			}
+			seeds[i] = f
+			i++
+		}
+	}
+
+	// Initialize the pool content.
+	mrg.setSeed(seeds)
+}
+
+func (mrg *CombinedMRG) String() string {
+	if mrg.params.Name != "" {
+		return mrg.params.Name
+	}
+
+	return "CombinedMRG"
+}
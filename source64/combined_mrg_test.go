@@ -0,0 +1,158 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source32"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestCombinedMRG(t *testing.T) {
+	// The presets must reproduce the streams (and substreams) of the dedicated implementations.
+	seed := []uint64{12345, 12345, 12345, 12345, 12345, 12345}
+
+	t.Run("MRG32k3A", func(t *testing.T) {
+		mrg, err := source64.NewCombinedMRGFromStream(source64.MRG32k3AParams, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref, err := source32.NewMRG32k3AFromStream([]uint32{12345, 12345, 12345, 12345, 12345, 12345})
+		if err != nil {
+			t.Fatal(err)
+		}
+		compareMRG(t, mrg, ref)
+	})
+
+	t.Run("MRG32k3P", func(t *testing.T) {
+		// MRG32k3P keeps the most recent value of each component first.
+		mrg, err := source64.NewCombinedMRGFromStream(source64.MRG32k3PParams, []uint64{3, 2, 1, 6, 5, 4})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref, err := source32.NewMRG32k3PFromStream([]uint32{1, 2, 3, 4, 5, 6})
+		if err != nil {
+			t.Fatal(err)
+		}
+		compareMRG(t, mrg, ref)
+	})
+
+	t.Run("MRG63k3A", func(t *testing.T) {
+		mrg, err := source64.NewCombinedMRGFromStream(source64.MRG63k3AParams, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref, err := source64.NewMRG63k3AFromStream(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compareMRG(t, mrg, ref)
+	})
+
+	t.Run("Float64", func(t *testing.T) {
+		mrg, err := source64.NewCombinedMRGFromStream(source64.MRG32k3AParams, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref, err := source32.NewMRG32k3AFromStream([]uint32{12345, 12345, 12345, 12345, 12345, 12345})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 100; i++ {
+			if want, got := ref.Float64(), mrg.Float64(); want != got {
+				t.Fatalf("Mismatch. want: %v, got: %v", want, got)
+			}
+		}
+	})
+}
+
+func compareMRG(t *testing.T, mrg *source64.CombinedMRG, ref grand.JumpableSource) {
+	for j := 0; j < 3; j++ {
+		for i := 0; i < 100; i++ {
+			if want, got := ref.Uint32(), mrg.Uint32(); want != got {
+				t.Fatalf("Mismatch in substream %d at index %d. want: %v, got: %v", j, i, want, got)
+			}
+		}
+		ref.Jump()
+		mrg.Jump()
+	}
+}
+
+func TestCombinedMRGParams(t *testing.T) {
+	params := []source64.CombinedMRGParams{
+		{},
+		{Components: []source64.MRGComponent{{M: 1, A: []uint64{0}}}},
+		{Components: []source64.MRGComponent{{M: 7, A: []uint64{3, 0}}}},
+		{Components: []source64.MRGComponent{{M: 7, A: []uint64{3, 7}}}},
+	}
+
+	for _, p := range params {
+		if _, err := source64.NewCombinedMRG(p, 1); err == nil {
+			t.Errorf("No error for invalid parameters %v", p)
+		}
+	}
+
+	seeds := [][]uint64{
+		{0, 0, 0, 1, 1, 1},
+		{1, 1, 1, 0, 0, 0},
+		{4294967087, 1, 1, 1, 1, 1},
+		{1, 1, 1, 1, 1, 4294944443},
+	}
+
+	for _, s := range seeds {
+		if _, err := source64.NewCombinedMRGFromStream(source64.MRG32k3AParams, s); err == nil {
+			t.Errorf("No error for invalid seed %v", s)
+		}
+	}
+
+	if _, err := source64.NewCombinedMRGFromStream(source64.MRG32k3AParams, []uint64{0, 0, 1, 0, 1, 0}); err != nil {
+		t.Errorf("Error for valid seed: %v", err)
+	}
+}
+
+func TestCombinedMRGWide(t *testing.T) {
+	// With M1 = 2^32, the output M1 does not fit in 32 bits: both components stay at 5, so z(n) = 0 and
+	// Uint64() returns M1, which Uint32() must return as its halves.
+	params := source64.CombinedMRGParams{
+		Components: []source64.MRGComponent{{M: 1 << 32, A: []uint64{1}}, {M: 1 << 32, A: []uint64{1}}},
+	}
+	mrg, err := source64.NewCombinedMRGFromStream(params, []uint64{5, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rg := mrg.Uint64(); rg != 1<<32 {
+		t.Errorf("Mismatch. want: %v, got: %v", uint64(1<<32), rg)
+	}
+
+	mrg.Restart()
+	expected := []uint32{1, 0, 1, 0}
+	for i := 0; i < len(expected); i++ {
+		rg := mrg.Uint32()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+}
+
+func TestCombinedMRGConformance(t *testing.T) {
+	// A single MRG with a small modulus, but with the full period of 7^3 - 1.
+	custom := source64.CombinedMRGParams{
+		Components:   []source64.MRGComponent{{M: 7, A: []uint64{1, 0, 2}}},
+		JumpExponent: 4,
+	}
+
+	for _, p := range []source64.CombinedMRGParams{source64.MRG32k3AParams, source64.MRG32k3PParams, source64.MRG63k3AParams, custom} {
+		p := p
+		t.Run(p.Name, func(t *testing.T) {
+			grandtest.RunSourceTests(t, func() grand.Source {
+				mrg, err := source64.NewCombinedMRG(p, 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return mrg
+			})
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	for _, s := range sources {
+		t.Run(s.name, func(t *testing.T) {
+			grandtest.RunSourceTests(t, s.src)
+		})
+	}
+}
+
+func TestReferenceVectors(t *testing.T) {
+	t.Run("XoShiRo256PlusJump", func(t *testing.T) {
+		rng, err := source64.NewXoShiRo256PlusFromStream([]uint64{0x012de1babb3c4104, 0xa5a818b8fc5aa503, 0xb124ea2b701f4993, 0x18e0374933d8c782})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The jump starts from the beginning of the substream
+		for i := 0; i < 10; i++ {
+			rng.Uint64()
+		}
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/xoshiro256plus_jump.txt")
+	})
+
+	t.Run("XorShift1024StarPhiJump", func(t *testing.T) {
+		rng, err := source64.NewXorShift1024StarPhiFromStream([]uint64{
+			0xa485f9024766e407, 0xa7f3dbcd8a22270b, 0xa621bd88cdee660f, 0xa11f9e5210aba113,
+			0xa04d701d5477e017, 0xa2bb52d89f33231b, 0xade934a3e2ff621f, 0xacc7156d25b8ad23,
+			0xaf34f7286944ec27, 0xae62c9f3ac002f2b, 0xa950abbef7cc6e2f, 0xab8e8c783a89a933,
+			0xaafc6ec37e55e837, 0xb52a408ec1112b3b, 0xb418214804dd6a3f, 0xb77603134f9eb543,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/xorshift1024starphi_jump.txt")
+	})
+	t.Run("XoRoShiRo128PlusPlusJump", func(t *testing.T) {
+		rng, err := source64.NewXoRoShiRo128PlusPlusFromStream([]uint64{0x012de1babb3c4104, 0xa5a818b8fc5aa503})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/xoroshiro128plusplus_jump.txt")
+	})
+
+	t.Run("XoRoShiRo1024PlusPlusJump", func(t *testing.T) {
+		rng, err := source64.NewXoRoShiRo1024PlusPlusFromStream([]uint64{
+			0x012de1babb3c4104, 0xa5a818b8fc5aa503, 0xb124ea2b701f4993, 0x18e0374933d8c782,
+			0x2af8df668d68ad55, 0x76e56f59daa06243, 0xf58c016f0f01e30f, 0x8eeafa41683dbbf4,
+			0x7bf121347c06677f, 0x4fd0c88d25db5ccb, 0x99af3be9ebe0a272, 0x94f2b33b74d0bdcb,
+			0x24b5d9d7a00a3140, 0x79d983d781a34a3c, 0x582e4a84d595f5ec, 0x7316fe8b0f606d20,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The jump starts from the beginning of the substream
+		for i := 0; i < 10; i++ {
+			rng.Uint64()
+		}
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/xoroshiro1024plusplus_jump.txt")
+	})
+}
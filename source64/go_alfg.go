@@ -0,0 +1,120 @@
+package source64
+
+const (
+	goalfg_len      = 607
+	goalfg_tap      = 273
+	goalfg_mask     = 1<<63 - 1
+	goalfg_int32max = 1<<31 - 1
+)
+
+// Implements the source of Go's math/rand (rand.NewSource), the additive lagged Fibonacci generator
+// x(n) = x(n-607) + x(n-273) mod 2^64 of DP Mitchell and JA Reeds, with Go's seeding: the 607 words are
+// drawn from the LCG x(n+1) = 48271*x(n) mod (2^31-1) and xored with a table of cooked values.
+// Uint64() and Int63() return the values of the Source64 of math/rand for the same seed.
+//
+// The state size is 4856 bytes; the period is (2^607-1)*2^63 when the state holds an odd value.
+//
+// https://pkg.go.dev/math/rand
+type GoALFG struct {
+	baseSource64
+	vec       [goalfg_len]int64
+	tap, feed int
+}
+
+// The first value is the seed of rand.NewSource().
+func NewGoALFGFromStream(seed []uint64) (*GoALFG, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(GoALFG)
+	ans.spi = ans
+	ans.Seed(int64(seed[0]))
+	return ans, nil
+}
+
+// NewGoALFG returns the generator of rand.NewSource(seed).
+func NewGoALFG(seed int64) *GoALFG {
+	ans := new(GoALFG)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+// seed the LCG x(n+1) = 48271*x(n) mod (2^31-1), with Schrage's method.
+func goalfgSeedrand(x int32) int32 {
+	const (
+		a = 48271
+		q = 44488
+		r = 3399
+	)
+
+	hi := x / q
+	lo := x % q
+	x = a*lo - r*hi
+	if x < 0 {
+		x += goalfg_int32max
+	}
+
+	return x
+}
+
+// Seed uses the seed as rand.Seed() does: it is reduced modulo 2^31-1, and 0 is replaced by 89482311.
+func (ga *GoALFG) Seed(seed int64) {
+	ga.stream = []uint64{uint64(seed)}
+	ga.Restart()
+}
+
+func (ga *GoALFG) Restart() {
+	ga.tap = 0
+	ga.feed = goalfg_len - goalfg_tap
+
+	seed := int64(ga.stream[0]) % goalfg_int32max
+	if seed < 0 {
+		seed += goalfg_int32max
+	}
+	if seed == 0 {
+		seed = 89482311
+	}
+
+	x := int32(seed)
+	for i := -20; i < goalfg_len; i++ {
+		x = goalfgSeedrand(x)
+		if i >= 0 {
+			u := int64(x) << 40
+			x = goalfgSeedrand(x)
+			u ^= int64(x) << 20
+			x = goalfgSeedrand(x)
+			u ^= int64(x)
+			ga.vec[i] = u ^ goalfg_cooked[i]
+		}
+	}
+
+	ga.resetState()
+}
+
+// Int63 returns the value of Int63() of math/rand's source, a non-negative 63-bit integer.
+func (ga *GoALFG) Int63() int64 {
+	return int64(ga.Uint64() & goalfg_mask)
+}
+
+func (ga *GoALFG) Uint64() uint64 {
+	ga.tap--
+	if ga.tap < 0 {
+		ga.tap += goalfg_len
+	}
+
+	ga.feed--
+	if ga.feed < 0 {
+		ga.feed += goalfg_len
+	}
+
+	x := ga.vec[ga.feed] + ga.vec[ga.tap]
+	ga.vec[ga.feed] = x
+	return uint64(x)
+}
+
+func (ga *GoALFG) String() string {
+	return "GoALFG"
+}
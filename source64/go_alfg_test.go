@@ -0,0 +1,53 @@
+package source64_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestGoALFG(t *testing.T) {
+	rng, err := source64.NewGoALFGFromStream([]uint64{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from rand.NewSource(42).(rand.Source64).Uint64() of math/rand.
+	 */
+	expected := []uint64{
+		0xafbf64b1967f8c53, 0x8872b44b9fbb971b, 0x4d52f284145b9fe8, 0x1aba923e34d9c909,
+		0x859bd7df529ddd09, 0x310c7a619b42764d, 0x680c5ba53b0d9f9f, 0xb13585884c14d6c0,
+		0xb1079b70e0cb1a84, 0xd2bc75d3613f0858, 0x5e2919f9f41db402, 0x9be5826f9eda8fe1,
+		0xae4b9ee7818e744e, 0x0f84badc4ce36fbd, 0xd4fe4f8c3ed6e83e, 0xbbac8f6d54eaeb1c,
+		0x5b2ece04ac1add13, 0xdba2cf79557c87a2, 0x7888aeaf0b1b8ec7, 0xfc428a1051a7821b,
+		0x0f4643aa3c903ced, 0x2b8a97160662785a, 0xa4aef3c4c6e30ced, 0x1d12575b49e0c37b,
+		0x538728100cbefdfe, 0x855f882dcdda00e1, 0x76a100da07b62c69, 0x10a296e771701c1c,
+		0x39b2745b5ac22ddc, 0x1b053f275e7029e1, 0x2f052b8e822623c9, 0xdc61108e6517386e,
+		0xf24144f3fd478949, 0xffa83f0216c1d40e, 0x88ff27ed6f6b57e3, 0xfc9afdcac0ba7489,
+		0x77e71936b19256d0, 0x6d8d9ec7fd4d9638, 0x9366fd1c66734353, 0x5049891b1eec4fd7,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("MathRand", func(t *testing.T) {
+		for _, seed := range []int64{0, 1, -1, 1<<31 - 1, 1 << 40, -123456789} {
+			rng := source64.NewGoALFG(seed)
+			src := rand.NewSource(seed)
+			for i := 0; i < 2000; i++ {
+				want, got := src.Int63(), rng.Int63()
+				if want != got {
+					t.Fatalf("Mismatch for seed %d at %d. want: %v, got: %v", seed, i, want, got)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package source64
+
+import (
+	"math/bits"
+
+	"github.com/jtejido/grand"
+)
+
+const (
+	// the LCG parameters ah, al, sh and sl, followed by the xoroshiro1024 state.
+	l128x1024_r = 20
+)
+
+// Implements L128X1024MixRandom of java.util.random (Java 17), a LXM generator from Guy Steele and Sebastiano Vigna:
+// the sum of the high half of a 128-bit LCG and of xoroshiro1024, mixed by mixLea64(). Memory footprint is 1280 bits
+// (the additive parameter a of the LCG selects one of 2^127 streams), the period is 2^128*(2^1024-1).
+//
+// Split() returns a new generator seeded from this one, as SplittableGenerator.split() does.
+//
+// Guy L. Steele Jr. and Sebastiano Vigna, LXM: better splittable pseudorandom number generators
+// (and almost as fast). Proceedings of the ACM on Programming Languages, 2021, 5(OOPSLA), 1--31.
+// https://docs.oracle.com/en/java/javase/17/docs/api/java.base/java/util/random/package-summary.html
+type L128X1024MixRandom struct {
+	baseSource64
+	xoroshiro1024Core
+	ah, al, sh, sl uint64
+}
+
+// A single value is used as the seed of Java's L128X1024MixRandom(long seed), longer streams hold the parameters
+// of the constructor L128X1024MixRandom(ah, al, sh, sl, x0, ..., x15) (filled if shorter). As in Java, a is made
+// odd and an all-zero xoroshiro1024 state is replaced.
+func NewL128X1024MixRandomFromStream(seed []uint64) (*L128X1024MixRandom, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(L128X1024MixRandom)
+	ans.spi = ans
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewL128X1024MixRandom(seed int64) *L128X1024MixRandom {
+	ans := new(L128X1024MixRandom)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (lxm *L128X1024MixRandom) setSeed(seed []uint64) {
+	if len(seed) == 1 {
+		v := seed[0] ^ silver_gamma
+		ah := mixMurmur64(v)
+		v += golden_gamma
+		lxm.stream = append([]uint64{ah, mixMurmur64(v), 0, 1}, lxmState(v, xoroshiro1024_r)...)
+	} else {
+		lxm.stream = make([]uint64, l128x1024_r)
+		fillState(lxm.stream, seed)
+	}
+
+	lxm.stream[1] |= 1
+	lxmFixState(lxm.stream[4:], lxm.stream[2])
+	lxm.Restart()
+}
+
+func (lxm *L128X1024MixRandom) Seed(seed int64) {
+	seeds := make([]uint64, l128x1024_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < len(seeds) {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	lxm.setSeed(seeds)
+}
+
+func (lxm *L128X1024MixRandom) Restart() {
+	lxm.ah, lxm.al = lxm.stream[0], lxm.stream[1]
+	lxm.sh, lxm.sl = lxm.stream[2], lxm.stream[3]
+	copy(lxm.state[:], lxm.stream[4:])
+	// Java starts at the last word of the array.
+	lxm.index = xoroshiro1024_r - 1
+	lxm.resetState()
+}
+
+// Split returns a new generator seeded from the next outputs of this one, as split() in Java.
+func (lxm *L128X1024MixRandom) Split() *L128X1024MixRandom {
+	return lxm.SplitFrom(lxm)
+}
+
+// SplitFrom returns a new generator seeded from the next outputs of source, as split(source) in Java:
+// the first output (the brine) gives the low half of the parameter a of the LCG.
+func (lxm *L128X1024MixRandom) SplitFrom(source grand.Source64) *L128X1024MixRandom {
+	seed := make([]uint64, l128x1024_r)
+	seed[1] = source.Uint64() << 1
+	seed[0] = source.Uint64()
+	for i := 2; i < len(seed); i++ {
+		seed[i] = source.Uint64()
+	}
+
+	ans, _ := NewL128X1024MixRandomFromStream(seed)
+	return ans
+}
+
+func (lxm *L128X1024MixRandom) Uint64() uint64 {
+	s0, _ := lxm.next()
+	result := mixLea64(lxm.sh + s0)
+
+	// s = (2^64 + ml)*s + a mod 2^128
+	hi, lo := bits.Mul64(lxm_ml, lxm.sl)
+	lxm.sh = lxm_ml*lxm.sh + hi + lxm.sl + lxm.ah
+	var c uint64
+	lxm.sl, c = bits.Add64(lo, lxm.al, 0)
+	lxm.sh += c
+
+	return result
+}
+
+func (lxm *L128X1024MixRandom) String() string {
+	return "L128X1024MixRandom"
+}
@@ -0,0 +1,59 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestL128X1024MixRandom(t *testing.T) {
+	rng, err := source64.NewL128X1024MixRandomFromStream([]uint64{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from a port of java.util.random's L128X1024MixRandom, seeded with new L128X1024MixRandom(42L). The values
+	 * are not yet checked against the output of a JDK 17 run.
+	 */
+	expected := []uint64{
+		0x30b8341f3b1ed3cb, 0x8fedbf228b3e0151, 0x9f6d20712bc587f6, 0x9346e839c9262ff3,
+		0xba933e21237f9cd9, 0xabe79fb97394b63e, 0xb0f602561e0e5019, 0x1e025a48de83bb0c,
+		0x98385ce94c95ecb9, 0xc74e0b43ed5a5cbf, 0xcceec49b825e78f3, 0xd9480915eec20097,
+		0x0918c2106f4a08c3, 0x2ec1276613f2aac5, 0x9e5ee8b96eceb632, 0x0c179cb66f81ca89,
+		0x4c484e63c14e542c, 0x851054003d953589, 0x85bc94f4f889520f, 0x9beda0c217a7a701,
+		0x912f1cc636071314, 0xbde86abc6d9ebb58, 0x408e55cc94ed7fcf, 0xf379be136dc595ec,
+		0x16fef60df1f318c5, 0x3e5df480a50f0db6, 0x9179eaef297b96e1, 0xd71720bd5e739101,
+		0x9dea69e1346f52da, 0xbfa4179711599fde, 0x64bd46bbae48c68d, 0xfe3baf02c29616bb,
+		0x19e6c9ab3b21d404, 0x6017467281377f48, 0xf26760cf767b35fa, 0x261af01ccc036502,
+		0x19cf941944ee150b, 0x2753d0b25a86823a, 0x1e48418bf0675222, 0xafb893d62b13a730,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Split", func(t *testing.T) {
+		rng.Restart()
+		child := rng.Split()
+		// the child and the parent after the outputs used to seed the child.
+		expected := [][]uint64{
+			{0xb6d5008efa413aee, 0x14cd6bad9a408e09, 0xe20e88cc35d73070, 0x5e13d7ad82d93408},
+			{0x912f1cc636071314, 0xbde86abc6d9ebb58, 0x408e55cc94ed7fcf, 0xf379be136dc595ec},
+		}
+
+		for j, src := range []grand.Source64{child, rng} {
+			for i := 0; i < len(expected[j]); i++ {
+				rg := src.Uint64()
+				if expected[j][i] != rg {
+					t.Errorf("Mismatch. want: %v, got: %v", expected[j][i], rg)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestL64X128MixRandom(t *testing.T) {
+	rng, err := source64.NewL64X128MixRandomFromStream([]uint64{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from a port of java.util.random's L64X128MixRandom, seeded with new L64X128MixRandom(42L). The values
+	 * are not yet checked against the output of a JDK 17 run.
+	 */
+	expected := []uint64{
+		0xb2482ded0ba7ac12, 0xabc6a30a803e9910, 0xb52050e95869e138, 0xd0bb322ded7531ec,
+		0x882b4c1e1da17c8a, 0x1c59ac3fd001527e, 0x8a34cb2e8f0c35cf, 0xe557452feb44d812,
+		0x2a10676878351b11, 0xfa4919641f499dd4, 0x2bfdd7a1879ce27a, 0xe116dbfe0de7c847,
+		0x52873f5d9ff5c03c, 0xa389183105007524, 0xeb3db2882678cefd, 0xfbd1718c64e1047c,
+		0xa03b4214224aa416, 0x8f3e51879bc7f10b, 0xf9fe583aa0f722cb, 0xb5d7a44c708beb63,
+		0x01da2175dfb7726d, 0x713b460415a6d3dd, 0xc554d50d7ea29461, 0x1510dd531cc04bd5,
+		0x95e1428f03f54231, 0xa8187c1a28563064, 0x12d9a37614ba94ff, 0x28c2c744173e9286,
+		0x669d56a0d6f0f8fa, 0x70ae3657be1790b6, 0x2bbed0425802b4f4, 0x0ae05372e2ca99c0,
+		0x6028baa975a47392, 0xb503fbe8549c3599, 0x74b4a249c49ebcc4, 0x98f57145ae22feb6,
+		0x7bcb15ba384cda45, 0xb597e7b0af5f8fc8, 0xa77aba0de8ef457e, 0xc2409568de1ac494,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Split", func(t *testing.T) {
+		rng.Restart()
+		child := rng.Split()
+		// the child and the parent after the outputs used to seed the child.
+		expected := [][]uint64{
+			{0x2ce84e50384cb6b1, 0xd60c9ef3284ff457, 0x3de2825efb3fa486, 0x7aef78be3d902a0b},
+			{0x882b4c1e1da17c8a, 0x1c59ac3fd001527e, 0x8a34cb2e8f0c35cf, 0xe557452feb44d812},
+		}
+
+		for j, src := range []grand.Source64{child, rng} {
+			for i := 0; i < len(expected[j]); i++ {
+				rg := src.Uint64()
+				if expected[j][i] != rg {
+					t.Errorf("Mismatch. want: %v, got: %v", expected[j][i], rg)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package source64
+
+import "github.com/jtejido/grand"
+
+const (
+	// the LCG parameters a and s, followed by the xoshiro256 state.
+	l64x256_r = 6
+)
+
+// Implements L64X256MixRandom of java.util.random (Java 17), a LXM generator from Guy Steele and Sebastiano Vigna:
+// the sum of a 64-bit LCG and of xoshiro256, mixed by mixLea64(). Memory footprint is 384 bits (the additive
+// parameter a of the LCG selects one of 2^63 streams), the period is 2^64*(2^256-1).
+//
+// Split() returns a new generator seeded from this one, as SplittableGenerator.split() does.
+//
+// Guy L. Steele Jr. and Sebastiano Vigna, LXM: better splittable pseudorandom number generators
+// (and almost as fast). Proceedings of the ACM on Programming Languages, 2021, 5(OOPSLA), 1--31.
+// https://docs.oracle.com/en/java/javase/17/docs/api/java.base/java/util/random/package-summary.html
+type L64X256MixRandom struct {
+	baseSource64
+	xoshiro256Core
+	a, s uint64
+}
+
+// A single value is used as the seed of Java's L64X256MixRandom(long seed), longer streams hold the parameters
+// of the constructor L64X256MixRandom(a, s, x0, x1, x2, x3) (filled if shorter). As in Java, a is made odd and
+// an all-zero xoshiro256 state is replaced.
+func NewL64X256MixRandomFromStream(seed []uint64) (*L64X256MixRandom, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(L64X256MixRandom)
+	ans.spi = ans
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewL64X256MixRandom(seed int64) *L64X256MixRandom {
+	ans := new(L64X256MixRandom)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (lxm *L64X256MixRandom) setSeed(seed []uint64) {
+	if len(seed) == 1 {
+		v := seed[0] ^ silver_gamma
+		lxm.stream = append([]uint64{mixMurmur64(v), 1}, lxmState(v, xoshiro256_r)...)
+	} else {
+		lxm.stream = make([]uint64, l64x256_r)
+		fillState(lxm.stream, seed)
+	}
+
+	lxm.stream[0] |= 1
+	lxmFixState(lxm.stream[2:], lxm.stream[1])
+	lxm.Restart()
+}
+
+func (lxm *L64X256MixRandom) Seed(seed int64) {
+	seeds := make([]uint64, l64x256_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < len(seeds) {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	lxm.setSeed(seeds)
+}
+
+func (lxm *L64X256MixRandom) Restart() {
+	lxm.a = lxm.stream[0]
+	lxm.s = lxm.stream[1]
+	copy(lxm.state[:], lxm.stream[2:])
+	lxm.resetState()
+}
+
+// Split returns a new generator seeded from the next outputs of this one, as split() in Java.
+func (lxm *L64X256MixRandom) Split() *L64X256MixRandom {
+	return lxm.SplitFrom(lxm)
+}
+
+// SplitFrom returns a new generator seeded from the next outputs of source, as split(source) in Java:
+// the first output (the brine) gives the parameter a of the LCG.
+func (lxm *L64X256MixRandom) SplitFrom(source grand.Source64) *L64X256MixRandom {
+	seed := make([]uint64, l64x256_r)
+	seed[0] = source.Uint64() << 1
+	for i := 1; i < len(seed); i++ {
+		seed[i] = source.Uint64()
+	}
+
+	ans, _ := NewL64X256MixRandomFromStream(seed)
+	return ans
+}
+
+func (lxm *L64X256MixRandom) Uint64() uint64 {
+	result := mixLea64(lxm.s + lxm.state[0])
+	lxm.s = lxm_m*lxm.s + lxm.a
+	lxm.next()
+	return result
+}
+
+func (lxm *L64X256MixRandom) String() string {
+	return "L64X256MixRandom"
+}
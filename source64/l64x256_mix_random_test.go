@@ -0,0 +1,59 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestL64X256MixRandom(t *testing.T) {
+	rng, err := source64.NewL64X256MixRandomFromStream([]uint64{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from a port of java.util.random's L64X256MixRandom, seeded with new L64X256MixRandom(42L). The values
+	 * are not yet checked against the output of a JDK 17 run.
+	 */
+	expected := []uint64{
+		0xb2482ded0ba7ac12, 0xc316ee8cfd72e9cc, 0x7e7e6ffec1d2f289, 0xe37240b563aaaa71,
+		0x952d861ef8dd204a, 0xed9a06a32108e7a3, 0xafaa1f627e0f8d59, 0x71f6218656a6d81f,
+		0xf47f016f6c319422, 0x502db5e9608385d1, 0x2a4264893fa9530e, 0x33c194c541425485,
+		0x6d73caba3b229481, 0xa6074842aef4ef4c, 0x1aa0432531fe78bc, 0xe017e306ff38b590,
+		0x0463f47cfd5eed6d, 0xb0b375e6cc99590c, 0xe3261fa06d6a084e, 0xcb1dad0338f31470,
+		0xa383db3a94077bf0, 0xdb066d586ea8d92b, 0x52ce192c56119b6c, 0x0867e315c7be9714,
+		0xcbf40062cc4156f2, 0xa6daa9b4c4492d16, 0x881272daaaea96ec, 0xf67e955e178e21d1,
+		0x5fad6ab134a277a6, 0xa9eaf571b70800e7, 0x4062f57439867bfc, 0x2a9ebf14379ee100,
+		0x4faf018c91086936, 0x848c348f3fb731a3, 0xd1e93368f3c30a4f, 0xd3483a29752028fc,
+		0x50e375c7c314560f, 0xf89b7196deddc0da, 0xe5fe6a24d2c3c598, 0xe4d634b53771548f,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Split", func(t *testing.T) {
+		rng.Restart()
+		child := rng.Split()
+		// the child and the parent after the outputs used to seed the child.
+		expected := [][]uint64{
+			{0x32caba538cc6960b, 0x21fc91e5f2f7fce6, 0x40fec02b71c83909, 0x0fe9c5d3a9e12ef0},
+			{0xafaa1f627e0f8d59, 0x71f6218656a6d81f, 0xf47f016f6c319422, 0x502db5e9608385d1},
+		}
+
+		for j, src := range []grand.Source64{child, rng} {
+			for i := 0; i < len(expected[j]); i++ {
+				rg := src.Uint64()
+				if expected[j][i] != rg {
+					t.Errorf("Mismatch. want: %v, got: %v", expected[j][i], rg)
+				}
+			}
+		}
+	})
+}
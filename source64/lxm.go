@@ -0,0 +1,59 @@
+package source64
+
+const (
+	// the multiplier of the 64-bit LCG of the LXM generators, and the low half of the multiplier
+	// 2^64 + lxm_ml of the 128-bit LCG.
+	lxm_m  uint64 = 0xd1342543de82ef95
+	lxm_ml uint64 = 0xd605bbb58c8abbfd
+	// the fractional part of sqrt(2) (golden_gamma is the one of the golden ratio), used by Java's
+	// RandomSupport to seed the LXM generators from a single value.
+	silver_gamma uint64 = 0x6a09e667f3bcc909
+)
+
+// The output function of the LXM generators, from Doug Lea.
+func mixLea64(z uint64) uint64 {
+	z = (z ^ (z >> 32)) * 0xdaba0b6eb09322e3
+	z = (z ^ (z >> 32)) * 0xdaba0b6eb09322e3
+	return z ^ (z >> 32)
+}
+
+// The finalizer of MurmurHash3.
+func mixMurmur64(z uint64) uint64 {
+	z = (z ^ (z >> 33)) * 0xff51afd7ed558ccd
+	z = (z ^ (z >> 33)) * 0xc4ceb9fe1a85ec53
+	return z ^ (z >> 33)
+}
+
+// David Stafford's variant 13 of the finalizer, used by SplitMix64.
+func mixStafford13(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Returns the n words of the XBG state of a LXM generator seeded from a single value, mixStafford13()
+// of a Weyl sequence starting at seed.
+func lxmState(seed uint64, n int) []uint64 {
+	ans := make([]uint64, n)
+	for i := range ans {
+		ans[i] = mixStafford13(seed)
+		seed += golden_gamma
+	}
+
+	return ans
+}
+
+// Replaces an all-zero XBG state as java.util.random does, with mixStafford13() of a Weyl sequence
+// starting at v.
+func lxmFixState(x []uint64, v uint64) {
+	for _, s := range x {
+		if s != 0 {
+			return
+		}
+	}
+
+	for i := range x {
+		v += golden_gamma
+		x[i] = mixStafford13(v)
+	}
+}
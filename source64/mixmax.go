@@ -0,0 +1,348 @@
+package source64
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+const (
+	mixmax_bits        = 61
+	mixmax_m61  uint64 = 1<<mixmax_bits - 1
+	// the stream of the IDs starts 2^mixmax_id_skip * ID iterations after the unit vector e_0.
+	mixmax_id_skip = 512
+	// Jump() skips 2^mixmax_jump iterations.
+	mixmax_jump = 256
+	// the multiplier of the LCG of seed_spbox().
+	mixmax_spbox_mult uint64 = 6364136223846793005
+)
+
+// MIXMAXParams holds the parameters of a MIXMAX generator: the size N of the matrix A(N, s, m),
+// with m = 2^specialMul + 1 and the special entry s.
+type MIXMAXParams struct {
+	n          int
+	specialMul uint
+	special    int64
+	// skips holds the skip polynomials, computed when first needed.
+	skips *mixmaxSkips
+}
+
+// The parameter sets of MIXMAX 2.0, as in the reference code (and CLHEP's MixMaxRng for N = 17).
+var (
+	MIXMAX17Params  = MIXMAXParams{n: 17, specialMul: 36, special: 0, skips: new(mixmaxSkips)}
+	MIXMAX240Params = MIXMAXParams{n: 240, specialMul: 51, special: 487013230256099140, skips: new(mixmaxSkips)}
+	MIXMAX256Params = MIXMAXParams{n: 256, specialMul: 0, special: -1, skips: new(mixmaxSkips)}
+)
+
+// N returns the size of the matrix, i.e. the state holds N 61-bit values.
+func (p MIXMAXParams) N() int {
+	return p.n
+}
+
+func mixmaxMod(k uint64) uint64 {
+	return (k & mixmax_m61) + (k >> mixmax_bits)
+}
+
+func mixmaxAdd(a, b uint64) uint64 {
+	return mixmaxMod(a + b)
+}
+
+// Returns cum + a*b mod 2^61-1 (not fully reduced, as in the reference code).
+func mixmaxMulAdd(cum, a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	lo, carry := bits.Add64(lo, cum, 0)
+	hi += carry
+	return mixmaxMod((lo & mixmax_m61) + hi*8 + (lo >> mixmax_bits))
+}
+
+// Returns k*2^specialMul mod 2^61-1.
+func (p *MIXMAXParams) mulWU(k uint64) uint64 {
+	return ((k << p.specialMul) & mixmax_m61) ^ (k >> (mixmax_bits - p.specialMul))
+}
+
+// Returns s*k mod 2^61-1.
+func (p *MIXMAXParams) mulSpecial(k uint64) uint64 {
+	if p.special == -1 {
+		return mixmax_m61 - k
+	}
+
+	return mixmaxMulAdd(0, uint64(p.special), k)
+}
+
+// Multiplies y by the matrix A, given the sum of its elements, and returns the sum of the new elements.
+// This is iterate_raw_vec() of the reference code.
+func (p *MIXMAXParams) iterate(y []uint64, sumtotOld uint64) uint64 {
+	temp2 := y[1]
+	tempV := sumtotOld
+	y[0] = tempV
+	sumtot, ovflow := tempV, uint64(0)
+	// tempP is the partial sum of the old elements.
+	var tempP uint64
+	for i := 1; i < p.n; i++ {
+		if p.specialMul != 0 {
+			tempPO := p.mulWU(tempP)
+			tempP = mixmaxAdd(tempP, y[i])
+			tempV = mixmaxMod(tempV + tempP + tempPO)
+		} else {
+			tempP = mixmaxAdd(tempP, y[i])
+			tempV = mixmaxAdd(tempV, tempP)
+		}
+		y[i] = tempV
+		sumtot += tempV
+		if sumtot < tempV {
+			ovflow++
+		}
+	}
+
+	if p.special != 0 {
+		temp2 = p.mulSpecial(temp2)
+		y[2] = mixmaxAdd(y[2], temp2)
+		sumtot += temp2
+		if sumtot < temp2 {
+			ovflow++
+		}
+	}
+
+	// 2^64 = 2^3 mod 2^61-1
+	return mixmaxMod(mixmaxMod(sumtot) + ovflow<<3)
+}
+
+// Implements MIXMAX, the matrix generator of Konstantin Savvidy and George Savvidy, which is a K-system
+// (Kolmogorov-Anosov) with a period of (2^61-1)^N - 1 for the standard sizes N = 17, 240 and 256.
+// The state is a vector of N values modulo 2^61-1, multiplied by the matrix A(N, s, m) at each iteration,
+// which gives N-1 61-bit values (Next()); Uint64() packs them into 64-bit values without dropping any bit.
+//
+// Jump() and the streams of NewMIXMAXFromIDs use skip polynomials: as A satisfies its characteristic
+// polynomial, A^k is a polynomial in A of degree N-1.
+//
+// K. Savvidy, The MIXMAX random number generator. Computer Physics Communications, 2015, 196, 161--165.
+// K. Savvidy and G. Savvidy, Spectrum and entropy of C-systems. MIXMAX random number generator.
+// Chaos, Solitons & Fractals, 2016, 91, 33--38.
+// https://mixmax.hepforge.org
+type MIXMAX struct {
+	baseJumpableSource64
+	params  MIXMAXParams
+	v       []uint64
+	sumtot  uint64
+	counter int
+	// start is the counter of the first call after a restart: n (iterate first) after seeding,
+	// 1 (return v[1], ..., v[n-1] first) for the streams of IDs, as in the reference code.
+	start int
+	// pool holds the npool bits of the last value that were not returned by Uint64().
+	pool  uint64
+	npool uint
+}
+
+// A single value is used as the seed of the reference seed_spbox(), which must not be 0.
+// Longer streams are the state vector (filled if shorter, and truncated to 61 bits), which must not be 0 modulo 2^61-1.
+func NewMIXMAXFromStream(params MIXMAXParams, seed []uint64) (*MIXMAX, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans, err := newMIXMAX(params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ans.setSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewMIXMAX(params MIXMAXParams, seed int64) (*MIXMAX, error) {
+	ans, err := newMIXMAX(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.Seed(seed)
+	return ans, nil
+}
+
+// NewMIXMAXFromVielbein starts the stream at the unit vector e_index, as the reference seed_vielbein().
+func NewMIXMAXFromVielbein(params MIXMAXParams, index int) (*MIXMAX, error) {
+	ans, err := newMIXMAX(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 0 || index >= params.n {
+		return nil, fmt.Errorf("The index must be in [0, %d)", params.n)
+	}
+
+	v := make([]uint64, params.n)
+	v[index] = 1
+	ans.stream = append(v, 1)
+	ans.Restart()
+	return ans, nil
+}
+
+// NewMIXMAXFromIDs starts the stream of the four 32-bit IDs, in the manner of the reference seed_uniquestream():
+// the unit vector e_0 is skipped ahead by 2^512 * ID iterations, where ID is the 128-bit number
+// clusterID:machineID:runID:streamID, using one skip per bit of the ID (the lowest bit of streamID first),
+// and the first values are the components v[1], ..., v[N-1] of the skipped vector.
+// Streams with different IDs don't overlap unless more than 2^512 iterations are drawn.
+//
+// The skip distances are those of this package: they have not been checked against the skipMat tables of
+// the reference code, so the streams of nonzero IDs may differ from those of seed_uniquestream().
+func NewMIXMAXFromIDs(params MIXMAXParams, clusterID, machineID, runID, streamID uint32) (*MIXMAX, error) {
+	ans, err := NewMIXMAXFromVielbein(params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := [4]uint32{streamID, runID, machineID, clusterID}
+	skips := params.skips.get(&params)
+	v, sumtot := ans.stream[:params.n], ans.stream[params.n]
+	for i, id := range ids {
+		for r := 0; id != 0; r++ {
+			if id&1 != 0 {
+				sumtot = params.skip(v, sumtot, skips.id[32*i+r])
+			}
+			id >>= 1
+		}
+	}
+
+	ans.stream[params.n] = sumtot
+	ans.start = 1
+	ans.Restart()
+	return ans, nil
+}
+
+func newMIXMAX(params MIXMAXParams) (*MIXMAX, error) {
+	if params.n == 0 {
+		return nil, errors.New("unknown MIXMAX parameters, use one of the MIXMAX*Params presets")
+	}
+
+	ans := new(MIXMAX)
+	ans.spi = ans
+	ans.params = params
+	ans.v = make([]uint64, params.n)
+	ans.start = params.n
+	return ans, nil
+}
+
+// The stream holds the state vector followed by the sum of its elements.
+func (mm *MIXMAX) setSeed(seed []uint64) error {
+	n := mm.params.n
+	v := make([]uint64, n)
+	var sumtot, ovflow uint64
+	if len(seed) == 1 {
+		// seed_spbox(): a 64-bit LCG from Knuth, in combination with a bit swap.
+		l := seed[0]
+		if l == 0 {
+			return errors.New("The seed must not be 0")
+		}
+
+		for i := range v {
+			l *= mixmax_spbox_mult
+			l = (l << 32) ^ (l >> 32)
+			v[i] = l & mixmax_m61
+		}
+	} else {
+		fillState(v, seed)
+		for i := range v {
+			v[i] &= mixmax_m61
+		}
+	}
+
+	zero := true
+	for _, x := range v {
+		sumtot += x
+		if sumtot < x {
+			ovflow++
+		}
+		if mixmaxReduce(x) != 0 {
+			zero = false
+		}
+	}
+
+	if zero {
+		return errors.New("The state vector must not be 0")
+	}
+
+	mm.stream = append(v, mixmaxMod(mixmaxMod(sumtot)+ovflow<<3))
+	mm.start = n
+	mm.Restart()
+	return nil
+}
+
+func (mm *MIXMAX) Seed(seed int64) {
+	seeds := make([]uint64, mm.params.n)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < len(seeds) {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	mm.setSeed(seeds)
+}
+
+func (mm *MIXMAX) Restart() {
+	mm.substream = append([]uint64{}, mm.stream...)
+	mm.RestartSubstream()
+}
+
+func (mm *MIXMAX) RestartSubstream() {
+	n := mm.params.n
+	copy(mm.v, mm.substream[:n])
+	mm.sumtot = mm.substream[n]
+	mm.counter = mm.start
+	mm.pool = 0
+	mm.npool = 0
+	mm.resetState()
+}
+
+// Jump advances the start of the current substream by 2^256 iterations, i.e. (N-1)*2^256 calls to Next().
+func (mm *MIXMAX) Jump() {
+	n := mm.params.n
+	skips := mm.params.skips.get(&mm.params)
+	mm.substream[n] = mm.params.skip(mm.substream[:n], mm.substream[n], skips.jump)
+	mm.RestartSubstream()
+}
+
+// Returns the parameter set of the generator.
+func (mm *MIXMAX) Params() MIXMAXParams {
+	return mm.params
+}
+
+// Next returns the next 61-bit value of the generator, as get_next() of the reference code.
+func (mm *MIXMAX) Next() uint64 {
+	if mm.counter < mm.params.n {
+		ans := mm.v[mm.counter]
+		mm.counter++
+		return ans
+	}
+
+	mm.sumtot = mm.params.iterate(mm.v, mm.sumtot)
+	mm.counter = 2
+	return mm.v[1]
+}
+
+func (mm *MIXMAX) Uint64() uint64 {
+	ans, n := mm.pool, mm.npool
+	for {
+		x := mm.Next() & mixmax_m61
+		ans |= x << n
+		if n+mixmax_bits >= 64 {
+			mm.pool = x >> (64 - n)
+			mm.npool = n + mixmax_bits - 64
+			return ans
+		}
+		n += mixmax_bits
+	}
+}
+
+func (mm *MIXMAX) String() string {
+	return fmt.Sprintf("MIXMAX%d", mm.params.n)
+}
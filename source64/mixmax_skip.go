@@ -0,0 +1,167 @@
+package source64
+
+import "sync"
+
+// mixmaxSkips holds the skip polynomials of a parameter set: A^k = q(A) where q = x^k mod the characteristic
+// polynomial of A. The reference code tabulates them, here they are computed once, when first needed.
+type mixmaxSkips struct {
+	once sync.Once
+	// id[b] skips 2^(512+b) iterations, jump skips 2^256 iterations.
+	id   [128][]uint64
+	jump []uint64
+}
+
+func (s *mixmaxSkips) get(p *MIXMAXParams) *mixmaxSkips {
+	s.once.Do(func() {
+		chi := p.charPoly()
+		// x^(2^0) = x
+		q := make([]uint64, p.n)
+		q[1] = 1
+		for k := 0; k < mixmax_id_skip+len(s.id); k++ {
+			if k == mixmax_jump {
+				s.jump = q
+			}
+			if k >= mixmax_id_skip {
+				s.id[k-mixmax_id_skip] = q
+			}
+			q = mixmaxPolyMulMod(q, q, chi)
+		}
+	})
+
+	return s
+}
+
+// Returns a fully reduced value modulo 2^61-1.
+func mixmaxReduce(k uint64) uint64 {
+	k = mixmaxMod(k)
+	if k >= mixmax_m61 {
+		k -= mixmax_m61
+	}
+
+	return k
+}
+
+// Returns the characteristic polynomial of A, x^N - sum chi[i]*x^i, as the coefficients chi[0..N-1].
+// As it is irreducible, it is the minimal polynomial of any sequence e_0.A^n.y for y != 0,
+// given by the Berlekamp-Massey algorithm from 2N terms.
+func (p *MIXMAXParams) charPoly() []uint64 {
+	n := p.n
+	y := make([]uint64, n)
+	y[0] = 1
+	sumtot := uint64(1)
+	seq := make([]uint64, 2*n)
+	for i := range seq {
+		seq[i] = mixmaxReduce(y[0])
+		sumtot = p.iterate(y, sumtot)
+	}
+
+	// c is the connection polynomial 1 + c[1]*x + ..., b the previous one.
+	c := make([]uint64, n+1)
+	b := make([]uint64, n+1)
+	c[0], b[0] = 1, 1
+	l, m := 0, 1
+	d0 := uint64(1)
+	for i := range seq {
+		d := seq[i]
+		for j := 1; j <= l; j++ {
+			d = mixmaxReduce(mixmaxMulAdd(d, c[j], seq[i-j]))
+		}
+		if d == 0 {
+			m++
+			continue
+		}
+
+		// coef = d/d0, the inverse given by Fermat's little theorem.
+		coef := mixmaxReduce(mixmaxMulAdd(0, d, mixmaxPow(d0, mixmax_m61-2)))
+		t := append([]uint64{}, c...)
+		for j := 0; j+m <= n; j++ {
+			c[j+m] = mixmaxReduce(c[j+m] + mixmax_m61 - mixmaxReduce(mixmaxMulAdd(0, coef, b[j])))
+		}
+		if 2*l <= i {
+			l = i + 1 - l
+			copy(b, t)
+			d0 = d
+			m = 1
+		} else {
+			m++
+		}
+	}
+
+	if l != n {
+		panic("MIXMAX: the characteristic polynomial is not irreducible")
+	}
+
+	// s(i) = -sum c[j]*s(i-j), i.e. x^N = sum -c[N-i]*x^i.
+	chi := make([]uint64, n)
+	for i := range chi {
+		chi[i] = mixmaxReduce(mixmax_m61 - c[n-i])
+	}
+
+	return chi
+}
+
+func mixmaxPow(a, e uint64) uint64 {
+	ans := uint64(1)
+	for ; e != 0; e >>= 1 {
+		if e&1 != 0 {
+			ans = mixmaxReduce(mixmaxMulAdd(0, ans, a))
+		}
+		a = mixmaxReduce(mixmaxMulAdd(0, a, a))
+	}
+
+	return ans
+}
+
+// Returns a*b mod (x^N - sum chi[i]*x^i), all coefficients fully reduced.
+func mixmaxPolyMulMod(a, b, chi []uint64) []uint64 {
+	n := len(chi)
+	r := make([]uint64, 2*n-1)
+	for i, x := range a {
+		if x == 0 {
+			continue
+		}
+		for j, y := range b {
+			r[i+j] = mixmaxMulAdd(r[i+j], x, y)
+		}
+	}
+
+	for i := 2*n - 2; i >= n; i-- {
+		x := mixmaxReduce(r[i])
+		if x == 0 {
+			continue
+		}
+		for j, y := range chi {
+			r[i-n+j] = mixmaxMulAdd(r[i-n+j], x, y)
+		}
+	}
+
+	ans := make([]uint64, n)
+	for i := range ans {
+		ans[i] = mixmaxReduce(r[i])
+	}
+
+	return ans
+}
+
+// Replaces y by q(A).y, given the sum of the elements of y, and returns the new sum.
+// This is apply_bigskip() of the reference code.
+func (p *MIXMAXParams) skip(y []uint64, sumtot uint64, q []uint64) uint64 {
+	tmp := append([]uint64{}, y...)
+	cum := make([]uint64, p.n)
+	for j, coeff := range q {
+		for i := range cum {
+			cum[i] = mixmaxMulAdd(cum[i], coeff, tmp[i])
+		}
+		if j < len(q)-1 {
+			sumtot = p.iterate(tmp, sumtot)
+		}
+	}
+
+	sumtot = 0
+	for i := range y {
+		y[i] = mixmaxReduce(cum[i])
+		sumtot = mixmaxAdd(sumtot, y[i])
+	}
+
+	return sumtot
+}
@@ -0,0 +1,169 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func checkMIXMAXNext(t *testing.T, rng *source64.MIXMAX, expected []uint64) {
+	for i := 0; i < len(expected); i++ {
+		rg := rng.Next()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %#x, got: %#x", expected[i], rg)
+		}
+	}
+}
+
+func TestMIXMAX(t *testing.T) {
+	rng, err := source64.NewMIXMAXFromStream(source64.MIXMAX17Params, []uint64{12345})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the matrix A(17, 0, 2^36+1) applied to the seed_spbox(12345) state modulo 2^61-1,
+	 * the 61-bit values packed into 64-bit values (the lowest bits first), computed with an independent
+	 * model of the recursion and not with the reference mixmax C code.
+	 */
+	expected := []uint64{
+		0xdd5dad4fd53cb69d, 0x8b71389bc1567619, 0xf61afd7486459383, 0x1f078fe508643163,
+		0x511e45af3a1b9d12, 0xfa3ae46c878157bc, 0x54bdb7d398eb5907, 0x8d984589a01ba81a,
+		0x46e45a2f07707e80, 0xc0b3fe3afb5047f6, 0x4a7c62ae3a46379b, 0xfd3e6ba64dab0823,
+		0xce4de2e2be6c6d96, 0xdd0f161d0d6c4510, 0x57f8f29c37a51531, 0xc9b09b29189c9dc6,
+		0x14b7a53e04fe703d, 0x77af643bfa1ccb9e, 0xdc85148e0f1f936e, 0xe5b9ae272f4216ff,
+		0xd88d1cbd4643fa48, 0xd1d89ba054a46886, 0xa1ad8706c563edab, 0x7b27b03f1720f91b,
+		0x2de74c747d996c7c, 0xb5f7fc6b0f154cf3, 0xcea120fbf665dc6a, 0x852301b28450f9d5,
+		0x31382d91cd97fc18, 0x6ff38806844c16f2, 0x9f42fbdd23dd1896, 0x70682297691b3c2d,
+		0xc3ba424d851b66ac, 0xaaa27a1eda8cee66, 0xa4bead11e0769ebd, 0xfd30c33ad96c77ee,
+		0x54eb1390a334fa91, 0x655a64c4e5189b25, 0x4f99aedc60414bd5, 0xfcfcc23874e9ddb8,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("N240", func(t *testing.T) {
+		rng, err := source64.NewMIXMAXFromStream(source64.MIXMAX240Params, []uint64{12345})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []uint64{
+			0x9c971975561706b9, 0x28891128f2926554, 0x27614b58dc985c2a, 0xef4f6db4666b17d0,
+			0x396aadf4e35bf226, 0x45aa7356059226f2, 0xc409bd94ef5c9b67, 0x2003ce46e8201d9c,
+		}
+
+		for i := 0; i < len(expected); i++ {
+			rg := rng.Uint64()
+			if expected[i] != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+			}
+		}
+	})
+
+	t.Run("N256", func(t *testing.T) {
+		rng, err := source64.NewMIXMAXFromStream(source64.MIXMAX256Params, []uint64{12345})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []uint64{
+			0x4915b993c7ef53e9, 0x1f03828799b5ce43, 0x0a91aaa25fa2707e, 0x60bb7ed6ce023b6f,
+			0xd1ace8cee43ac62b, 0x31e7bb557e0dad9e, 0xcedea0fdec8f000a, 0x433226023b2c83c6,
+		}
+
+		for i := 0; i < len(expected); i++ {
+			rg := rng.Uint64()
+			if expected[i] != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+			}
+		}
+	})
+
+	t.Run("Vielbein", func(t *testing.T) {
+		rng, err := source64.NewMIXMAXFromVielbein(source64.MIXMAX17Params, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		checkMIXMAXNext(t, rng, []uint64{0x1, 0x1, 0x2, 0x1000000003, 0x2000000004, 0x3000000005})
+	})
+
+	t.Run("Jump", func(t *testing.T) {
+		rng.Restart()
+		rng.Jump()
+		checkMIXMAXNext(t, rng, []uint64{
+			0x1caf686cbfc33190, 0x04b270f47231b458, 0x1809fef1b2e75e82, 0x08a1f2a357b04c03,
+		})
+	})
+
+	t.Run("IDs", func(t *testing.T) {
+		/*
+		 * Data from an independent model of seed_uniquestream() with the skip polynomials of this package,
+		 * which are not checked against the skipMat tables of the reference code.
+		 */
+		tests := []struct {
+			ids      [4]uint32
+			expected []uint64
+		}{
+			{[4]uint32{0, 0, 0, 1}, []uint64{0x0a8fa928bab1b284, 0x094e9f1df1ebfb55, 0x15ddda763aa1513c}},
+			{[4]uint32{1, 2, 3, 4}, []uint64{0x0df3312b9563e82d, 0x1dff7a2c5eb033aa, 0x12a6c6e9a172298c}},
+			{[4]uint32{0xffffffff, 0, 0x80000000, 0xdeadbeef}, []uint64{0x0be19bffef1080e3, 0x0d62a07a87829217, 0x1181853e0637bc6f}},
+		}
+
+		for _, test := range tests {
+			rng, err := source64.NewMIXMAXFromIDs(source64.MIXMAX17Params, test.ids[0], test.ids[1], test.ids[2], test.ids[3])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checkMIXMAXNext(t, rng, test.expected)
+		}
+	})
+
+	t.Run("ZeroIDs", func(t *testing.T) {
+		rng, err := source64.NewMIXMAXFromIDs(source64.MIXMAX17Params, 0, 0, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref, err := source64.NewMIXMAXFromVielbein(source64.MIXMAX17Params, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The stream of the zero IDs is e_0, and its first values are v[1], ..., v[16].
+		checkMIXMAXNext(t, rng, make([]uint64, 16))
+		for i := 0; i < 34; i++ {
+			want, got := ref.Next(), rng.Next()
+			if want != got {
+				t.Errorf("Mismatch. want: %#x, got: %#x", want, got)
+			}
+		}
+	})
+
+	t.Run("InvalidSeed", func(t *testing.T) {
+		if _, err := source64.NewMIXMAX(source64.MIXMAXParams{}, 1); err == nil {
+			t.Errorf("Unknown parameters should be rejected.")
+		}
+
+		m := make([]uint64, 17)
+		for i := range m {
+			m[i] = 1<<61 - 1 + uint64(i%2)<<61
+		}
+		for _, s := range [][]uint64{{0}, make([]uint64, 17), m} {
+			if _, err := source64.NewMIXMAXFromStream(source64.MIXMAX17Params, s); err == nil {
+				t.Errorf("No error for the seed %#x", s)
+			}
+		}
+
+		if _, err := source64.NewMIXMAXFromVielbein(source64.MIXMAX17Params, 17); err == nil {
+			t.Errorf("An index out of the state should be rejected.")
+		}
+	})
+}
@@ -1,25 +1,34 @@
 package source64
 
 import (
-	"fmt"
+	"github.com/jtejido/grand/internal/modmath"
 )
 
 const (
-	mrg63k3a_m1   uint64 = 9223372036854769163
-	mrg63k3a_m2   uint64 = 9223372036854754679
-	mrg63k3a_a12  uint64 = 1754669720
-	mrg63k3a_q12  uint64 = 5256471877
-	mrg63k3a_r12  uint64 = 251304723
-	mrg63k3a_a13n uint64 = 3182104042
-	mrg63k3a_q13  uint64 = 2898513661
-	mrg63k3a_r13  uint64 = 394451401
-	mrg63k3a_a21  uint64 = 31387477935
-	mrg63k3a_q21  uint64 = 293855150
-	mrg63k3a_r21  uint64 = 143639429
-	mrg63k3a_a23n uint64 = 6199136374
-	mrg63k3a_q23  uint64 = 1487847900
-	mrg63k3a_r23  uint64 = 985240079
-	mrg63k3a_r           = 6
+	mrg63k3a_m1   = 9223372036854769163
+	mrg63k3a_m2   = 9223372036854754679
+	mrg63k3a_a12  = 1754669720
+	mrg63k3a_q12  = 5256471877
+	mrg63k3a_r12  = 251304723
+	mrg63k3a_a13n = 3182104042
+	mrg63k3a_q13  = 2898513661
+	mrg63k3a_r13  = 394451401
+	mrg63k3a_a21  = 31387477935
+	mrg63k3a_q21  = 293855150
+	mrg63k3a_r21  = 143639429
+	mrg63k3a_a23n = 6199136374
+	mrg63k3a_q23  = 1487847900
+	mrg63k3a_r23  = 985240079
+	mrg63k3a_r    = 6
+	// The substreams are 2^127 values apart.
+	mrg63k3a_jump = 127
+)
+
+var (
+	// The transition matrices of both components raised to 2^127,
+	// for the state vector (x(n-3), x(n-2), x(n-1)).
+	mrg63k3a_a1p127 = modmath.Companion([]uint64{0, mrg63k3a_a12, mrg63k3a_m1 - mrg63k3a_a13n}, mrg63k3a_m1).Pow2(mrg63k3a_jump, mrg63k3a_m1)
+	mrg63k3a_a2p127 = modmath.Companion([]uint64{mrg63k3a_a21, 0, mrg63k3a_m2 - mrg63k3a_a23n}, mrg63k3a_m2).Pow2(mrg63k3a_jump, mrg63k3a_m2)
 )
 
 // This implements the MRG63k3A pseudo-random number generator
@@ -29,13 +38,14 @@ const (
 // https://www.iro.umontreal.ca/~lecuyer/myftp/papers/opres-combmrg2-1999.pdf
 // Good Parameter Sets for Combined Multiple Recursive Random Number Generators.
 // Operations Research, 1999, 47-1, 159--164
-// TO-DO. Jump()
+//
+// The period is about 2^377. Jump() advances the stream by 2^127 values.
 type MRG63k3A struct {
 	// State variable [][]s must be 2-vector 64-bit integer.
 	// The seeds for s[0][0], s[0][1], s[0][2] must be integers in [0, m1 - 1] and not all 0.
 	// The seeds for s[1][0], s[1][1], s[1][2] must be integers in [0, m2 - 1] and not all 0.
-	baseSource64
-	s [2][3]uint64
+	baseJumpableSource64
+	s [2][3]int64
 }
 
 func NewMRG63k3AFromStream(seed []uint64) (ans *MRG63k3A, err error) {
@@ -72,7 +82,7 @@ func NewMRG63k3A(seed int64) *MRG63k3A {
 
 func (mrg *MRG63k3A) setSeed(seed []uint64) (err error) {
 
-	err = mrg.checkSeed(seed)
+	err = checkMRGSeed(seed, []int{3, 3}, []uint64{mrg63k3a_m1, mrg63k3a_m2})
 
 	if err != nil {
 		return
@@ -84,33 +94,31 @@ func (mrg *MRG63k3A) setSeed(seed []uint64) (err error) {
 	return nil
 }
 
-func (mrg *MRG63k3A) checkSeed(seed []uint64) error {
-
-	for i := 0; i < mrg63k3a_r; i++ {
-		if seed[i] == 0 {
-			return fmt.Errorf("seed values must not be 0.")
-		}
-
-		if (i == 0 || i == 1 || i == 2) && seed[i] >= mrg63k3a_m1 {
-			return fmt.Errorf("The first 3 seed values must be less than %d", mrg63k3a_m1)
-		} else if seed[i] >= mrg63k3a_m2 {
-			return fmt.Errorf("The last 3 seed values must be less than %d", mrg63k3a_m2)
-		}
-	}
-
-	return nil
+func (mrg *MRG63k3A) Restart() {
+	mrg.substream = append([]uint64{}, mrg.stream...)
+	mrg.RestartSubstream()
 }
 
-func (mrg *MRG63k3A) Restart() {
-	mrg.s[0][0] = mrg.stream[0]
-	mrg.s[0][1] = mrg.stream[1]
-	mrg.s[0][2] = mrg.stream[2]
-	mrg.s[1][0] = mrg.stream[3]
-	mrg.s[1][1] = mrg.stream[4]
-	mrg.s[1][2] = mrg.stream[5]
+func (mrg *MRG63k3A) RestartSubstream() {
+	mrg.s[0][0] = int64(mrg.substream[0])
+	mrg.s[0][1] = int64(mrg.substream[1])
+	mrg.s[0][2] = int64(mrg.substream[2])
+	mrg.s[1][0] = int64(mrg.substream[3])
+	mrg.s[1][1] = int64(mrg.substream[4])
+	mrg.s[1][2] = int64(mrg.substream[5])
 	mrg.resetState()
 }
 
+func (mrg *MRG63k3A) Jump() {
+	mrg63k3a_a1p127.MulVec(mrg.substream[:3], mrg.substream[:3], mrg63k3a_m1)
+	mrg63k3a_a2p127.MulVec(mrg.substream[3:], mrg.substream[3:], mrg63k3a_m2)
+	mrg.RestartSubstream()
+}
+
+// Returns the next value of the combined recurrence, which lies in [1, m1].
+//
+// This is a direct port of L'Ecuyer's code, the products are computed exactly
+// in int64 with the approximate factoring method (m = a*q + r).
 func (mrg *MRG63k3A) Uint64() uint64 {
 
 	/* Component 1 */
@@ -118,15 +126,15 @@ func (mrg *MRG63k3A) Uint64() uint64 {
 	p13 := mrg63k3a_a13n*(mrg.s[0][0]-h*mrg63k3a_q13) - h*mrg63k3a_r13
 	h = mrg.s[0][1] / mrg63k3a_q12
 	p12 := mrg63k3a_a12*(mrg.s[0][1]-h*mrg63k3a_q12) - h*mrg63k3a_r12
-	if p13&0x8000000000000000 == 1 {
+	if p13 < 0 {
 		p13 += mrg63k3a_m1
 	}
-	if p12&0x8000000000000000 == 1 {
+	if p12 < 0 {
 		p12 += mrg63k3a_m1 - p13
 	} else {
 		p12 -= p13
 	}
-	if p12&0x8000000000000000 == 1 {
+	if p12 < 0 {
 		p12 += mrg63k3a_m1
 	}
 	mrg.s[0][0] = mrg.s[0][1]
@@ -138,15 +146,15 @@ func (mrg *MRG63k3A) Uint64() uint64 {
 	p23 := mrg63k3a_a23n*(mrg.s[1][0]-h*mrg63k3a_q23) - h*mrg63k3a_r23
 	h = mrg.s[1][2] / mrg63k3a_q21
 	p21 := mrg63k3a_a21*(mrg.s[1][2]-h*mrg63k3a_q21) - h*mrg63k3a_r21
-	if p23&0x8000000000000000 == 1 {
+	if p23 < 0 {
 		p23 += mrg63k3a_m2
 	}
-	if p21&0x8000000000000000 == 1 {
+	if p21 < 0 {
 		p21 += mrg63k3a_m2 - p23
 	} else {
 		p21 -= p23
 	}
-	if p21&0x8000000000000000 == 1 {
+	if p21 < 0 {
 		p21 += mrg63k3a_m2
 	}
 	mrg.s[1][0] = mrg.s[1][1]
@@ -155,9 +163,9 @@ func (mrg *MRG63k3A) Uint64() uint64 {
 
 	/* Combination */
 	if p12 > p21 {
-		return (p12 - p21)
+		return uint64(p12 - p21)
 	}
-	return (p12 - p21 + mrg63k3a_m1)
+	return uint64(p12 - p21 + mrg63k3a_m1)
 }
 
 func (mrg *MRG63k3A) Seed(seed int64) {
@@ -165,19 +173,19 @@ func (mrg *MRG63k3A) Seed(seed int64) {
 	seeder.Seed(seed)
 	for j := 0; j < 3; j++ {
 	again0:
-		f := seeder.Intn(int(mrg63k3a_m1))
+		f := seeder.Uint64n(mrg63k3a_m1)
 		if f == 0 {
 			goto again0
 		}
-		seeds[j] = uint64(f)
+		seeds[j] = f
 	}
 	for j := 3; j < 6; j++ {
 	again1:
-		f := seeder.Intn(int(mrg63k3a_m2))
+		f := seeder.Uint64n(mrg63k3a_m2)
 		if f == 0 {
 			goto again1
 		}
-		seeds[j] = uint64(f)
+		seeds[j] = f
 	}
 
 	// Initialize the pool content.
@@ -2,11 +2,16 @@ package source64_test
 
 import (
 	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
 	"github.com/jtejido/grand/source64"
 	"testing"
 )
 
 func TestMRG63k3A(t *testing.T) {
+	/*
+	 * Data from running L'Ecuyer's code (the unnormalized values of MRG63k3a()):
+	 *   https://www.iro.umontreal.ca/~lecuyer/myftp/papers/opres-combmrg2-1999.pdf
+	 */
 	rng, err := source64.NewMRG63k3AFromStream([]uint64{123456789, 123456789, 123456789, 123456789, 123456789, 123456789})
 
 	if err != nil {
@@ -16,106 +21,106 @@ func TestMRG63k3A(t *testing.T) {
 	r := grand.New(rng)
 
 	expected := []uint64{
-		15160845846450731929,
-		17808790114850713731,
-		16456768463916757596,
-		12153693498324097387,
-		9649373214932561355,
-		8485826326454122203,
-		3576485657889479526,
-		6941470033850937557,
-		4857612881914713316,
-		6727602721735637138,
-		14337893599803255674,
-		618380086247241420,
-		6850069792480451340,
-		13626400191019467078,
-		6088401316493404632,
-		7279239965508080771,
-		5141778009094628055,
-		17954733604142588888,
-		5565135912070580361,
-		1348560928649223391,
-		2694896070297646687,
-		8711687280880919332,
-		8673305014046684826,
-		2396375273343316152,
-		16471315253755797148,
-		11739983727506535226,
-		10568166919951327033,
-		1444824802906215316,
-		1863508920366690301,
-		3536498374689800047,
-		13856278515189988217,
-		10943887400049578427,
-		11786698124677060611,
-		475686528489845201,
-		137419748601211890,
-		14206868851522322940,
-		1341837747783109950,
-		6735748530599225532,
-		2344274004031400490,
-		14215354304086661818,
-		8654668899225358382,
-		9263983670511731824,
-		9868758613196225699,
-		7124503975262143714,
-		6436395875102434686,
-		4950550708831738457,
-		13761740040893238231,
-		3472021673784742232,
-		3876004832340178559,
-		15372983269732877506,
-		8369508381746377980,
-		2017307682392891161,
-		4601620097929249013,
-		4448944451123365398,
-		7525919168564393155,
-		8667770390495731573,
-		14532008652474605622,
-		508021228021433963,
-		7772257966702687427,
-		9082672421650472875,
-		7898452538941996247,
-		12028219155069815979,
-		1595326258847658822,
-		7496339144737660063,
-		9176434609754651478,
-		8682458805896101170,
-		10530199572082866677,
-		12179477978100434447,
-		17291590518035920621,
-		10070332440158569606,
-		8630339063088287963,
-		7726123685942773768,
-		2450902717609472785,
-		17155956985286058707,
-		8428323597706432278,
-		3257403903323190832,
-		18402810528285814130,
-		16359493174429117983,
-		17371730856672127709,
-		493108725342170818,
-		16555441187792889817,
-		1027781351128861317,
-		16293517635124938818,
-		10063007778732298182,
-		8390222550214841354,
-		6337780206608510262,
-		6879834718135520767,
-		3730657861791888355,
-		6464520216689430666,
-		8162616621559370102,
-		6774386788553846528,
-		15704190911697089382,
-		3342416314144103597,
-		7424434639470196263,
-		2759387456523859308,
-		1265073208610522017,
-		11791353643525815797,
-		13221731812264592675,
-		9082110260997114203,
-		2044877234658273681,
+		5937473809595949476,
+		8585418077995931278,
+		7233373107501396343,
+		2930340432071454314,
+		6400916347256758513,
+		2493875355366750018,
+		6771455846920063146,
+		655930223408676195,
+		1706738961599624890,
+		1835026062396065035,
+		5275268195855665582,
+		8319675741322194662,
+		255278882996075858,
+		8664346617400958082,
+		1689628899042462925,
+		7019221448962416836,
+		8762040764315726527,
+		7819315832839598789,
+		790907809627268031,
+		6481801132944446524,
+		1033523849927599353,
+		574021141524715614,
+		2056012346049054526,
+		9062393834000109810,
+		6390264682220877177,
+		6424651260830536868,
+		1706289927810140874,
+		7899655425169032911,
+		612501501495315145,
+		1958516232735103851,
+		3316620228539883845,
+		7202010485819300164,
+		1404091205854216259,
+		5106988810820005514,
+		2198587847930634759,
+		5995600448546967383,
+		7347061322502845790,
+		2608844151517816415,
+		248966832373039250,
+		2929381026207755599,
+		2879385264482536685,
+		3615821374410789192,
+		986368666497342999,
+		6985657915731446948,
+		4559408085247570886,
+		6953671750908121317,
+		6283300507799036342,
+		9023683453316391263,
+		2946101681284922697,
+		755040490174936910,
+		4981800751739871432,
+		8136739390849409848,
+		8555800175498919229,
+		6697170250341189791,
+		5398324923296654736,
+		4714745196795227729,
+		3819961382705988149,
+		9153576880810686288,
+		611416425896583381,
+		3399714368881017233,
+		2304162806952053442,
+		3469842424465909650,
+		6897794530481232756,
+		8441904649781050665,
+		2242517733289428004,
+		768724763267573750,
+		5683591081546118603,
+		6660964340230259978,
+		4718874793574363326,
+		1118149379837013888,
+		3499297228475645334,
+		7499585297508749752,
+		8958129396707967997,
+		3352581456039881706,
+		832554695800549835,
+		7929481687412864951,
+		1600940733170663175,
+		7404570840813665476,
+		2846641752555153449,
+		1285521160963041966,
+		8459502330060024896,
+		1601304011135193243,
+		4370479167316744740,
+		4784932350098198625,
+		8740030249693806623,
+		520764688065818135,
+		2119852856765189566,
+		2795830526891495400,
+		5580029387212611309,
+		9207334646085116079,
+		2372035349088616957,
+		4290637261219692220,
+		8260931377238344119,
+		632301330549622017,
+		4085768346595458058,
+		2177145758704367675,
+		4459432376564502924,
+		6130216444363212320,
+		6922566960352121128,
+		5146797112434257062,
 	}
 
 	for i := 0; i < len(expected); i++ {
@@ -126,3 +131,14 @@ func TestMRG63k3A(t *testing.T) {
 	}
 
 }
+
+func TestMRG63k3ASubstreams(t *testing.T) {
+	rng, err := source64.NewMRG63k3AFromStream([]uint64{123456789, 123456789, 123456789, 123456789, 123456789, 123456789})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	rng.Jump()
+	grandtest.CheckVectors(t, rng, "testdata/mrg63k3a_substream1.txt")
+}
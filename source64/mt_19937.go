@@ -111,6 +111,7 @@ func (mt *MT19937) Restart() {
 		mt.state[i] = mt.stream[i]
 	}
 
+	mt.index = mt19937_n
 	mt.resetState()
 }
 
@@ -0,0 +1,68 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestMWC128(t *testing.T) {
+	/*
+	 * Data from running a C build of the reference algorithm (MWC128.c).
+	 */
+	rng, err := source64.NewMWC128FromStream([]uint64{0x6a09e667f3bcc908, 0xbb67ae8584caa73b})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0x6a09e667f3bcc908, 0x46e9df26de7b0003,
+		0xcf16e82144593a20, 0xb423b0501e5bd95f,
+		0x8e4bbc012ba1cfb8, 0xeaf1d5bf1019e3bb,
+		0x87435a735182719c, 0xb43d6e4bef899416,
+		0xf5c46a0d4fa2bfee, 0xb7cafe1234079988,
+		0xf1f1c96540a5312c, 0x1ab5b37118fa4fe0,
+		0xe341eeda1b7d4792, 0x4e32996ea19ece77,
+		0xec37bb67607dbbc6, 0x2bbe32b07b91c07d,
+		0xc253aa99317e26fc, 0x844853caf84b30ea,
+		0xb49a4f656d95142d, 0xb4c1f3f67f33d2ca,
+		0x811ca6c2395b3987, 0x1d4e71742a27d047,
+		0xcc468e4aebfe6228, 0xcbfb68813b0f1416,
+		0xb25aeb8f10de8751, 0x2bd2adc3e1c3ddde,
+		0x691be8ad7998ac80, 0x44433f9f61acec35,
+		0x251df38de689e5ff, 0x7bda91de9c6d354c,
+		0x70b7f14d2b6a8c84, 0x75f6c9a2cb82ce31,
+		0x94871a0086107133, 0x8718eed99d7f0c64,
+		0xc971567f84eac814, 0x524d7cb52f433cd8,
+		0xc203a899b7337141, 0x37173a37eaaf9aa2,
+		0x2ffc63ec99648001, 0xae0ad88479261df2,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Jump", func(t *testing.T) {
+		rng.Restart()
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/mwc128_jump.txt")
+
+		rng.Restart()
+		rng.LongJump()
+		grandtest.CheckVectors(t, rng, "testdata/mwc128_longjump.txt")
+	})
+
+	t.Run("InvalidCarry", func(t *testing.T) {
+		for _, c := range []uint64{0, 0xffebb71d94fcdaf8, 0xffebb71d94fcdaf8 + 1, 1<<64 - 1} {
+			if _, err := source64.NewMWC128FromStream([]uint64{0x6a09e667f3bcc908, c}); err == nil {
+				t.Errorf("No error for the carry %#x", c)
+			}
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package source64
+
+import (
+	"math/bits"
+)
+
+// Implements Sebastiano Vigna's MWC192, a multiply-with-carry generator with lag 2, base 2^64 and
+// multiplier 0xffa04e67b3c95d86.
+//
+// It is close in speed to the scrambled linear generators, its only 128-bit operations being a
+// multiplication and a sum. The state is 192 bits (including the carry) and the period is
+// about 2^191. Jump() and LongJump() are computed on the equivalent multiplicative LCG.
+//
+// http://prng.di.unimi.it/MWC192.c
+// http://prng.di.unimi.it/
+type MWC192 struct {
+	baseMWC64
+}
+
+// The stream holds the words x, y (the oldest first), followed by the carry c, which must satisfy 0 < c < 0xffa04e67b3c95d85.
+func NewMWC192FromStream(seed []uint64) (*MWC192, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(MWC192)
+	ans.spi = ans
+	ans.params = mwc192_params
+	err = ans.setSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewMWC192(seed int64) *MWC192 {
+	ans := new(MWC192)
+	ans.spi = ans
+	ans.params = mwc192_params
+	ans.Seed(seed)
+	return ans
+}
+
+func (mwc *MWC192) Uint64() uint64 {
+	x, y, c := mwc.state[0], mwc.state[1], mwc.state[2]
+	hi, lo := bits.Mul64(mwc192_a, x)
+	lo, carry := bits.Add64(lo, c, 0)
+	mwc.state[0], mwc.state[1], mwc.state[2] = y, lo, hi+carry
+	return y
+}
+
+func (mwc *MWC192) String() string {
+	return "MWC192"
+}
@@ -0,0 +1,68 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestMWC192(t *testing.T) {
+	/*
+	 * Data from running a C build of the reference algorithm (MWC192.c).
+	 */
+	rng, err := source64.NewMWC192FromStream([]uint64{0x6a09e667f3bcc908, 0x3c6ef372fe94f82b, 0xbb67ae8584caa73b})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0x3c6ef372fe94f82b, 0x9f8abe7d1debc96b,
+		0xa91f77a2d04d9c73, 0x8b7085ba4451e790,
+		0x247eadd10fa98110, 0x5ee1de1c1030cad7,
+		0xde4ef3202f9691c7, 0x308570b40ddbd341,
+		0x2b4f48d23cdecc3d, 0x7645a4598d549bfd,
+		0xa00821f6ea275eb8, 0x2e3ba0ddb922643e,
+		0x2efc74eff1642b78, 0x331db147f0ab04b2,
+		0x6a84584712e7a6f7, 0x661d7ceb4b986850,
+		0x9ddbdde110651c12, 0xeae1a8af37afb8e0,
+		0x5b509127bd412f16, 0x1d9108158ff0db65,
+		0x164c1881f59f45e5, 0xf87cf8a391336a47,
+		0xaf8b22121fff24cc, 0x6066ad69c1e5ca7c,
+		0xa34cfd2008810c95, 0xa54ade59820ac643,
+		0xfc7e255c75326906, 0xc6d9ab68378bdcd5,
+		0xbf39934c35ae42a3, 0xbfad800a5ea871ee,
+		0x6dde9812825274f6, 0x92d756c395de61f2,
+		0x29602a36e0004c52, 0xb9de4c87286da81c,
+		0x46a241acc2fd7e37, 0x6fb4712aac7cf455,
+		0x5d31d7c531c2266d, 0xd60c989c230237b1,
+		0x6295c22b6d2e8cdd, 0xdaa5fbf6d66bfed2,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Jump", func(t *testing.T) {
+		rng.Restart()
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/mwc192_jump.txt")
+
+		rng.Restart()
+		rng.LongJump()
+		grandtest.CheckVectors(t, rng, "testdata/mwc192_longjump.txt")
+	})
+
+	t.Run("InvalidCarry", func(t *testing.T) {
+		for _, c := range []uint64{0, 0xffa04e67b3c95d85, 0xffa04e67b3c95d85 + 1, 1<<64 - 1} {
+			if _, err := source64.NewMWC192FromStream([]uint64{0x6a09e667f3bcc908, 0x3c6ef372fe94f82b, c}); err == nil {
+				t.Errorf("No error for the carry %#x", c)
+			}
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package source64
+
+import (
+	"math/bits"
+)
+
+// Implements Sebastiano Vigna's MWC256, a multiply-with-carry generator with lag 3, base 2^64 and
+// multiplier 0xff377e26f82da74a.
+//
+// It is close in speed to the scrambled linear generators, its only 128-bit operations being a
+// multiplication and a sum. The state is 256 bits (including the carry) and the period is
+// about 2^255. Jump() and LongJump() are computed on the equivalent multiplicative LCG.
+//
+// http://prng.di.unimi.it/MWC256.c
+// http://prng.di.unimi.it/
+type MWC256 struct {
+	baseMWC64
+}
+
+// The stream holds the words x, y, z (the oldest first), followed by the carry c, which must satisfy 0 < c < 0xff377e26f82da749.
+func NewMWC256FromStream(seed []uint64) (*MWC256, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(MWC256)
+	ans.spi = ans
+	ans.params = mwc256_params
+	err = ans.setSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewMWC256(seed int64) *MWC256 {
+	ans := new(MWC256)
+	ans.spi = ans
+	ans.params = mwc256_params
+	ans.Seed(seed)
+	return ans
+}
+
+func (mwc *MWC256) Uint64() uint64 {
+	x, y, z, c := mwc.state[0], mwc.state[1], mwc.state[2], mwc.state[3]
+	hi, lo := bits.Mul64(mwc256_a, x)
+	lo, carry := bits.Add64(lo, c, 0)
+	mwc.state[0], mwc.state[1], mwc.state[2], mwc.state[3] = y, z, lo, hi+carry
+	return z
+}
+
+func (mwc *MWC256) String() string {
+	return "MWC256"
+}
@@ -0,0 +1,68 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestMWC256(t *testing.T) {
+	/*
+	 * Data from running a C build of the reference algorithm (MWC256.c).
+	 */
+	rng, err := source64.NewMWC256FromStream([]uint64{0x6a09e667f3bcc908, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1, 0xbb67ae8584caa73b})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0xa54ff53a5f1d36f1, 0x4da69ab136e8fb8b,
+		0xb6ef4d2f1c4d10df, 0x31e4602b55d22d13,
+		0xf679e83a13fbfe4f, 0x6149b9380765a64b,
+		0xcb58d36261aa5c28, 0x5f9593a739059479,
+		0x92ec521699c5680e, 0x8eb56096033869f8,
+		0x95d159288684a452, 0x9d9dc9bd8aab30d2,
+		0x7d3608ca6ff887dc, 0x9891b4a3e83234f0,
+		0xaac8ca6c2524d52d, 0xfc91d50a4c814d8f,
+		0xca9b8aa6ddfe1918, 0x8f3e4594d891b872,
+		0x5567eee7aa579eec, 0x5ad1a996d7d02739,
+		0x4b3fc9e3ec3501a2, 0x195dd5c0a08b07e6,
+		0xfc69ecd28708cc5a, 0xe00c6ba4c9e094b8,
+		0x13e894878fcf3b8e, 0xaa70a664b9b2b982,
+		0x250d873a65003254, 0x5e36127f473bff22,
+		0x8952186842d4611c, 0x1d0018f6041e112f,
+		0xfccd21e1086459fa, 0x1cfb09c3c46cfa0f,
+		0x9d76c753c3747e20, 0xf3e3119cc604fc89,
+		0xf32342bf3c2b6c21, 0x9e924811bb754002,
+		0xc6f75d034b575cc5, 0xa189075286a0893e,
+		0x55bf40340ed0895c, 0x61c8b9ed33d8cef2,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Jump", func(t *testing.T) {
+		rng.Restart()
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/mwc256_jump.txt")
+
+		rng.Restart()
+		rng.LongJump()
+		grandtest.CheckVectors(t, rng, "testdata/mwc256_longjump.txt")
+	})
+
+	t.Run("InvalidCarry", func(t *testing.T) {
+		for _, c := range []uint64{0, 0xff377e26f82da749, 0xff377e26f82da749 + 1, 1<<64 - 1} {
+			if _, err := source64.NewMWC256FromStream([]uint64{0x6a09e667f3bcc908, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1, c}); err == nil {
+				t.Errorf("No error for the carry %#x", c)
+			}
+		}
+	})
+}
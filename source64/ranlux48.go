@@ -0,0 +1,202 @@
+package source64
+
+import (
+	"fmt"
+
+	"github.com/jtejido/grand/internal/ranlux"
+)
+
+const (
+	ranlux48_w           = 48
+	ranlux48_s           = 5
+	ranlux48_r           = 12
+	ranlux48_used        = 11
+	ranlux48_mask uint64 = 1<<ranlux48_w - 1
+	// Jump() skips 2^ranlux_jump blocks.
+	ranlux_jump = 128
+)
+
+// RANLUX48Luxury holds the block sizes p of the luxury levels 0 to 4, as for RANLUX24.
+// Level 4 (p = 389) is std::ranlux48.
+var RANLUX48Luxury = [5]int{24, 48, 97, 223, 389}
+
+// Implements RANLUX48, Lüscher's RANLUX on the 48-bit subtract-with-borrow generator, as std::ranlux48 does:
+// x(n) = x(n-5) - x(n-12) - c(n-1) mod 2^48, of which only the first 11 values of each block of p values
+// are used. Each step is two steps of the 24-bit recursion of RANLUX24.
+//
+// Next() returns the 48-bit values of std::ranlux48 (with p = 389), while Uint64() packs them into 64-bit
+// values without dropping any bit (4 values for 3 calls).
+//
+// The recursion is equivalent to a LCG modulo 2^576 - 2^240 + 1, so Jump() is a modular multiplication.
+//
+// M. Lüscher, A portable high-quality random number generator for lattice field theory simulations.
+// Computer Physics Communications, 1994, 79, 100--110.
+type RANLUX48 struct {
+	baseJumpableSource64
+	p    int
+	jump ranlux.Number
+	// x is a ring buffer of the lag values, x[i] is the oldest one.
+	x [ranlux48_r]uint64
+	i int
+	c uint64
+	// n is the number of values used in the current block.
+	n int
+	// pool holds the npool bits of the last value that were not returned by Uint64().
+	pool  uint64
+	npool uint
+}
+
+// A single value is used as in std::ranlux48's seed(value), longer streams hold the 12 lag values
+// (the oldest first, filled if shorter and truncated to 48 bits), the carry is set as in std::ranlux48.
+func NewRANLUX48FromStream(p int, seed []uint64) (*RANLUX48, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans, err := newRANLUX48(p)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewRANLUX48(p int, seed int64) (*RANLUX48, error) {
+	ans, err := newRANLUX48(p)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.Seed(seed)
+	return ans, nil
+}
+
+func newRANLUX48(p int) (*RANLUX48, error) {
+	if p < ranlux48_used {
+		return nil, fmt.Errorf("The block size must be at least %d", ranlux48_used)
+	}
+
+	ans := new(RANLUX48)
+	ans.spi = ans
+	ans.p = p
+	// each step is two steps of the 24-bit recursion.
+	ans.jump = ranlux.PowMod(&ranlux.A, 2*uint64(p))
+	ans.jump = ranlux.Pow2Mod(&ans.jump, ranlux_jump)
+	return ans, nil
+}
+
+func (rl *RANLUX48) setSeed(seed []uint64) {
+	x := make([]uint64, ranlux48_r)
+	var c uint64
+	if len(seed) == 1 {
+		c = ranlux.SeedSequence(seed[0], ranlux48_w, x)
+	} else {
+		fillState(x, seed)
+		for i := range x {
+			x[i] &= ranlux48_mask
+		}
+		if x[ranlux48_r-1] == 0 {
+			c = 1
+		}
+	}
+
+	rl.stream = append(x, c)
+	rl.Restart()
+}
+
+func (rl *RANLUX48) Seed(seed int64) {
+	seeds := make([]uint64, ranlux48_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < len(seeds) {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	rl.setSeed(seeds)
+}
+
+func (rl *RANLUX48) Restart() {
+	rl.substream = append([]uint64{}, rl.stream...)
+	rl.RestartSubstream()
+}
+
+func (rl *RANLUX48) RestartSubstream() {
+	copy(rl.x[:], rl.substream)
+	rl.c = rl.substream[ranlux48_r]
+	rl.i = 0
+	rl.n = 0
+	rl.pool = 0
+	rl.npool = 0
+	rl.resetState()
+}
+
+// Jump advances the start of the current substream by 2^128 blocks of p steps of the recursion,
+// i.e. 2^128*11 calls to Next().
+func (rl *RANLUX48) Jump() {
+	rl.substream[ranlux48_r] = ranlux.Advance(rl.substream[:ranlux48_r], rl.substream[ranlux48_r], ranlux48_w, &rl.jump)
+	rl.RestartSubstream()
+}
+
+// Returns the block size p.
+func (rl *RANLUX48) Luxury() int {
+	return rl.p
+}
+
+// Returns the next value of the subtract-with-borrow recursion.
+func (rl *RANLUX48) step() uint64 {
+	// x[i] is x(n-12) and x[j] is x(n-5).
+	j := rl.i + ranlux48_r - ranlux48_s
+	if j >= ranlux48_r {
+		j -= ranlux48_r
+	}
+
+	// a borrow wraps around and sets the most significant bit.
+	y := rl.x[j] - rl.x[rl.i] - rl.c
+	rl.c = y >> 63
+	y &= ranlux48_mask
+	rl.x[rl.i] = y
+	rl.i++
+	if rl.i == ranlux48_r {
+		rl.i = 0
+	}
+
+	return y
+}
+
+// Next returns the next 48-bit value of the generator (the output of std::ranlux48 when p = 389).
+func (rl *RANLUX48) Next() uint64 {
+	if rl.n >= ranlux48_used {
+		for ; rl.n < rl.p; rl.n++ {
+			rl.step()
+		}
+		rl.n = 0
+	}
+
+	rl.n++
+	return rl.step()
+}
+
+func (rl *RANLUX48) Uint64() uint64 {
+	ans, n := rl.pool, rl.npool
+	for {
+		x := rl.Next()
+		ans |= x << n
+		if n+ranlux48_w >= 64 {
+			rl.pool = x >> (64 - n)
+			rl.npool = n + ranlux48_w - 64
+			return ans
+		}
+		n += ranlux48_w
+	}
+}
+
+func (rl *RANLUX48) String() string {
+	return "RANLUX48"
+}
@@ -0,0 +1,69 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestRANLUX48(t *testing.T) {
+	t.Run("Std", func(t *testing.T) {
+		// The 10000th value of a default-constructed std::ranlux48 is 249142670248501 ([rand.predef]).
+		rng, err := source64.NewRANLUX48FromStream(source64.RANLUX48Luxury[4], []uint64{19780503})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got uint64
+		for i := 0; i < 10000; i++ {
+			got = rng.Next()
+		}
+		if got != 249142670248501 {
+			t.Errorf("Mismatch. want: %v, got: %v", uint64(249142670248501), got)
+		}
+	})
+
+	rng, err := source64.NewRANLUX48FromStream(source64.RANLUX48Luxury[3], []uint64{12345})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the values of std::ranlux48_base seeded with 12345, discarded as in std::ranlux48 with p = 223
+	 * and packed in 64-bit values.
+	 */
+	expected := []uint64{
+		0x0bc26ba603fafb6b, 0xef3380d0a148fd1c, 0x7f49bd1097accc2e, 0x248ab22c2cda6e71,
+		0x89a11a96584bea18, 0xd5e0cdc71807838c, 0x10a9191755c27f85, 0xe4329bf720140a06,
+		0x1c292346edaec392, 0xc8b5e6ebd9b0d913, 0xaec8b98b7ca0fc74, 0x472c8c31db23403c,
+		0xba2c6ade34d7cc0a, 0xf9d895418dbbffd6, 0x4dd1369bd6081b5c, 0x406ed4e797afa3f3,
+		0x706eb79cb025ba9d, 0xf7825e0f16942834, 0x7aeb7946a2d2feb6, 0x45d76bea871c5105,
+		0x6b870363d4d13483, 0xca4514f2f9ab22f0, 0x060ee01c78c159fb, 0x7e64bcd122c91b8a,
+		0x244935e3bcfec97c, 0x85059be7dd17dcec, 0x34242c217b9bb389, 0x22c5f76a8cad1444,
+		0x7309d50765d74862, 0x44f7cdac9285cb21, 0x109fd3f686bd5afe, 0xe0027fc42ed4b620,
+		0x7b16b2f1da3454c3, 0x19d7fd1cfd2317c9, 0xa442acb34a3b93df, 0x2113d5ab9dfd8db8,
+		0x1eacdba0aca45bdb, 0xaee9aeb59ceb9da1, 0x978b430ffce189dd, 0xec5b1723e58fac1a,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Jump", func(t *testing.T) {
+		rng.Restart()
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/ranlux48_jump.txt")
+	})
+
+	t.Run("InvalidLuxury", func(t *testing.T) {
+		if _, err := source64.NewRANLUX48(10, 1); err == nil {
+			t.Errorf("A block size smaller than 11 should be rejected.")
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package source64
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jtejido/grand/internal/ranlux"
+)
+
+const (
+	// RANLUXPlusPlusDefaultLuxury is the luxury level p = 2048 recommended by the reference implementation.
+	RANLUXPlusPlusDefaultLuxury = 2048
+	ranluxpp_min_luxury         = 24
+	// a seed s starts the stream 2^96*s states after the state 1.
+	ranluxpp_seed_skip = 96
+)
+
+// Implements RANLUX++ from Alexei Sibidanov, the LCG X(n+1) = a^p*X(n) mod m with m = 2^576 - 2^240 + 1,
+// where a is the multiplier equivalent to one step of the subtract-with-borrow recursion of RANLUX24.
+// Each state X gives the 576 bits of the 24 lag values of that recursion (9 calls to Uint64()), which is
+// RANLUX with all the values of each block of p steps, computed at the cost of one modular multiplication.
+//
+// As the state is a number modulo m, Jump() is a modular multiplication too.
+//
+// Alexei Sibidanov, A revision of the subtract-with-borrow random number generators.
+// Computer Physics Communications, 2017, 221, 299--303. https://arxiv.org/abs/1705.03123
+type RANLUXPlusPlus struct {
+	baseJumpableSource64
+	p          int
+	mult, jump ranlux.Number
+	lcg        ranlux.Number
+	// bits holds the lag values of the state lcg, pos is the next word returned by Uint64().
+	bits ranlux.Number
+	pos  int
+}
+
+// A single value s is used as the seed of the reference implementation, which starts the stream at the state
+// a^(p*2^96*s). Longer streams are the state X of the LCG (the least significant word first), which must satisfy
+// 0 < X < m.
+func NewRANLUXPlusPlusFromStream(p int, seed []uint64) (*RANLUXPlusPlus, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans, err := newRANLUXPlusPlus(p)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ans.setSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewRANLUXPlusPlus(p int, seed int64) (*RANLUXPlusPlus, error) {
+	ans, err := newRANLUXPlusPlus(p)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.Seed(seed)
+	return ans, nil
+}
+
+func newRANLUXPlusPlus(p int) (*RANLUXPlusPlus, error) {
+	if p < ranluxpp_min_luxury {
+		return nil, fmt.Errorf("The luxury level must be at least %d", ranluxpp_min_luxury)
+	}
+
+	ans := new(RANLUXPlusPlus)
+	ans.spi = ans
+	ans.p = p
+	ans.mult = ranlux.PowMod(&ranlux.A, uint64(p))
+	ans.jump = ranlux.Pow2Mod(&ans.mult, ranlux_jump)
+	return ans, nil
+}
+
+func (rl *RANLUXPlusPlus) setSeed(seed []uint64) error {
+	var x ranlux.Number
+	if len(seed) == 1 {
+		x = ranlux.Pow2Mod(&rl.mult, ranluxpp_seed_skip)
+		x = ranlux.PowMod(&x, seed[0])
+	} else {
+		fillState(x[:], seed)
+		if x.IsZero() || !x.Less(&ranlux.M) {
+			return errors.New("The state must be greater than 0 and less than 2^576 - 2^240 + 1")
+		}
+	}
+
+	rl.stream = append([]uint64{}, x[:]...)
+	rl.Restart()
+	return nil
+}
+
+func (rl *RANLUXPlusPlus) Seed(seed int64) {
+	seeder.Seed(seed)
+	// Initialize the pool content.
+	rl.setSeed([]uint64{seeder.Uint64()})
+}
+
+func (rl *RANLUXPlusPlus) Restart() {
+	rl.substream = append([]uint64{}, rl.stream...)
+	rl.RestartSubstream()
+}
+
+func (rl *RANLUXPlusPlus) RestartSubstream() {
+	copy(rl.lcg[:], rl.substream)
+	rl.bits, _ = ranlux.ToRanlux(&rl.lcg)
+	rl.pos = 0
+	rl.resetState()
+}
+
+// Jump advances the start of the current substream by 2^128 states, i.e. 9*2^128 calls to Uint64().
+func (rl *RANLUXPlusPlus) Jump() {
+	var x ranlux.Number
+	copy(x[:], rl.substream)
+	x = ranlux.MulMod(&rl.jump, &x)
+	copy(rl.substream, x[:])
+	rl.RestartSubstream()
+}
+
+// Returns the luxury level p.
+func (rl *RANLUXPlusPlus) Luxury() int {
+	return rl.p
+}
+
+func (rl *RANLUXPlusPlus) Uint64() uint64 {
+	if rl.pos == len(rl.bits) {
+		rl.lcg = ranlux.MulMod(&rl.mult, &rl.lcg)
+		rl.bits, _ = ranlux.ToRanlux(&rl.lcg)
+		rl.pos = 0
+	}
+
+	ans := rl.bits[rl.pos]
+	rl.pos++
+	return ans
+}
+
+func (rl *RANLUXPlusPlus) String() string {
+	return "RANLUXPlusPlus"
+}
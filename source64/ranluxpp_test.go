@@ -0,0 +1,76 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestRANLUXPlusPlus(t *testing.T) {
+	rng, err := source64.NewRANLUXPlusPlusFromStream(source64.RANLUXPlusPlusDefaultLuxury, []uint64{314159265})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the 24-bit subtract-with-borrow recursion started at the state a^(2048*2^96*314159265)
+	 * of the equivalent LCG, taking the 576 bits of its lag values every 2048 steps.
+	 */
+	expected := []uint64{
+		0x56f723d075012181, 0x265f853e921add2c, 0xa6a7054394a421c5, 0xcd2e70d0f9b19195,
+		0x7ce7d57ba8b98182, 0x3980ec83b845f4a0, 0x9059d8491278f705, 0xd94264c5634e05dd,
+		0x2b0a44aaf5502f7e, 0x825ca842e3eac49d, 0x09903a9048b66375, 0x3682dff05a6bffdc,
+		0x91e619683a185da9, 0x5df1345a33417c10, 0xd604e2c7d5b820c6, 0x181027ae6120ffe7,
+		0x9f4c975b9193be4e, 0xbb7547aaa5fd9813, 0xdb8a51294fcb0667, 0x49884f52ccb90935,
+		0x1be0665557b19654, 0x7eaacdcb5293b147, 0x62eb4ff1236b975c, 0x13be8124ff9ed8d1,
+		0xf992d8e4e8230fa7, 0x36d282016fc2fc0d, 0x29163bd5c61d9244, 0x9d6f0fd89621dcef,
+		0x9953539c4836dbcf, 0xf39a536cd9ba5a40, 0xfdeae73249d8f919, 0x6db23ed36407beca,
+		0xfb3c6330d7113882, 0x4f84ca4faf680277, 0xb39bb2d241df9f2c, 0x37452049d2817dee,
+		0x066b8abddb44dd88, 0x69c12bb153226991, 0x51c343e635505f1c, 0xe682b61b1c802c11,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Jump", func(t *testing.T) {
+		rng.Restart()
+		rng.Jump()
+		grandtest.CheckVectors(t, rng, "testdata/ranluxpp_jump.txt")
+	})
+
+	t.Run("ROOT", func(t *testing.T) {
+		/*
+		 * Data from the RanluxppEngine2048 test of ROOT (math/mathcore/test/testRanluxpp.cxx), a port of
+		 * Sibidanov's ranluxpp seeded with 314159265: its first IntRndm() is 39378223178113 and its
+		 * following Rndm() is 0.57072241146576274, i.e. the bits 0 to 47 and 48 to 95 of the state.
+		 */
+		rng.Restart()
+		lo, hi := rng.Uint64(), rng.Uint64()
+		if got := lo & (1<<48 - 1); got != 39378223178113 {
+			t.Errorf("Mismatch. want: %v, got: %v", uint64(39378223178113), got)
+		}
+		if got := float64(lo>>48|(hi&(1<<32-1))<<16) * 0x1p-48; got != 0.57072241146576274 {
+			t.Errorf("Mismatch. want: %v, got: %v", 0.57072241146576274, got)
+		}
+	})
+
+	t.Run("InvalidState", func(t *testing.T) {
+		if _, err := source64.NewRANLUXPlusPlus(23, 1); err == nil {
+			t.Errorf("A luxury level smaller than 24 should be rejected.")
+		}
+
+		m := []uint64{1, 0, 0, 0xffff000000000000, 1<<64 - 1, 1<<64 - 1, 1<<64 - 1, 1<<64 - 1, 1<<64 - 1}
+		for _, s := range [][]uint64{make([]uint64, 9), m} {
+			if _, err := source64.NewRANLUXPlusPlusFromStream(source64.RANLUXPlusPlusDefaultLuxury, s); err == nil {
+				t.Errorf("No error for the state %#x", s)
+			}
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package source64
+
+const (
+	romuduojr_r = 2
+)
+
+// Implements RomuDuoJr from Mark Overton's Romu family, the fastest member of the family, for moderate
+// numbers of values.
+//
+// The state size is 128-bits; the estimated capacity is 2^51 bytes. The all-zero state is a fixed point
+// of the generator and is rejected.
+//
+// Mark A. Overton, Romu: Fast Nonlinear Pseudo-Random Number Generators Providing High Quality.
+// https://arxiv.org/abs/2002.11331
+// https://www.romu-random.org
+type RomuDuoJr struct {
+	baseSource64
+	state [2]uint64
+}
+
+func NewRomuDuoJrFromStream(seed []uint64) (*RomuDuoJr, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(RomuDuoJr)
+	ans.spi = ans
+	tmp := make([]uint64, romuduojr_r)
+	fillState(tmp, seed)
+	err = checkZeroState(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(tmp)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewRomuDuoJr(seed int64) *RomuDuoJr {
+	ans := new(RomuDuoJr)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (r *RomuDuoJr) setSeed(seed []uint64) {
+	r.stream = append([]uint64{}, seed...)
+	r.Restart()
+}
+
+func (r *RomuDuoJr) Seed(seed int64) {
+	seeds := make([]uint64, romuduojr_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < romuduojr_r {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	r.setSeed(seeds)
+}
+
+func (r *RomuDuoJr) Restart() {
+	copy(r.state[:], r.stream)
+	r.resetState()
+}
+
+func (r *RomuDuoJr) Uint64() uint64 {
+	xp := r.state[0]
+	r.state[0] = romu_mult * r.state[1]
+	r.state[1] = rotateLeft(r.state[1]-xp, 27)
+	return xp
+}
+
+func (r *RomuDuoJr) String() string {
+	return "RomuDuoJr"
+}
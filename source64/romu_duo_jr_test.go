@@ -0,0 +1,45 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestRomuDuoJr(t *testing.T) {
+	rng, err := source64.NewRomuDuoJrFromStream([]uint64{0x0123456789abcdef, 0xfedcba9876543210})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the reference C code.
+	 */
+	expected := []uint64{
+		0x0123456789abcdef, 0x7c447f53146e1ab0, 0x46e9c60b180d1983, 0x4b17b22f0610fcca,
+		0xa95ff71af3023ec9, 0x91b5068ad61cb4c3, 0x424aa10a374a8824, 0x1eb4bf85c8298ffb,
+		0x15791a27000846df, 0x743c2e8ba26aa65c, 0x6733b0c32520a6b2, 0xd7fdec1825d749f7,
+		0xef5f6f56e47e3981, 0x495bb500026548c3, 0xaa69dc4f5e546b00, 0x8486bfceb27f0f6f,
+		0x8dc183eae1c974c1, 0x0034a8ecea491f6a, 0x1ff1ffb6ea2e33ac, 0x7c021f0f41320c77,
+		0x59467da929def59d, 0xb0d592a9ee27c7d4, 0x7566534d8e21cc81, 0x0fd9cdc27b5c12cb,
+		0xdc7dadf04959499d, 0x856a89829e596275, 0x4d881dd6c79061ea, 0xd18ee540c8b28892,
+		0x3dac7678910fb6bb, 0x2f9f6a2252e25ef2, 0xbbe238a08309f6d4, 0xa7a07545305a2f2c,
+		0x0a5005e244222a96, 0x6d59bb1a8ca5f8d7, 0xc205ec566535ac96, 0xb5237648959ec638,
+		0x5a25d619603ffaaf, 0xe4c8bba7abdff968, 0xdfe429cca107cc11, 0x1dba88cb7d9a9e4c,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("ZeroState", func(t *testing.T) {
+		if _, err := source64.NewRomuDuoJrFromStream(make([]uint64, 2)); err == nil {
+			t.Errorf("The all-zero state should be rejected.")
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestRomuDuo(t *testing.T) {
+	rng, err := source64.NewRomuDuoFromStream([]uint64{0x0123456789abcdef, 0xfedcba9876543210})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the reference C code.
+	 */
+	expected := []uint64{
+		0x0123456789abcdef, 0x7c447f53146e1ab0, 0x01d3cb454189b4c2, 0xd3d584870f26c92f,
+		0xa5878dbbfa9d2817, 0x823be6ee7adcd3ae, 0x01f792268fea9155, 0x24154b7bca005819,
+		0x2b535b0ad2cc18b2, 0x7deb32202cdb5175, 0x04e48570768adaa4, 0xcb9c2a0dc8c5dde5,
+		0x4f5afeedf219e4eb, 0xfdac07dda7f03f5f, 0x43ee599c22685d08, 0xc2fb469a83b344fa,
+		0x2d5a921de0ce4fad, 0xd65b86e7ecc579b8, 0xba1e4ba79fc81de4, 0x46cae2a6df4fbcca,
+		0xacf8d9be916c690d, 0x888b7f173e9ed298, 0x7f6b3819940db7c8, 0xc9338ebadff7c2d6,
+		0x2334a3be21c86acf, 0xc9d76fb3c5ca2eb7, 0x2f42909be27ce54b, 0xb97161916677df91,
+		0xf87126dde05d40af, 0x4eae4f7f8c97f800, 0xcb53024fbd4b8036, 0x012b38f0b9d2ba2c,
+		0xd35ba2ee086b1d0b, 0x2a8f565e54f566aa, 0xe2a47b7b8b86a373, 0x1c85a9a02f2f07c7,
+		0x33eaa64861fa8f21, 0xcd0db27fc75f9146, 0x70d4674603d8ce57, 0x0a4f56c8369b4401,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("ZeroState", func(t *testing.T) {
+		if _, err := source64.NewRomuDuoFromStream(make([]uint64, 2)); err == nil {
+			t.Errorf("The all-zero state should be rejected.")
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package source64
+
+const (
+	romuquad_r = 4
+	// the multiplier of the 64-bit Romu generators.
+	romu_mult uint64 = 15241094284759029579
+)
+
+// Implements RomuQuad from Mark Overton's Romu family, the largest member of the family, for when a huge
+// number of values or of parallel streams is needed.
+//
+// The state size is 256-bits; the estimated capacity is 2^90 bytes. The all-zero state is a fixed point
+// of the generator and is rejected.
+//
+// The Romu generators are nonlinear (a multiplication and rotations), so they have no single period: the
+// capacity is the estimated number of bytes that can be drawn before the risk of running into a cycle too short
+// becomes significant, for a state seeded at random.
+//
+// Mark A. Overton, Romu: Fast Nonlinear Pseudo-Random Number Generators Providing High Quality.
+// https://arxiv.org/abs/2002.11331
+// https://www.romu-random.org
+type RomuQuad struct {
+	baseSource64
+	state [4]uint64
+}
+
+func NewRomuQuadFromStream(seed []uint64) (*RomuQuad, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(RomuQuad)
+	ans.spi = ans
+	tmp := make([]uint64, romuquad_r)
+	fillState(tmp, seed)
+	err = checkZeroState(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(tmp)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewRomuQuad(seed int64) *RomuQuad {
+	ans := new(RomuQuad)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (r *RomuQuad) setSeed(seed []uint64) {
+	r.stream = append([]uint64{}, seed...)
+	r.Restart()
+}
+
+func (r *RomuQuad) Seed(seed int64) {
+	seeds := make([]uint64, romuquad_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < romuquad_r {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	r.setSeed(seeds)
+}
+
+func (r *RomuQuad) Restart() {
+	copy(r.state[:], r.stream)
+	r.resetState()
+}
+
+func (r *RomuQuad) Uint64() uint64 {
+	wp, xp, yp, zp := r.state[0], r.state[1], r.state[2], r.state[3]
+	r.state[0] = romu_mult * zp
+	r.state[1] = zp + rotateLeft(wp, 52)
+	r.state[2] = yp - xp
+	r.state[3] = rotateLeft(yp+wp, 19)
+	return xp
+}
+
+func (r *RomuQuad) String() string {
+	return "RomuQuad"
+}
@@ -0,0 +1,45 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestRomuQuad(t *testing.T) {
+	rng, err := source64.NewRomuQuadFromStream([]uint64{0x0123456789abcdef, 0xfedcba9876543210, 0x0f1e2d3c4b5a6978, 0x8796a5b4c3d2e1f0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the reference C code.
+	 */
+	expected := []uint64{
+		0xfedcba9876543210, 0x6686b7e91a4b7cac, 0xaa23a076489b4f17, 0x3f64bfa5df63de6a,
+		0x059d6feeb5a87428, 0xad641703a217da4d, 0x1f2656385c29e3e2, 0xeef06ad39bfcfdd7,
+		0x5f50127aa1fb4075, 0x4043b68715389108, 0xa8e04534a0cce6d8, 0x684145b65a9639dd,
+		0xa558172fc523472a, 0x905ebc60c765b40e, 0x5eeefc874b482077, 0x09ebc39e8606cdd6,
+		0xf47e1fa81bd9d1ff, 0x02be4c85bd4dddcb, 0x9d2c5be5323ab304, 0x688730e7b22cd533,
+		0x6e9db2ef200e175c, 0x36bc72324a5659ff, 0x2f477af9fb37262a, 0x7332076ab180d25e,
+		0xd9c1b86cf2a47e8d, 0x018182cafe92869b, 0x48aad7d64a111afa, 0xfcca092bedf0843c,
+		0x6522d20e1bc33282, 0x9cefbc16f77641e1, 0x9b1738f63327641e, 0x3c3dba8513815bbe,
+		0xf2987d141d450413, 0x194c43c045a47a6c, 0x0776d37d84c7c779, 0x4531a3adee15954d,
+		0xc02c99099b71ed0e, 0x77d00c77aa073b92, 0x42f250b12dd745c9, 0x701cc7bb8b580c92,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("ZeroState", func(t *testing.T) {
+		if _, err := source64.NewRomuQuadFromStream(make([]uint64, 4)); err == nil {
+			t.Errorf("The all-zero state should be rejected.")
+		}
+	})
+}
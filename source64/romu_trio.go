@@ -0,0 +1,82 @@
+package source64
+
+const (
+	romutrio_r = 3
+)
+
+// Implements RomuTrio from Mark Overton's Romu family, the general-purpose member of the family.
+//
+// The state size is 192-bits; the estimated capacity is 2^75 bytes. The all-zero state is a fixed point
+// of the generator and is rejected.
+//
+// Mark A. Overton, Romu: Fast Nonlinear Pseudo-Random Number Generators Providing High Quality.
+// https://arxiv.org/abs/2002.11331
+// https://www.romu-random.org
+type RomuTrio struct {
+	baseSource64
+	state [3]uint64
+}
+
+func NewRomuTrioFromStream(seed []uint64) (*RomuTrio, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(RomuTrio)
+	ans.spi = ans
+	tmp := make([]uint64, romutrio_r)
+	fillState(tmp, seed)
+	err = checkZeroState(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(tmp)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewRomuTrio(seed int64) *RomuTrio {
+	ans := new(RomuTrio)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (r *RomuTrio) setSeed(seed []uint64) {
+	r.stream = append([]uint64{}, seed...)
+	r.Restart()
+}
+
+func (r *RomuTrio) Seed(seed int64) {
+	seeds := make([]uint64, romutrio_r)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < romutrio_r {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	r.setSeed(seeds)
+}
+
+func (r *RomuTrio) Restart() {
+	copy(r.state[:], r.stream)
+	r.resetState()
+}
+
+func (r *RomuTrio) Uint64() uint64 {
+	xp, yp, zp := r.state[0], r.state[1], r.state[2]
+	r.state[0] = romu_mult * zp
+	r.state[1] = rotateLeft(yp-xp, 12)
+	r.state[2] = rotateLeft(zp-yp, 44)
+	return xp
+}
+
+func (r *RomuTrio) String() string {
+	return "RomuTrio"
+}
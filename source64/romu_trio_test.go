@@ -0,0 +1,45 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestRomuTrio(t *testing.T) {
+	rng, err := source64.NewRomuTrioFromStream([]uint64{0x0123456789abcdef, 0xfedcba9876543210, 0x0f1e2d3c4b5a6978})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from the reference C code.
+	 */
+	expected := []uint64{
+		0x0123456789abcdef, 0x2b85cfa924f4ae28, 0x19d5218807f62670, 0xc05273700299991a,
+		0xdbc225949206b200, 0xfd48a1d9659ca9d2, 0x817d797d39d2c499, 0xa6d8be0745e7fbbd,
+		0x3359a7816d04751a, 0xd1fd5e01c8ce1b01, 0x743a469a3303ec37, 0xe1658d35565de409,
+		0x366de5cf79b28d8a, 0xc757f8d940f1ce8d, 0x2c09aaf5c0a42344, 0x7083dfc2a2f7f448,
+		0x22733d236c9302b3, 0x2e9af45fb2f9fe27, 0xf32c4e7adb7326c6, 0x455bc7a8b5fe94eb,
+		0xb8bb325e511160e3, 0x67c3310dcf8f0c8a, 0x2f54bbef861b9961, 0x9c268abee3c9fee4,
+		0x9eafd133e301e53a, 0x165f24ea48663827, 0x7ecee1361697205e, 0x9644c7af90be0d25,
+		0x35825bb8eb8197df, 0x73be077f607713b0, 0x698354ed709eab94, 0xfca8cb5abd883407,
+		0x1fcb610b031c1d38, 0x40ffb25360785031, 0x13a1c9f70bde6d70, 0xcf76f74661eeb146,
+		0x25972092f6d3396e, 0x2676de6e7a426d0e, 0xea8faa4c4f92b1f2, 0x24a3975e012a72e7,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("ZeroState", func(t *testing.T) {
+		if _, err := source64.NewRomuTrioFromStream(make([]uint64, 3)); err == nil {
+			t.Errorf("The all-zero state should be rejected.")
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package source64
+
+import "errors"
+
+// Implements Bernard Widynski's Squares, a counter-based generator: the output for the counter n is
+// the 64-bit version (five rounds of squaring) of squares(n, key), so that any position of the stream
+// can be computed directly.
+//
+// The state size is 128-bits (the key and the counter) and the period is 2^64. The key must not be 0;
+// the reference recommends keys with an irregular bit pattern and distinct hexadecimal digits, such as
+// those given by its key generator.
+//
+// Bernard Widynski, Squares: A Fast Counter-Based RNG. https://arxiv.org/abs/2004.06278
+// https://squaresrng.wixsite.com/rand
+type Squares struct {
+	baseSource64
+	key, counter uint64
+}
+
+// The stream holds the key followed by the starting counter (0 when missing).
+func NewSquaresFromStream(seed []uint64) (*Squares, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	if seed[0] == 0 {
+		return nil, errors.New("The key must not be 0")
+	}
+
+	ans := new(Squares)
+	ans.spi = ans
+	ans.stream = make([]uint64, 2)
+	copy(ans.stream, seed)
+	ans.Restart()
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewSquares(seed int64) *Squares {
+	ans := new(Squares)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (sq *Squares) Seed(seed int64) {
+	seeder.Seed(seed)
+	// Initialize the pool content, with an odd key.
+	sq.stream = []uint64{seeder.Uint64() | 1, 0}
+	sq.Restart()
+}
+
+func (sq *Squares) Restart() {
+	sq.key = sq.stream[0]
+	sq.counter = sq.stream[1]
+	sq.resetState()
+}
+
+func (sq *Squares) Uint64() uint64 {
+	x := sq.counter * sq.key
+	y := x
+	z := y + sq.key
+	sq.counter++
+
+	x = x*x + y
+	x = (x >> 32) | (x << 32) // round 1
+	x = x*x + z
+	x = (x >> 32) | (x << 32) // round 2
+	x = x*x + y
+	x = (x >> 32) | (x << 32) // round 3
+	t := x*x + z
+	x = (t >> 32) | (t << 32)    // round 4
+	return t ^ ((x*x + y) >> 32) // round 5
+}
+
+func (sq *Squares) String() string {
+	return "Squares"
+}
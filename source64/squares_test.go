@@ -0,0 +1,57 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestSquares(t *testing.T) {
+	rng, err := source64.NewSquaresFromStream([]uint64{0xc8e4fd154ce32f6d})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from squares64() of the reference code, from the counter 0.
+	 */
+	expected := []uint64{
+		0x800c823ecc9b9607, 0x5f4f366db727a9f6, 0xeee77e310b90add2, 0xf9a1dcf6ff2160d7,
+		0xb570b3f7653b9428, 0xf278e3ad15bfbe6e, 0x7681043d4de52271, 0xa107c4f588d16b56,
+		0x553c6f26401afddc, 0x50120c1666e5f73c, 0xa898425f4baa2609, 0xaa9d86e2ed81b794,
+		0x7cacf7d2a527c689, 0xd5513a24fe76883c, 0x627f364d13804bd1, 0x46970505fed2a5e9,
+		0xf32d30543bc331e8, 0xdcb3cd2175390811, 0x8c2ca64cf1b6b084, 0xb5d98c9b06a8ca9b,
+		0xfb3d46d1c11b2f55, 0xddf6fb37505fe902, 0x6596bc04b54cec43, 0x4ba09b6314ed5e20,
+		0x2557da123227d630, 0x0140707bb568ac54, 0xeaedceadfc47769e, 0x1c14950ccccc4b84,
+		0x66c95556ad3124ae, 0x6ad14aa65b3bd705, 0xd53c23fdd43c85a3, 0x0ef4fd154aee0b61,
+		0x622f916ae2a09a1b, 0x94f3927dd6d5c09f, 0x5532a6afb757adbc, 0x9b3c5a92afeb0e84,
+		0x5d5a0cda33a27f03, 0xc29b22943a5147a8, 0xdf2df3f884abc927, 0xd065715acfe97b9e,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("Counter", func(t *testing.T) {
+		// the stream can start at any counter.
+		rng, err := source64.NewSquaresFromStream([]uint64{0xc8e4fd154ce32f6d, 38})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if rg := rng.Uint64(); rg != expected[38] {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[38], rg)
+		}
+	})
+
+	t.Run("ZeroKey", func(t *testing.T) {
+		if _, err := source64.NewSquaresFromStream([]uint64{0, 1}); err == nil {
+			t.Errorf("The key 0 should be rejected.")
+		}
+	})
+}
@@ -0,0 +1,366 @@
+package source64
+
+import (
+	"errors"
+
+	"github.com/jtejido/grand/internal/gf2"
+)
+
+const (
+	tinymt_mexp     = 127
+	tinymt_min_loop = 8
+	// the number of restarts of the search of the tempering matrix.
+	tinymt_tmat_tries = 16
+
+	tinymt64_sh0         = 12
+	tinymt64_sh1         = 11
+	tinymt64_sh8         = 8
+	tinymt64_mask uint64 = 0x7fffffffffffffff
+)
+
+// TinyMT64Params holds the parameters of a TinyMT64 generator, i.e. the two matrices of the
+// recursion (Mat1, Mat2) and the tempering matrix (Tmat).
+type TinyMT64Params struct {
+	Mat1, Mat2 uint32
+	Tmat       uint64
+}
+
+// TinyMT64DefaultParams is the parameter set used in the examples of the reference code.
+var TinyMT64DefaultParams = TinyMT64Params{Mat1: 0xfa051f40, Mat2: 0xffd0fff4, Tmat: 0x58d02ffeffbfffbc}
+
+// Validate returns an error if the characteristic polynomial of the recursion is not primitive
+// (i.e. the period is not 2^127-1).
+func (p TinyMT64Params) Validate() error {
+	if _, ok := p.characteristicPolynomial(); !ok {
+		return errors.New("The characteristic polynomial of the TinyMT64 parameters is not primitive")
+	}
+
+	return nil
+}
+
+// CharacteristicPolynomial returns the characteristic polynomial of the recursion, in hexadecimal
+// (the coefficient of x^127 first), as printed by the dynamic creator (TinyMTDC).
+// Parameter sets with different polynomials generate independent streams.
+func (p TinyMT64Params) CharacteristicPolynomial() string {
+	f, _ := p.characteristicPolynomial()
+	return f.String()
+}
+
+// Returns the characteristic polynomial, and whether it is primitive.
+// As 2^127-1 is a prime, the polynomial is primitive iff it is irreducible of degree 127.
+func (p TinyMT64Params) characteristicPolynomial() (gf2.Poly, bool) {
+	st := [2]uint64{1, 0}
+	seq := make([]uint8, 2*tinymt_mexp)
+	for i := range seq {
+		p.nextState(&st)
+		seq[i] = uint8(st[1] & 1)
+	}
+
+	f := gf2.MinimalPolynomial(seq)
+	return f, f.Degree() == tinymt_mexp && gf2.IsIrreducible(f)
+}
+
+// Delta returns the sum of the dimension defects of the output, i.e. the sum over v = 1..64 of
+// floor(127/v) - k(v), where k(v) is the dimension of equidistribution with v-bit accuracy.
+// As in the reference code, it is computed on the F2-linear version of the tempering
+// (the addition is replaced by an exclusive or). The lower, the better.
+func (p TinyMT64Params) Delta() int {
+	return newTinyMT64Outputs(p).delta(p.Tmat, tinymt_mexp*64)
+}
+
+// tinyMT64Outputs holds the first 127 outputs of the F2-linear version of TinyMT64, as vectors of
+// coefficients on the 127 bits of the initial state: base[n][b] is bit b of the n-th output
+// without the tempering matrix, lsb[n] is the bit that selects the tempering matrix.
+type tinyMT64Outputs struct {
+	base [tinymt_mexp][64][2]uint64
+	lsb  [tinymt_mexp][2]uint64
+}
+
+func newTinyMT64Outputs(p TinyMT64Params) *tinyMT64Outputs {
+	ans := new(tinyMT64Outputs)
+	for j := 0; j < tinymt_mexp; j++ {
+		// the bits of the state are the 63 low bits of status[0], then status[1].
+		var st [2]uint64
+		if j < 63 {
+			st[0] = 1 << uint(j)
+		} else {
+			st[1] = 1 << uint(j-63)
+		}
+
+		w, bit := j/64, uint64(1)<<uint(j%64)
+		for n := 0; n < tinymt_mexp; n++ {
+			p.nextState(&st)
+			x := st[0] ^ st[1] ^ (st[0] >> tinymt64_sh8)
+			for b := 0; b < 64; b++ {
+				if x>>uint(b)&1 != 0 {
+					ans.base[n][b][w] |= bit
+				}
+			}
+			if x&1 != 0 {
+				ans.lsb[n][w] |= bit
+			}
+		}
+	}
+
+	return ans
+}
+
+// Returns the sum of the dimension defects, or any value >= limit once it is reached.
+func (o *tinyMT64Outputs) delta(tmat uint64, limit int) (ans int) {
+	for v := 1; v <= 64 && ans < limit; v++ {
+		basis := gf2.NewBasis(tinymt_mexp)
+		max := tinymt_mexp / v
+		k := 0
+	outputs:
+		for ; k < max; k++ {
+			// the v most significant bits of the k-th output
+			for b := 63; b > 63-v; b-- {
+				x := o.base[k][b]
+				if tmat>>uint(b)&1 != 0 {
+					x[0] ^= o.lsb[k][0]
+					x[1] ^= o.lsb[k][1]
+				}
+				if !basis.Add(x[:]) {
+					break outputs
+				}
+			}
+		}
+		ans += max - k
+	}
+
+	return
+}
+
+// Searches a tempering matrix by hill climbing: starting from a random one, bits are flipped as long
+// as it lowers the sum of the dimension defects. This restarts until the sum is 0, at most
+// tinymt_tmat_tries times, and returns the best matrix found.
+func (o *tinyMT64Outputs) search(rng *SplitMix64) (tmat uint64) {
+	best := tinymt_mexp * 64
+	for i := 0; i < tinymt_tmat_tries && best > 0; i++ {
+		t := rng.Uint64()
+		d := o.delta(t, tinymt_mexp*64)
+		for improved := true; improved && d > 0; {
+			improved = false
+			for b := 0; b < 64; b++ {
+				if e := o.delta(t^1<<uint(b), d); e < d {
+					t, d, improved = t^1<<uint(b), e, true
+				}
+			}
+		}
+
+		if d < best {
+			tmat, best = t, d
+		}
+	}
+
+	return
+}
+
+// SearchTinyMT64Params runs the dynamic creator: it returns count parameter sets with primitive,
+// pairwise different characteristic polynomials, so each set generates an independent stream
+// (e.g. one per worker). The same seed always gives the same parameter sets.
+//
+// Mat1 and Mat2 are drawn at random until the recursion has a primitive characteristic polynomial,
+// then Tmat is searched to minimize Delta().
+//
+// Mutsuo Saito and Makoto Matsumoto, Tiny Mersenne Twister (TinyMT).
+// http://www.math.sci.hiroshima-u.ac.jp/~m-mat/MT/TINYMT/index.html
+func SearchTinyMT64Params(seed int64, count int) []TinyMT64Params {
+	rng := NewSplitMix64(uint64(seed))
+	seen := make(map[string]bool)
+	ans := make([]TinyMT64Params, 0, count)
+	for len(ans) < count {
+		x := rng.Uint64()
+		p := TinyMT64Params{Mat1: uint32(x >> 32), Mat2: uint32(x)}
+		f, ok := p.characteristicPolynomial()
+		if !ok || seen[f.String()] {
+			continue
+		}
+
+		outputs := newTinyMT64Outputs(p)
+		p.Tmat = outputs.search(rng)
+		seen[f.String()] = true
+		ans = append(ans, p)
+	}
+
+	return ans
+}
+
+// Implements the 64-bits Tiny Mersenne Twister (TinyMT64), developed by Mutsuo Saito and Makoto Matsumoto.
+//
+// TinyMT is a small F2-linear generator with 127-bits of state and a period of 2^127-1, meant for
+// applications which need many generators: each parameter set (mat1, mat2, tmat) defines a different
+// generator, and sets with different characteristic polynomials generate independent streams.
+// TinyMT64Table holds precomputed parameter sets, and SearchTinyMT64Params finds new ones.
+//
+// Mutsuo Saito and Makoto Matsumoto, Tiny Mersenne Twister (TinyMT).
+// http://www.math.sci.hiroshima-u.ac.jp/~m-mat/MT/TINYMT/index.html
+type TinyMT64 struct {
+	baseSource64
+	params TinyMT64Params
+	state  [2]uint64
+}
+
+// A single value is used as the seed of tinymt64_init(), longer streams are used as the key
+// of tinymt64_init_by_array().
+func NewTinyMT64FromStream(params TinyMT64Params, seed []uint64) (*TinyMT64, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans, err := newTinyMT64(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.setSeed(seed)
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewTinyMT64(params TinyMT64Params, seed int64) (*TinyMT64, error) {
+	ans, err := newTinyMT64(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.Seed(seed)
+	return ans, nil
+}
+
+func newTinyMT64(params TinyMT64Params) (*TinyMT64, error) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(TinyMT64)
+	ans.spi = ans
+	ans.params = params
+	return ans, nil
+}
+
+func (mt *TinyMT64) setSeed(seed []uint64) {
+	p := &mt.params
+	if len(seed) == 1 {
+		st := [2]uint64{seed[0] ^ uint64(p.Mat1)<<32, uint64(p.Mat2) ^ p.Tmat}
+		for i := 1; i < tinymt_min_loop; i++ {
+			st[i&1] ^= uint64(i) + 6364136223846793005*(st[(i-1)&1]^(st[(i-1)&1]>>62))
+		}
+		mt.state = st
+	} else {
+		st := []uint64{0, uint64(p.Mat1), uint64(p.Mat2), p.Tmat}
+		tinyMT64MixKey(st, seed)
+		mt.state = [2]uint64{st[0] ^ st[1], st[2] ^ st[3]}
+	}
+
+	// period certification
+	if mt.state[0]&tinymt64_mask == 0 && mt.state[1] == 0 {
+		mt.state = [2]uint64{'T', 'M'}
+	}
+
+	mt.stream = append([]uint64{}, mt.state[:]...)
+	mt.Restart()
+}
+
+// This is the body of tinymt64_init_by_array() of the reference code.
+func tinyMT64MixKey(st, key []uint64) {
+	const lag, mid, size = 1, 1, 4
+	count := tinymt_min_loop
+	if len(key)+1 > count {
+		count = len(key) + 1
+	}
+
+	r := tinyMT64Func1(st[0] ^ st[mid%size] ^ st[(size-1)%size])
+	st[mid%size] += r
+	r += uint64(len(key))
+	st[(mid+lag)%size] += r
+	st[0] = r
+
+	count--
+	i, j := 1, 0
+	for ; j < count && j < len(key); j++ {
+		r = tinyMT64Func1(st[i] ^ st[(i+mid)%size] ^ st[(i+size-1)%size])
+		st[(i+mid)%size] += r
+		r += key[j] + uint64(i)
+		st[(i+mid+lag)%size] += r
+		st[i] = r
+		i = (i + 1) % size
+	}
+	for ; j < count; j++ {
+		r = tinyMT64Func1(st[i] ^ st[(i+mid)%size] ^ st[(i+size-1)%size])
+		st[(i+mid)%size] += r
+		r += uint64(i)
+		st[(i+mid+lag)%size] += r
+		st[i] = r
+		i = (i + 1) % size
+	}
+	for j = 0; j < size; j++ {
+		r = tinyMT64Func2(st[i] + st[(i+mid)%size] + st[(i+size-1)%size])
+		st[(i+mid)%size] ^= r
+		r -= uint64(i)
+		st[(i+mid+lag)%size] ^= r
+		st[i] = r
+		i = (i + 1) % size
+	}
+}
+
+func tinyMT64Func1(x uint64) uint64 {
+	return (x ^ (x >> 59)) * 2173292883993
+}
+
+func tinyMT64Func2(x uint64) uint64 {
+	return (x ^ (x >> 59)) * 58885565329898161
+}
+
+func (mt *TinyMT64) Seed(seed int64) {
+	seeds := make([]uint64, 2)
+	seeder.Seed(seed)
+	var i int
+	// Fill the remaining pairs
+	for i < len(seeds) {
+		v := seeder.Uint64()
+		seeds[i] = v
+		i++
+	}
+
+	// Initialize the pool content.
+	mt.setSeed(seeds)
+}
+
+func (mt *TinyMT64) Restart() {
+	copy(mt.state[:], mt.stream)
+	mt.resetState()
+}
+
+// Returns the parameter set of the generator.
+func (mt *TinyMT64) Params() TinyMT64Params {
+	return mt.params
+}
+
+func (p *TinyMT64Params) nextState(st *[2]uint64) {
+	st[0] &= tinymt64_mask
+	x := st[0] ^ st[1]
+	x ^= x << tinymt64_sh0
+	x ^= x >> 32
+	x ^= x << 32
+	x ^= x << tinymt64_sh1
+	st[0] = st[1]
+	st[1] = x
+	mask := -(x & 1)
+	st[0] ^= uint64(p.Mat1) & mask
+	st[1] ^= uint64(p.Mat2) << 32 & mask
+}
+
+func (mt *TinyMT64) Uint64() uint64 {
+	mt.params.nextState(&mt.state)
+
+	x := mt.state[0] + mt.state[1]
+	x ^= mt.state[0] >> tinymt64_sh8
+	return x ^ (mt.params.Tmat & -(x & 1))
+}
+
+func (mt *TinyMT64) String() string {
+	return "TinyMT64"
+}
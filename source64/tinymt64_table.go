@@ -0,0 +1,263 @@
+package source64
+
+// TinyMT64Table holds 256 parameter sets with pairwise different characteristic polynomials, for
+// applications which need many independent generators (e.g. one per worker).
+// They are the output of SearchTinyMT64Params(1, 256).
+var TinyMT64Table = []TinyMT64Params{
+	{Mat1: 0xfd0d1f90, Mat2: 0xdf4a692f, Tmat: 0xf15279b9e709a102},
+	{Mat1: 0x1805127c, Mat2: 0xc0db4d9d, Tmat: 0x3f285742a6bfd384},
+	{Mat1: 0x3b2682ca, Mat2: 0x23c4ff68, Tmat: 0x0959052b449b5340},
+	{Mat1: 0x701c8369, Mat2: 0xe4818ff3, Tmat: 0x635661651f241444},
+	{Mat1: 0x13671a14, Mat2: 0x1437aab9, Tmat: 0x65a2d0441d813760},
+	{Mat1: 0x7e8f9ffe, Mat2: 0xf47c203d, Tmat: 0x3ad3654881c4dac0},
+	{Mat1: 0xb0f49a25, Mat2: 0xe26cec1a, Tmat: 0x7b56dd0ce6853ef8},
+	{Mat1: 0x77d02b75, Mat2: 0x42c355d6, Tmat: 0x964b62c921c014c6},
+	{Mat1: 0xf02d453a, Mat2: 0x0b2613f3, Tmat: 0xa73b90da22fc05b4},
+	{Mat1: 0x62ec5d4c, Mat2: 0x161ba421, Tmat: 0xd90dc2c690d2e9a4},
+	{Mat1: 0x90387203, Mat2: 0xf275b5af, Tmat: 0xaef53363c8599dbc},
+	{Mat1: 0x0090ea2f, Mat2: 0x4af83a6a, Tmat: 0xe5612ea5b79347ea},
+	{Mat1: 0x80255a3e, Mat2: 0x7d5b4536, Tmat: 0x87fdab2ff6590772},
+	{Mat1: 0xfdef660a, Mat2: 0xf109f1f7, Tmat: 0x07df0135b210be00},
+	{Mat1: 0xc86b2a6e, Mat2: 0xabcbedce, Tmat: 0xe26484ff0ab26bb4},
+	{Mat1: 0x9e45b412, Mat2: 0x683bbfbe, Tmat: 0xba4e05a905cd3bee},
+	{Mat1: 0xb618e201, Mat2: 0xb36ada1d, Tmat: 0x45623b88f04923f8},
+	{Mat1: 0x5c6ad974, Mat2: 0x5a435375, Tmat: 0x8dbb0f8a301a2fd8},
+	{Mat1: 0xf69af5bd, Mat2: 0xd1dd6674, Tmat: 0xd6de279eeb4405c0},
+	{Mat1: 0x007f39e1, Mat2: 0x79e7ab32, Tmat: 0x1d2934715693022a},
+	{Mat1: 0xa5f88da5, Mat2: 0xde674e86, Tmat: 0x23bd28c52451cde4},
+	{Mat1: 0x9b621ef4, Mat2: 0x4d6886f3, Tmat: 0x7424fd43fdcde93e},
+	{Mat1: 0x93326eff, Mat2: 0x94fc2e55, Tmat: 0x578c88b8ccbab0c0},
+	{Mat1: 0x962d6ef2, Mat2: 0xd45c6fd3, Tmat: 0x4e3bddaea8829f94},
+	{Mat1: 0x166ac4da, Mat2: 0xd5bc4f67, Tmat: 0xd0d6b798d78595ea},
+	{Mat1: 0x24fb4733, Mat2: 0x7513f78f, Tmat: 0xbce4bddc4454dc06},
+	{Mat1: 0x8f895456, Mat2: 0x3b94d99c, Tmat: 0x1f8561820743a620},
+	{Mat1: 0x134744ec, Mat2: 0x19b81106, Tmat: 0x4ab6ab4d504b6a76},
+	{Mat1: 0x5ceb7d8c, Mat2: 0x4e46c071, Tmat: 0x1151b2ec55e0f42e},
+	{Mat1: 0x2b0f1384, Mat2: 0xa2eee41c, Tmat: 0xe5d84d10e3a27b16},
+	{Mat1: 0xde025e5c, Mat2: 0xf250220c, Tmat: 0x99f0a2c4d5b28a82},
+	{Mat1: 0x5c323c83, Mat2: 0x0c50ee35, Tmat: 0xb2a3b36d43d4bf50},
+	{Mat1: 0x666aa0de, Mat2: 0x2d3a33cd, Tmat: 0xac267606e27442e6},
+	{Mat1: 0x22be8d85, Mat2: 0xd4286e10, Tmat: 0xf5ffa3e6b45fce4c},
+	{Mat1: 0x4dc9ef80, Mat2: 0x4511a3f0, Tmat: 0x09a6c9c33275f9d8},
+	{Mat1: 0x86087166, Mat2: 0xcf719ca1, Tmat: 0x681337d37c8476f0},
+	{Mat1: 0x8a72a37f, Mat2: 0xe04eff40, Tmat: 0x71c426a4feff3f6e},
+	{Mat1: 0xd7889488, Mat2: 0x4eb89489, Tmat: 0xa085cdae44d84d5e},
+	{Mat1: 0x70c45cb2, Mat2: 0x62b0f54c, Tmat: 0xab0e76ee3bb39944},
+	{Mat1: 0x1873ced1, Mat2: 0xa7243ba8, Tmat: 0xaee4c350be953068},
+	{Mat1: 0xc74c8c3c, Mat2: 0xa30b7997, Tmat: 0xee719342606664fe},
+	{Mat1: 0x63567bef, Mat2: 0x450a3c1c, Tmat: 0xf0e5b366f37b25ec},
+	{Mat1: 0xa4ecf042, Mat2: 0xa904358d, Tmat: 0x3f267fd10afb6bcc},
+	{Mat1: 0x54dfcc95, Mat2: 0x4e7b737f, Tmat: 0x2ed4e9b578519f78},
+	{Mat1: 0xd7c67bb4, Mat2: 0x7c7b3380, Tmat: 0xfd36e89657c539a4},
+	{Mat1: 0x043d1a4b, Mat2: 0xe23d3f98, Tmat: 0x267d02c3b0f07b90},
+	{Mat1: 0xf61b2a15, Mat2: 0x24b41d5d, Tmat: 0x3066295511241bb4},
+	{Mat1: 0xb44aeaf4, Mat2: 0x44a42531, Tmat: 0xbf25be9bc345835c},
+	{Mat1: 0x8a912199, Mat2: 0xc6681568, Tmat: 0xeff3a5bff5476f40},
+	{Mat1: 0x6c4b5422, Mat2: 0xcbeaef4f, Tmat: 0xe8048f92d30586de},
+	{Mat1: 0x6738db35, Mat2: 0x895a079f, Tmat: 0x6d712d3985f9d4c4},
+	{Mat1: 0x6daf4a2c, Mat2: 0x22d458e4, Tmat: 0x037e0a64e2cbbca8},
+	{Mat1: 0xf673d045, Mat2: 0x2813c1bf, Tmat: 0x9dccb1d194a660e8},
+	{Mat1: 0x4f77bbb5, Mat2: 0x48f3eff3, Tmat: 0x958bf3c772a2613c},
+	{Mat1: 0xdd4bd738, Mat2: 0x92ac9338, Tmat: 0x38b6912690586bda},
+	{Mat1: 0xcaf156fd, Mat2: 0xe4061ac2, Tmat: 0x972bf643da0d0a52},
+	{Mat1: 0xe8523d55, Mat2: 0x19e1cb1a, Tmat: 0x583589f46c5794a8},
+	{Mat1: 0x575e796f, Mat2: 0xc5a2bba8, Tmat: 0x9a8557392e8ca42c},
+	{Mat1: 0x06839c66, Mat2: 0x1cce6290, Tmat: 0x42aa219af6693c24},
+	{Mat1: 0xe9c2dd6e, Mat2: 0x92651a2c, Tmat: 0xe594eac31331c98c},
+	{Mat1: 0xf7f57281, Mat2: 0xbde2e464, Tmat: 0x62e38e7fc5b67794},
+	{Mat1: 0x9cbd5277, Mat2: 0x79295ba5, Tmat: 0xa4f551037f30f880},
+	{Mat1: 0xd4a1ca9e, Mat2: 0xe66dde7f, Tmat: 0x7dd639f3fd894172},
+	{Mat1: 0x86603dda, Mat2: 0xd4a6dba8, Tmat: 0x25331ab2554512a2},
+	{Mat1: 0x70112edb, Mat2: 0x13754b36, Tmat: 0xf2540188d35b582e},
+	{Mat1: 0xdfb71561, Mat2: 0xdfea4f76, Tmat: 0x288706280576363c},
+	{Mat1: 0xfe47f83e, Mat2: 0xdfee97fc, Tmat: 0xa372e26503f903aa},
+	{Mat1: 0x4740a4be, Mat2: 0x62398332, Tmat: 0x206c1e7a528aa2b0},
+	{Mat1: 0xf0b620fb, Mat2: 0xcac7f2a1, Tmat: 0x5d1cfa990f261930},
+	{Mat1: 0x12c6f3aa, Mat2: 0x12a64cd5, Tmat: 0x3d61ae64120b5d18},
+	{Mat1: 0xdbf879af, Mat2: 0xd251aa45, Tmat: 0xb20d3681581c5000},
+	{Mat1: 0x9ff9a547, Mat2: 0x48e98f06, Tmat: 0xebebd4fce65a06d4},
+	{Mat1: 0xc6d3f84f, Mat2: 0x04f8d97e, Tmat: 0x36bd8d5f737216c6},
+	{Mat1: 0x8a3ea4fb, Mat2: 0x8e664f6c, Tmat: 0x91bcaed5e5055fba},
+	{Mat1: 0x7be10dfc, Mat2: 0xec9e1ca5, Tmat: 0x6b80eee153e0f572},
+	{Mat1: 0xd71df03b, Mat2: 0x63afe5f4, Tmat: 0x36c56445e2d13176},
+	{Mat1: 0x1f9177fb, Mat2: 0x811e6a9f, Tmat: 0x79addaac6fc38176},
+	{Mat1: 0x01b55ab3, Mat2: 0xab06e539, Tmat: 0x91465dc444b388f6},
+	{Mat1: 0x6a3e85ed, Mat2: 0x604edc3f, Tmat: 0x13ded0ad2f5266b8},
+	{Mat1: 0xa51a8679, Mat2: 0xfc18db2a, Tmat: 0x31864e2ad32f8bda},
+	{Mat1: 0x5863a89e, Mat2: 0x6860e145, Tmat: 0xeb22c1ca87dfed30},
+	{Mat1: 0xa335243f, Mat2: 0x88e66d9a, Tmat: 0xe720e6e0ff70b9de},
+	{Mat1: 0xc122c36e, Mat2: 0x937faadd, Tmat: 0x88e1054fae8aa980},
+	{Mat1: 0xdda9f468, Mat2: 0xf32087ff, Tmat: 0x1301d0f022bca764},
+	{Mat1: 0xb3e2250c, Mat2: 0x248c2d5d, Tmat: 0x8ddea5f45727a734},
+	{Mat1: 0xab0e6d30, Mat2: 0xd524e2be, Tmat: 0x1b136aa207d7832c},
+	{Mat1: 0x9a0fe070, Mat2: 0xa950397f, Tmat: 0x2c42e75bd3100782},
+	{Mat1: 0xda52c2eb, Mat2: 0x696d6102, Tmat: 0xa94753d85b14739c},
+	{Mat1: 0x498aa8a6, Mat2: 0xc3c0ea0a, Tmat: 0x4b9f7ac21fed7962},
+	{Mat1: 0xeeff5fa5, Mat2: 0xb7e80826, Tmat: 0x5889e22e79818772},
+	{Mat1: 0xede79868, Mat2: 0xbcf02ea1, Tmat: 0x0dacc75dee8bd244},
+	{Mat1: 0x005882ed, Mat2: 0xd82f3163, Tmat: 0x400e9eba189deba2},
+	{Mat1: 0x69ed192e, Mat2: 0x81486741, Tmat: 0x64d67cf0c53eb028},
+	{Mat1: 0xa616ea0f, Mat2: 0x366abaa4, Tmat: 0x3eaa72808f794d78},
+	{Mat1: 0xf1d2d865, Mat2: 0x3059a162, Tmat: 0x5feb84cbd728a524},
+	{Mat1: 0xaba21c9e, Mat2: 0xaaac9f4b, Tmat: 0x743b5f3bfdfaea74},
+	{Mat1: 0xe6c5f4ac, Mat2: 0x3c6a0416, Tmat: 0x142f6a7f2774099a},
+	{Mat1: 0x5b10419f, Mat2: 0x418ef2c6, Tmat: 0x85b144db93bc30ac},
+	{Mat1: 0x7d5d609f, Mat2: 0x00549806, Tmat: 0xea3d77aa985a0b1a},
+	{Mat1: 0xb8991ef5, Mat2: 0x1aab25ea, Tmat: 0x1be825399232fa34},
+	{Mat1: 0x3466c40c, Mat2: 0xf127b00d, Tmat: 0xad6be2cfe456bc6a},
+	{Mat1: 0x219a5987, Mat2: 0x29cbcd3d, Tmat: 0x1442f55992983e44},
+	{Mat1: 0xdf4a01fe, Mat2: 0x450be4c5, Tmat: 0x3d9b211d883a3f8e},
+	{Mat1: 0xd80c5730, Mat2: 0x315d2919, Tmat: 0xb3228d2733798d52},
+	{Mat1: 0x876504ee, Mat2: 0xc36ecfde, Tmat: 0x15cdcd8f20a18ad4},
+	{Mat1: 0x04f6d9af, Mat2: 0xcb7a064c, Tmat: 0xa3592f8a258b346c},
+	{Mat1: 0x17b40da7, Mat2: 0xeb80d442, Tmat: 0x6e3223a16421f8a2},
+	{Mat1: 0x82e2ddda, Mat2: 0xadc2d725, Tmat: 0x7cbd790ac5f4ecd8},
+	{Mat1: 0xda99f339, Mat2: 0x3320d180, Tmat: 0xb7c4f75d8e8061e8},
+	{Mat1: 0x5c5d3689, Mat2: 0xa9fa8eb8, Tmat: 0x4c4813479480aa90},
+	{Mat1: 0x067eda53, Mat2: 0x80960aba, Tmat: 0x8b3e1b7aac23c162},
+	{Mat1: 0xbb63a00a, Mat2: 0x1c7d757f, Tmat: 0x7f9e4d1d4325beee},
+	{Mat1: 0xf0cfd7ac, Mat2: 0xd1b006dc, Tmat: 0x30293f965ff24790},
+	{Mat1: 0xc4f42aaf, Mat2: 0x9896ef56, Tmat: 0x8baaa24ac58b22a0},
+	{Mat1: 0x45922e31, Mat2: 0xcd7248f3, Tmat: 0xa2ef20f69904d0c2},
+	{Mat1: 0x766ab7b8, Mat2: 0x9d1ae1cd, Tmat: 0xcb3502f58da4531a},
+	{Mat1: 0x4c2deeb4, Mat2: 0x5ed9e078, Tmat: 0xfd186f99b563da22},
+	{Mat1: 0x825b7c41, Mat2: 0xa2deeed8, Tmat: 0x482bace08e2c1a00},
+	{Mat1: 0xff0754de, Mat2: 0x146a4e8a, Tmat: 0xfb0d96d9bc6e017c},
+	{Mat1: 0x3775906b, Mat2: 0x03a4fc02, Tmat: 0x1664129934ecd350},
+	{Mat1: 0x6c08347e, Mat2: 0xc95ea40f, Tmat: 0xe6e87d24c5b9785c},
+	{Mat1: 0x5a73f66b, Mat2: 0x6f08d4aa, Tmat: 0x17d120bc1c862066},
+	{Mat1: 0x33609c9a, Mat2: 0xd5e0939f, Tmat: 0xf289670f4f5f9fd4},
+	{Mat1: 0x2f7c044f, Mat2: 0xa79c6036, Tmat: 0x8a979ca083aeebec},
+	{Mat1: 0x2408c76a, Mat2: 0x0f0f396d, Tmat: 0x769eb48686cb0986},
+	{Mat1: 0xb9ff53f3, Mat2: 0x5ae9b618, Tmat: 0xec93ffbaf594aeb4},
+	{Mat1: 0xd98eb034, Mat2: 0xcbd812ba, Tmat: 0x2ff0c07f9bb5a9d6},
+	{Mat1: 0xebe842f4, Mat2: 0x9dbab4d9, Tmat: 0x7ec50ea002cf187c},
+	{Mat1: 0x113ad797, Mat2: 0xad7177e1, Tmat: 0x0e8df782818a7884},
+	{Mat1: 0x573c6efd, Mat2: 0x28b0cf06, Tmat: 0x49f368f14ac3a1c2},
+	{Mat1: 0xe9cb6342, Mat2: 0x94b73ab4, Tmat: 0x5aa80e3373f5a226},
+	{Mat1: 0x52cee6b2, Mat2: 0xf0422aae, Tmat: 0xc1d8eb11beb5408e},
+	{Mat1: 0x158c6308, Mat2: 0xa8363b68, Tmat: 0x88558fe0ad9b8ea2},
+	{Mat1: 0xd5a05098, Mat2: 0xd2e08a8b, Tmat: 0x817b40fac73d19f4},
+	{Mat1: 0xeeeff434, Mat2: 0x10b9831d, Tmat: 0xa1e87418953c198e},
+	{Mat1: 0xc6a145fa, Mat2: 0x7a0ad9df, Tmat: 0x4a9acfea0b72a570},
+	{Mat1: 0xe184725c, Mat2: 0xeccb43bd, Tmat: 0x686f15c10e11b946},
+	{Mat1: 0x63eb419a, Mat2: 0x439ddd89, Tmat: 0x4fca755884b64d92},
+	{Mat1: 0xb583b550, Mat2: 0xa1d5cd35, Tmat: 0x1136a92191dc2c84},
+	{Mat1: 0x8c2fec68, Mat2: 0x00f71662, Tmat: 0x0566a6da6e1b2a86},
+	{Mat1: 0xa3e926a6, Mat2: 0xad9eb1e2, Tmat: 0xd916cd09d6f2cb3a},
+	{Mat1: 0xc043b890, Mat2: 0xc4afe43a, Tmat: 0x051778f05944e6e4},
+	{Mat1: 0x5c3cc8a1, Mat2: 0x8ea78248, Tmat: 0xa723ea253f0abf08},
+	{Mat1: 0xa4da2793, Mat2: 0xffef27f1, Tmat: 0x2478d1571cf98d1e},
+	{Mat1: 0x33f48b15, Mat2: 0x399433b5, Tmat: 0x65ea4a7c38871e06},
+	{Mat1: 0x85150c33, Mat2: 0xb9682649, Tmat: 0x8c37541dfd6ed834},
+	{Mat1: 0xb676de95, Mat2: 0x7ded5f05, Tmat: 0x753e715856d77f1e},
+	{Mat1: 0x006f14a4, Mat2: 0x00cad93d, Tmat: 0x305a2fe9cb71dbb4},
+	{Mat1: 0x62eba254, Mat2: 0x93fef060, Tmat: 0x79d0482d5209335a},
+	{Mat1: 0xa9e9b97c, Mat2: 0xcb829f57, Tmat: 0x4ab70404bc5d176e},
+	{Mat1: 0xe8f3feb1, Mat2: 0xb8dad58c, Tmat: 0xc907bb674e18b858},
+	{Mat1: 0x022a036a, Mat2: 0x5e47287c, Tmat: 0x942d62b6c41689c2},
+	{Mat1: 0x81fa9181, Mat2: 0x4c45269b, Tmat: 0xbec16673a46ef8bc},
+	{Mat1: 0x7969d0c0, Mat2: 0x4339ea9b, Tmat: 0xa424dfa88eb2365c},
+	{Mat1: 0x0b525903, Mat2: 0x9fa10fc4, Tmat: 0x0707d9e75ebb074e},
+	{Mat1: 0x00a0b8ec, Mat2: 0xc986c522, Tmat: 0x26797436bd1a5f68},
+	{Mat1: 0x63627b28, Mat2: 0xa0c118b4, Tmat: 0x3fff5ed1665c7258},
+	{Mat1: 0x75f18161, Mat2: 0x3dacabd9, Tmat: 0xb2fa476321353b38},
+	{Mat1: 0x5c58cb41, Mat2: 0xf4d0feab, Tmat: 0x8370df35296ddacc},
+	{Mat1: 0x477a8aaf, Mat2: 0x62ed6f30, Tmat: 0x3842bebed1e0906a},
+	{Mat1: 0x958e6a0c, Mat2: 0xdd6728ca, Tmat: 0x1f36c3f3a95917de},
+	{Mat1: 0xc91438e9, Mat2: 0xfa83eccf, Tmat: 0xb87259c3c2d7e610},
+	{Mat1: 0xabcceac8, Mat2: 0x7434d27f, Tmat: 0xe9b3bd33b64ef71e},
+	{Mat1: 0x7ef6212b, Mat2: 0x8b04d496, Tmat: 0x9c49753e80cd0f02},
+	{Mat1: 0x33d4ce7d, Mat2: 0x5363f640, Tmat: 0x1182c512eed995b4},
+	{Mat1: 0x3a865eec, Mat2: 0x31ba2b76, Tmat: 0x5e1350e6b94ced16},
+	{Mat1: 0x01807b56, Mat2: 0x2b7c38d5, Tmat: 0xc2d074f7e2b7656c},
+	{Mat1: 0x1cac4048, Mat2: 0x31ab8eaa, Tmat: 0xb5623497b9f5ffb0},
+	{Mat1: 0x405518cb, Mat2: 0xf2fe0af3, Tmat: 0x36b6d1c8546ec9cc},
+	{Mat1: 0x8980754e, Mat2: 0xedfbd110, Tmat: 0xc4760e2fc92998ca},
+	{Mat1: 0xed55491f, Mat2: 0x672cdfca, Tmat: 0x539945420f2e158a},
+	{Mat1: 0x66179ecd, Mat2: 0x82ce3e80, Tmat: 0x779c91a25a8fa40e},
+	{Mat1: 0xf1da2939, Mat2: 0x54da2224, Tmat: 0x4b935c5076915d1a},
+	{Mat1: 0x40303771, Mat2: 0x77b1ab8b, Tmat: 0xa8351cf417efab5e},
+	{Mat1: 0x972cd438, Mat2: 0x575dfd47, Tmat: 0x8b3f1162d586e880},
+	{Mat1: 0xc1a765ec, Mat2: 0x4dfac27d, Tmat: 0xbf22ef1126cc9d40},
+	{Mat1: 0x5dba4c6b, Mat2: 0x1a9087c2, Tmat: 0x0251ee5126ff6d88},
+	{Mat1: 0x0e704289, Mat2: 0x116ee505, Tmat: 0x360f2ec38abf207c},
+	{Mat1: 0xd142ea28, Mat2: 0x94346257, Tmat: 0xc566e8f349a41492},
+	{Mat1: 0x6c9ce4e7, Mat2: 0xb3d6db77, Tmat: 0x7c1e5b109e80bcc8},
+	{Mat1: 0xdbe91e14, Mat2: 0x0ba32b15, Tmat: 0x5d009d469e20fe78},
+	{Mat1: 0x3c064a48, Mat2: 0x115390aa, Tmat: 0xe2f726b24e6f3a14},
+	{Mat1: 0x46f09661, Mat2: 0xc784893f, Tmat: 0x22e9e8bf40e53f84},
+	{Mat1: 0x8225504e, Mat2: 0x983b044f, Tmat: 0x38f3a4a77a2ed1b2},
+	{Mat1: 0xaf54f663, Mat2: 0xf9156899, Tmat: 0x2a6a1a1d07d8d282},
+	{Mat1: 0xc16b2c70, Mat2: 0x67a7444c, Tmat: 0x45f3ea693aebe594},
+	{Mat1: 0x14da1b69, Mat2: 0x3e760290, Tmat: 0x95d37f8929df881c},
+	{Mat1: 0xac4c33e6, Mat2: 0x1b418f8e, Tmat: 0xf3dfe6af8c719714},
+	{Mat1: 0x3d25759c, Mat2: 0xa664bdf2, Tmat: 0xcca233f83e6aa546},
+	{Mat1: 0x6a7cdb0f, Mat2: 0x448e08f7, Tmat: 0xa83a280727ce180e},
+	{Mat1: 0x7785b734, Mat2: 0x6b26c3a9, Tmat: 0xd466d0c147d4c3e4},
+	{Mat1: 0xcb8274a6, Mat2: 0x13a85553, Tmat: 0xb83195497897ad38},
+	{Mat1: 0x023971af, Mat2: 0xf874269d, Tmat: 0xa650a5af53cde81e},
+	{Mat1: 0xa278de27, Mat2: 0xe29331c5, Tmat: 0x4c241ee4b82df134},
+	{Mat1: 0xac46f3c0, Mat2: 0x4f5c8f32, Tmat: 0x91aee08a3c7984b2},
+	{Mat1: 0x170e1d8a, Mat2: 0x1ecca8b3, Tmat: 0x7ffce68da2351834},
+	{Mat1: 0x674179fa, Mat2: 0xd7cbed33, Tmat: 0x7b180aa174fcbf36},
+	{Mat1: 0x175b4c41, Mat2: 0x79fddc3f, Tmat: 0xae2e8129ef6f00a2},
+	{Mat1: 0xa20887bc, Mat2: 0x8209345a, Tmat: 0x3a6347dc4a1e5b54},
+	{Mat1: 0x14accf32, Mat2: 0x1c7d98ba, Tmat: 0xf4c4c730c67d58b2},
+	{Mat1: 0x1c3e3e1f, Mat2: 0x7c80058e, Tmat: 0x233da1598c11267a},
+	{Mat1: 0x6e908e3b, Mat2: 0x20e32b91, Tmat: 0xc579a98402f89344},
+	{Mat1: 0x7a16a989, Mat2: 0xfe22a2d0, Tmat: 0x89bff69bd6a08c3a},
+	{Mat1: 0xb8608e8a, Mat2: 0x6cfbb0d4, Tmat: 0x368aa72676e88f96},
+	{Mat1: 0xa33faa5b, Mat2: 0x47e29262, Tmat: 0x2f5d3ac42a5d2116},
+	{Mat1: 0xa6663ff8, Mat2: 0x599bba9d, Tmat: 0x0753e409bb7e054c},
+	{Mat1: 0xd916b649, Mat2: 0xf97fe90b, Tmat: 0xe34140bb420437f2},
+	{Mat1: 0xd6f1ccdf, Mat2: 0x4201232e, Tmat: 0x3a0785cd78f31e14},
+	{Mat1: 0xdfd484ed, Mat2: 0xd9380222, Tmat: 0xdf7848fec4c91208},
+	{Mat1: 0xa13ffad3, Mat2: 0x5c64fd40, Tmat: 0x15b5e53cbaf8af62},
+	{Mat1: 0x0eea8416, Mat2: 0xa9e75c1c, Tmat: 0x929dbeb5409dbad0},
+	{Mat1: 0xf25f4889, Mat2: 0xab2a4ff2, Tmat: 0x7b76fa4c834a417a},
+	{Mat1: 0x91150ccf, Mat2: 0x82a4c7a9, Tmat: 0xaab8b5ab94552c0e},
+	{Mat1: 0x76d40aa1, Mat2: 0xc7cc1645, Tmat: 0x5610e36bb170a4cc},
+	{Mat1: 0xec978feb, Mat2: 0x7194d57c, Tmat: 0x4f61c0924eacfcb2},
+	{Mat1: 0x54c0f86c, Mat2: 0x4c00289a, Tmat: 0xfae581c1e58d3d6c},
+	{Mat1: 0xc2564121, Mat2: 0xdb236d33, Tmat: 0xf81276d51a9a0588},
+	{Mat1: 0x68cc8026, Mat2: 0x23105e6a, Tmat: 0x7378550d11045eae},
+	{Mat1: 0xb92d76d8, Mat2: 0x7f7f67d3, Tmat: 0x93820d6d9e4403e8},
+	{Mat1: 0x435b1035, Mat2: 0x10c141e5, Tmat: 0x8faf14fa6abecd1e},
+	{Mat1: 0x5b8a7404, Mat2: 0x2d801982, Tmat: 0x93e853df030dfff2},
+	{Mat1: 0x8854fc0d, Mat2: 0x157e0348, Tmat: 0x315499ae498b9768},
+	{Mat1: 0x8c0bfc44, Mat2: 0xe18a2210, Tmat: 0x55a8393cce2ea456},
+	{Mat1: 0x6863b02c, Mat2: 0x0f40238b, Tmat: 0xced343d78fc239cc},
+	{Mat1: 0x116418ec, Mat2: 0xff76eae7, Tmat: 0x11c38a50ae5f6768},
+	{Mat1: 0x4770a285, Mat2: 0x47ec2c7b, Tmat: 0xa4398deb7576c106},
+	{Mat1: 0xa667fa40, Mat2: 0x282885d2, Tmat: 0x103b71e47341a48e},
+	{Mat1: 0xf1a3ae08, Mat2: 0xa77a2313, Tmat: 0x891183bddbc57fd8},
+	{Mat1: 0x91804234, Mat2: 0x0c7afcef, Tmat: 0x57c17e2ae10334c0},
+	{Mat1: 0x0e99a8fb, Mat2: 0xbc822903, Tmat: 0x10b315e6639d6e8e},
+	{Mat1: 0x0c048f1e, Mat2: 0xd8d41d07, Tmat: 0x44b1a3248516254a},
+	{Mat1: 0x244b1a58, Mat2: 0x8e2f7512, Tmat: 0xcd70f5c73e94cf24},
+	{Mat1: 0xfcd34fed, Mat2: 0xf4085e70, Tmat: 0xf771084bbded0b1e},
+	{Mat1: 0x50158a8b, Mat2: 0x3651f012, Tmat: 0x097c71c6f7222b4a},
+	{Mat1: 0xdff729d7, Mat2: 0x32e42cd8, Tmat: 0x3503ac9e90ec0896},
+	{Mat1: 0x1e2501e6, Mat2: 0x2cb70cec, Tmat: 0xd54667fd03a6970e},
+	{Mat1: 0xc709d46e, Mat2: 0x0780e94d, Tmat: 0xefa9019373155d74},
+	{Mat1: 0x08205678, Mat2: 0x6ef01f41, Tmat: 0xe2880a6c8ee9374e},
+	{Mat1: 0x79736587, Mat2: 0x8092aba4, Tmat: 0xe636dc9eaadc5910},
+	{Mat1: 0x322eacee, Mat2: 0x01b65885, Tmat: 0x9768fdd7b23d9fc6},
+	{Mat1: 0x482ee6c4, Mat2: 0x1d99891f, Tmat: 0x10576c72c9d8acfc},
+	{Mat1: 0xea8852fe, Mat2: 0x79e35755, Tmat: 0x4460ad2c993c6e84},
+	{Mat1: 0x13251ac4, Mat2: 0x82a6d86a, Tmat: 0xb2d796b5aca92ef2},
+	{Mat1: 0xc4368e49, Mat2: 0xb5613a15, Tmat: 0xa9f8cd42fddada36},
+	{Mat1: 0xb9d1e753, Mat2: 0xf5411fe8, Tmat: 0x8d40ba174315a25e},
+	{Mat1: 0xf8534ced, Mat2: 0x858f4f99, Tmat: 0x7d83d4578fd22bfa},
+	{Mat1: 0x4cef48ee, Mat2: 0x203dd823, Tmat: 0xfab5f5cae146a506},
+	{Mat1: 0x6fe07faa, Mat2: 0xf9a10765, Tmat: 0xa439b70ec7773da4},
+	{Mat1: 0x3ccc2670, Mat2: 0xc3c68218, Tmat: 0x87f72f92259870ce},
+	{Mat1: 0x805cb695, Mat2: 0xee736a57, Tmat: 0x6f2dea5b64caa73a},
+	{Mat1: 0x373b44db, Mat2: 0x9ce79491, Tmat: 0x808117a493f5c522},
+	{Mat1: 0xd1afbc0e, Mat2: 0x36f6c70d, Tmat: 0xb82b1b1c6e738f4c},
+	{Mat1: 0xe6d3aa63, Mat2: 0x9ba7c9da, Tmat: 0x248e68437251ec66},
+	{Mat1: 0x093d8201, Mat2: 0x3f4351b2, Tmat: 0x2a043478f83ae2c0},
+	{Mat1: 0x6a33cbf3, Mat2: 0x12eef9cf, Tmat: 0x660d38b61446bb1c},
+	{Mat1: 0x716fc08e, Mat2: 0xdcc374cf, Tmat: 0x9d8abed54b92fccc},
+}
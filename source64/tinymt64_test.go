@@ -0,0 +1,130 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestTinyMT64(t *testing.T) {
+	/*
+	 * Data from running a C build of the reference algorithm (TinyMT 1.1), seeded with tinymt64_init(1).
+	 */
+	rng, err := source64.NewTinyMT64FromStream(source64.TinyMT64DefaultParams, []uint64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []uint64{
+		0xd728944fa51f6257, 0xefd23d979584d86e,
+		0x1e3943ce0d830e11, 0x8bffb401a9a2ce7e,
+		0x7bdc760e5d5eb8da, 0xb4d4b30850c1998d,
+		0x42ea50d07ab9543a, 0x9e68298a14b2e944,
+		0xfc23531d52cd98b9, 0x1848e4ac0a1240b9,
+		0x2114fe700752c2de, 0x42e2e6d273919f10,
+		0xa67d9b01638b1678, 0x9cfe4eb28decdd8c,
+		0x0dcef3a6ff4b1e1f, 0x584df9c46b466816,
+		0x67879f80d6991166, 0x0d9ee9bba75e4308,
+		0xa47df457ba5305e9, 0x52f047e9305e59ce,
+		0xe73d0fe9bfc26cf9, 0x4559636ce2e68b24,
+		0x9aa0a2de4626e86e, 0xac27e6104a294116,
+		0x947ef4b7c6f063cf, 0xba84f15df7f47a28,
+		0xd2ce17ecfe9f5eb4, 0xc4c8f1ae3d16debe,
+		0x8f558459be46d891, 0x8098aa1c4917ee41,
+		0xc37694042b9e1e84, 0xa7f5b218b1a2cbf5,
+		0x9f40bb66a49f11f7, 0x5930e4b3b4ec6994,
+		0x9b4a20e168326857, 0xa64fcbc675b25eb4,
+		0x2a3012b7dcd13e95, 0xc38877057d078247,
+		0x84247a6224b31103, 0xb2c8292d8ca04389,
+	}
+
+	r := grand.New(rng)
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+	t.Run("InitByArray", func(t *testing.T) {
+		/*
+		 * Data from the reference code, with a parameter set from TinyMT64Table, seeded with tinymt64_init_by_array().
+		 */
+		rng, err := source64.NewTinyMT64FromStream(source64.TinyMT64Params{Mat1: 0xfd0d1f90, Mat2: 0xdf4a692f, Tmat: 0xf15279b9e709a102}, []uint64{0x12345, 0x23456, 0x34567, 0x45678})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []uint64{
+			0x3b23b862e4a27830, 0xcffbf6d62bbb81aa,
+			0x5e7744866c79a460, 0x07669844e8f58e68,
+			0xcb6b0d54844cc378, 0x229381d10b5fd5a9,
+			0x95f9a9ee89d497da, 0xd07199ff5c1c2f2c,
+			0x545b0ca46e1f49a4, 0x9762ea618188ed12,
+			0x519676a7791f9e4c, 0x660f274614408e00,
+			0xe4bd4fa64e851b86, 0xe0a54bc9a194ac38,
+			0x996aa8e4cb9856d1, 0xd04f7625724c3a0d,
+			0x4f4dd3e54c3bfe9f, 0xf651b15ce9b20176,
+			0x14c5a35c272da8e1, 0x76c2bb3cfd34c8a9,
+			0x36aa96dcd78a56c9, 0x6f55fa2627f11d88,
+			0x42f285bf9783e109, 0x3ea28458f806f991,
+			0xbe25127c178306ee, 0xe26e5a577524eafa,
+			0xd8d0eb7379357d49, 0x72e999137ae12244,
+			0x76e7f81a4f501f03, 0xe95b1c159b52d70e,
+			0x242b84663e74fa7d, 0x081c1d67e0754d52,
+			0x14a1e9a2d6940040, 0x076e2c3283e036ce,
+			0xfdfc2b53eb6fa330, 0x29779759d3dab25a,
+			0x88488795589b0dff, 0x9a408a1721cb3882,
+			0x1cdba6584ac6b6af, 0x9396aa077970f858,
+		}
+
+		for i := 0; i < len(expected); i++ {
+			rg := rng.Uint64()
+			if expected[i] != rg {
+				t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+			}
+		}
+	})
+
+	t.Run("Params", func(t *testing.T) {
+		p := source64.TinyMT64DefaultParams
+		// the characteristic polynomial of the reference code parameters
+		if want := "945e0ad4a30ec19432dfa9d5959e5d5d"; p.CharacteristicPolynomial() != want {
+			t.Errorf("CharacteristicPolynomial() mismatch. want: %v, got: %v", want, p.CharacteristicPolynomial())
+		}
+
+		if p.Delta() != 0 {
+			t.Errorf("Delta() mismatch. want: 0, got: %v", p.Delta())
+		}
+
+		if _, err := source64.NewTinyMT64(source64.TinyMT64Params{}, 1); err == nil {
+			t.Error("No error for the zero TinyMT64Params")
+		}
+
+		p.Mat1 ^= 1
+		if _, err := source64.NewTinyMT64(p, 1); err == nil {
+			t.Error("No error for TinyMT64Params with a reducible characteristic polynomial")
+		}
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		polys := make(map[string]bool)
+		for i, p := range source64.TinyMT64Table {
+			if err := p.Validate(); err != nil {
+				t.Fatalf("TinyMT64Table[%d]: %v", i, err)
+			}
+
+			f := p.CharacteristicPolynomial()
+			if polys[f] {
+				t.Fatalf("TinyMT64Table[%d]: duplicate characteristic polynomial %v", i, f)
+			}
+			polys[f] = true
+		}
+
+		for i, p := range source64.SearchTinyMT64Params(1, 1) {
+			if p != source64.TinyMT64Table[i] {
+				t.Errorf("SearchTinyMT64Params mismatch at index %d. want: %+v, got: %+v", i, source64.TinyMT64Table[i], p)
+			}
+		}
+	})
+}
@@ -2,6 +2,7 @@ package source64
 
 import (
 	"errors"
+	"fmt"
 	"math"
 )
 
@@ -13,6 +14,53 @@ func checkEmptySeed(seed []uint64) error {
 	return nil
 }
 
+// Checks the seed of a combined multiple recursive generator, where the seed of the j-th
+// component is made of the next orders[j] values.
+// The seed values of a component must be less than its modulus and must not be all 0.
+func checkMRGSeed(seed []uint64, orders []int, moduli []uint64) error {
+	var r int
+	for _, k := range orders {
+		r += k
+	}
+
+	if len(seed) < r {
+		return fmt.Errorf("The stream must have at least %d values", r)
+	}
+
+	var i int
+	for j, k := range orders {
+		zero := true
+		for _, v := range seed[i : i+k] {
+			if v >= moduli[j] {
+				return fmt.Errorf("The seed values of component %d must be less than %d", j+1, moduli[j])
+			}
+
+			if v != 0 {
+				zero = false
+			}
+		}
+
+		if zero {
+			return fmt.Errorf("The seed values of component %d must not be all 0.", j+1)
+		}
+
+		i += k
+	}
+
+	return nil
+}
+
+// Checks that the state of a generator for which the all-zero state is a fixed point is not all 0.
+func checkZeroState(state []uint64) error {
+	for _, v := range state {
+		if v != 0 {
+			return nil
+		}
+	}
+
+	return errors.New("The state must not be all 0")
+}
+
 func fillState(state, seed []uint64) {
 	stateSize := len(state)
 	seedSize := len(seed)
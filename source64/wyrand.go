@@ -0,0 +1,65 @@
+package source64
+
+import "math/bits"
+
+const (
+	wyrand_p0 uint64 = 0x2d358dccaa6c78a5
+	wyrand_p1 uint64 = 0x8bb84b93962eacc9
+)
+
+// Implements wyrand, the generator of Wang Yi's wyhash (final version 4): a Weyl sequence mixed by the
+// xor of the two halves of a 128-bit product.
+//
+// The state size is 64-bits and the period is 2^64; every state is valid.
+//
+// https://github.com/wangyi-fudan/wyhash
+type WyRand struct {
+	baseSource64
+	state uint64
+}
+
+func NewWyRandFromStream(seed []uint64) (*WyRand, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(WyRand)
+	ans.spi = ans
+	ans.setSeed(seed[0])
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewWyRand(seed int64) *WyRand {
+	ans := new(WyRand)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (wy *WyRand) setSeed(seed uint64) {
+	wy.stream = []uint64{seed}
+	wy.Restart()
+}
+
+func (wy *WyRand) Seed(seed int64) {
+	seeder.Seed(seed)
+	// Initialize the pool content.
+	wy.setSeed(seeder.Uint64())
+}
+
+func (wy *WyRand) Restart() {
+	wy.state = wy.stream[0]
+	wy.resetState()
+}
+
+func (wy *WyRand) Uint64() uint64 {
+	wy.state += wyrand_p0
+	hi, lo := bits.Mul64(wy.state, wy.state^wyrand_p1)
+	return hi ^ lo
+}
+
+func (wy *WyRand) String() string {
+	return "WyRand"
+}
@@ -0,0 +1,39 @@
+package source64_test
+
+import (
+	"testing"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestWyRand(t *testing.T) {
+	rng, err := source64.NewWyRandFromStream([]uint64{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := grand.New(rng)
+	/*
+	 * Data from wyrand() of wyhash final version 4.
+	 */
+	expected := []uint64{
+		0xca71d87c76983989, 0x7e5ba61552085fc6, 0xcdf101e3bab88b9f, 0x0a3825ad73267808,
+		0x8ac0adc15d671c29, 0x74b0aa52525d790d, 0xe53f8280a3cccdf0, 0x637233aa01a1ed74,
+		0xb87970edbe884e6a, 0x4052cb14ca8875c9, 0x361a6a242710e06a, 0xf7bad3d2ceb0ccc8,
+		0xd3dd0701229fcfc9, 0x8a9ccd864fd3cc4e, 0xe8aacb57ff888fa4, 0xfd3330ebc23a190e,
+		0xbc6435ccdaaa82d9, 0xf391a0ba86ff5205, 0x3f10de1ffa55f931, 0xe103633e23a5f511,
+		0x913de557cbb1c8e6, 0x6c50bf834b8b4ad0, 0x7e2b908a36ff4558, 0xbd4a4bc35a09b86c,
+		0xed6dd8c303a543a8, 0x034ce541dbd99145, 0xff1c7147bfd448d1, 0xd998205a3faec244,
+		0x05195208c544fa5a, 0x721efaec79ce6896, 0x2f3fbe89d3640baf, 0x6c80ad889dd6da84,
+		0xdf65585b553a9a72, 0xa6151d9952ccf4c6, 0xccc9f215aabe02d0, 0x2af3f642e5c9fee5,
+		0x018b4a9cd973670b, 0xd11527c3f78d45c8, 0xe3b671790a74eee5, 0xddb074fb70166e69,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+}
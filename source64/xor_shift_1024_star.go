@@ -74,6 +74,7 @@ func newXorShiftStreamWithMultiplier(seed []uint64, multiplier uint64) (*XorShif
 	}
 
 	ans := new(XorShift1024Star)
+	ans.spi = ans
 	ans.multiplier = multiplier
 	if len(seed) < xorshift_r {
 		tmp := make([]uint64, xorshift_r)
@@ -123,24 +124,23 @@ func (xs *XorShift1024Star) RestartSubstream() {
 // Perform the jump to advance the generator state.
 func (xs *XorShift1024Star) Jump() {
 	s := make([]uint64, xorshift_r)
-	xs.substream = make([]uint64, xorshift_r)
+	xs.RestartSubstream()
 
 	for i := 0; i < len(xorshift_pw); i++ {
 		for b := 0; b < 64; b++ {
 			if (xorshift_pw[i] & (1 << uint64(b))) != 0 {
 				for j := 0; j < len(xs.state); j++ {
-					s[j] ^= xs.state[(uint64(i)+xs.index)&15]
+					s[j] ^= xs.state[(uint64(j)+xs.index)&15]
 				}
 			}
 			xs.Uint64()
 		}
 	}
 
-	for i := 0; i < xorshift_r; i++ {
-		xs.substream[(uint64(i)+xs.index)&15] = s[i]
-	}
+	// The state is stored starting from the current index, so the substream can be restarted at index 0.
+	xs.substream = append([]uint64{}, s...)
 
-	xs.Restart()
+	xs.RestartSubstream()
 }
 
 func (xs *XorShift1024Star) Uint64() uint64 {
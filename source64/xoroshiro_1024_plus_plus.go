@@ -0,0 +1,48 @@
+package source64
+
+// An all-purpose 64-bit generator with a large state for parallel use.
+//
+// This is a member of the Xor-Shift-Rotate family of generators. Memory footprint is 1024 bits
+// and the period is 2^1024-1.
+//
+// http://xoshiro.di.unimi.it/xoroshiro1024plusplus.c
+// http://xoshiro.di.unimi.it/
+type XoRoShiRo1024PlusPlus struct {
+	baseXoRoShiRo1024
+}
+
+func NewXoRoShiRo1024PlusPlusFromStream(seed []uint64) (*XoRoShiRo1024PlusPlus, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(XoRoShiRo1024PlusPlus)
+	ans.spi = ans
+	if len(seed) < xoroshiro1024_r {
+		tmp := make([]uint64, xoroshiro1024_r)
+		fillState(tmp, seed)
+		ans.setSeed(tmp)
+	} else {
+		ans.setSeed(seed)
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewXoRoShiRo1024PlusPlus(seed int64) *XoRoShiRo1024PlusPlus {
+	ans := new(XoRoShiRo1024PlusPlus)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (xoshiro *XoRoShiRo1024PlusPlus) Uint64() uint64 {
+	s0, s15 := xoshiro.next()
+	return rotateLeft(s0+s15, 23) + s15
+}
+
+func (xoshiro *XoRoShiRo1024PlusPlus) String() string {
+	return "XoRoShiRo1024PlusPlus"
+}
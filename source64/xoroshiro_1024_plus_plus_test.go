@@ -0,0 +1,47 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestXoRoShiRo1024PlusPlus(t *testing.T) {
+	/*
+	 * Data from running the executable compiled from the author's C code:
+	 * http://xoshiro.di.unimi.it/xoroshiro1024plusplus.c
+	 */
+	rng, err := source64.NewXoRoShiRo1024PlusPlusFromStream([]uint64{
+		0x012de1babb3c4104, 0xa5a818b8fc5aa503, 0xb124ea2b701f4993, 0x18e0374933d8c782,
+		0x2af8df668d68ad55, 0x76e56f59daa06243, 0xf58c016f0f01e30f, 0x8eeafa41683dbbf4,
+		0x7bf121347c06677f, 0x4fd0c88d25db5ccb, 0x99af3be9ebe0a272, 0x94f2b33b74d0bdcb,
+		0x24b5d9d7a00a3140, 0x79d983d781a34a3c, 0x582e4a84d595f5ec, 0x7316fe8b0f606d20,
+	})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0x3b09ad2dbf0fac01, 0xc94a7fe723f359b9, 0xe6e1dd32bf791bfc, 0xb7544ad223abd2d8,
+		0xf30327faf9c86e1b, 0xe6e5f8e0c3ee32ee, 0xd0517ea6f0a6dff8, 0xe7ab840562f624f9,
+		0x46974064298e33c5, 0xb1c7d3a0a763d025, 0x516b8571e4870ed4, 0xc6fb23b5d1e49b84,
+		0x09aa6d82bcab4254, 0x23719002bbe8f966, 0x0d67afdb43a5dca4, 0x297327cb4057c221,
+		0x4b2af2a3dba0da80, 0x9eda3fa5e098414a, 0x6806ec1363b1e1b9, 0x6efe75a6813c59c6,
+		0x335baf867960e5fb, 0x9f4415ecc8830b7a, 0xe1c6456883daafbd, 0x50d175bab6ac665c,
+		0x8122d5175b11d1f5, 0xb3671ac101492a4b, 0x658bac8aa044c300, 0xa652105130589a28,
+		0xf49f0307772db260, 0x9d18a1bd5200fcbc, 0x9cd41d5db25d6593, 0xe34ecdb564717deb,
+		0x8affe46f54d83679, 0x67639a77a4199b87, 0xa0d788390eaa4b68, 0x67f84eff59949883,
+		0xc374a0949d9e7c44, 0xdb3251d6eb8cfc68, 0x130ac6799fd3b059, 0x72258b39becdf313,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+}
@@ -0,0 +1,51 @@
+package source64
+
+// A fast 64-bit generator suitable for float generation, with a large state for parallel use.
+//
+// The lowest bits have low linear complexity, use XoRoShiRo1024StarStar or XoRoShiRo1024PlusPlus
+// if all the bits are needed.
+//
+// This is a member of the Xor-Shift-Rotate family of generators. Memory footprint is 1024 bits
+// and the period is 2^1024-1.
+//
+// http://xoshiro.di.unimi.it/xoroshiro1024star.c
+// http://xoshiro.di.unimi.it/
+type XoRoShiRo1024Star struct {
+	baseXoRoShiRo1024
+}
+
+func NewXoRoShiRo1024StarFromStream(seed []uint64) (*XoRoShiRo1024Star, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(XoRoShiRo1024Star)
+	ans.spi = ans
+	if len(seed) < xoroshiro1024_r {
+		tmp := make([]uint64, xoroshiro1024_r)
+		fillState(tmp, seed)
+		ans.setSeed(tmp)
+	} else {
+		ans.setSeed(seed)
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewXoRoShiRo1024Star(seed int64) *XoRoShiRo1024Star {
+	ans := new(XoRoShiRo1024Star)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (xoshiro *XoRoShiRo1024Star) Uint64() uint64 {
+	s0, _ := xoshiro.next()
+	return s0 * 0x9e3779b97f4a7c13
+}
+
+func (xoshiro *XoRoShiRo1024Star) String() string {
+	return "XoRoShiRo1024Star"
+}
@@ -0,0 +1,48 @@
+package source64
+
+// An all-purpose 64-bit generator with a large state for parallel use.
+//
+// This is a member of the Xor-Shift-Rotate family of generators. Memory footprint is 1024 bits
+// and the period is 2^1024-1.
+//
+// http://xoshiro.di.unimi.it/xoroshiro1024starstar.c
+// http://xoshiro.di.unimi.it/
+type XoRoShiRo1024StarStar struct {
+	baseXoRoShiRo1024
+}
+
+func NewXoRoShiRo1024StarStarFromStream(seed []uint64) (*XoRoShiRo1024StarStar, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(XoRoShiRo1024StarStar)
+	ans.spi = ans
+	if len(seed) < xoroshiro1024_r {
+		tmp := make([]uint64, xoroshiro1024_r)
+		fillState(tmp, seed)
+		ans.setSeed(tmp)
+	} else {
+		ans.setSeed(seed)
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewXoRoShiRo1024StarStar(seed int64) *XoRoShiRo1024StarStar {
+	ans := new(XoRoShiRo1024StarStar)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (xoshiro *XoRoShiRo1024StarStar) Uint64() uint64 {
+	s0, _ := xoshiro.next()
+	return rotateLeft(s0*5, 7) * 9
+}
+
+func (xoshiro *XoRoShiRo1024StarStar) String() string {
+	return "XoRoShiRo1024StarStar"
+}
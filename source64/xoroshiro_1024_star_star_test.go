@@ -0,0 +1,47 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestXoRoShiRo1024StarStar(t *testing.T) {
+	/*
+	 * Data from running the executable compiled from the author's C code:
+	 * http://xoshiro.di.unimi.it/xoroshiro1024starstar.c
+	 */
+	rng, err := source64.NewXoRoShiRo1024StarStarFromStream([]uint64{
+		0x012de1babb3c4104, 0xa5a818b8fc5aa503, 0xb124ea2b701f4993, 0x18e0374933d8c782,
+		0x2af8df668d68ad55, 0x76e56f59daa06243, 0xf58c016f0f01e30f, 0x8eeafa41683dbbf4,
+		0x7bf121347c06677f, 0x4fd0c88d25db5ccb, 0x99af3be9ebe0a272, 0x94f2b33b74d0bdcb,
+		0x24b5d9d7a00a3140, 0x79d983d781a34a3c, 0x582e4a84d595f5ec, 0x7316fe8b0f606d20,
+	})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0x462c422df780c48e, 0xbe94d15abff76d8a, 0xb4dbef0e8d88ef2e, 0xdfa2836db33bfc43,
+		0x2a4965b718a2e4f1, 0xce2042d1aa74d50d, 0xa6febfa96d04f58d, 0xb16b1ce69018ab5d,
+		0xd9a067d3c7a7d9ff, 0xe6c40f3b3e470500, 0x54c0b9c458ae5b94, 0xfba57390e5542333,
+		0x9e1670e4da0647b0, 0x118cacc5ae1d413c, 0x855f38d9f9975117, 0xb1d8ae900456c302,
+		0x30d6603089b0b5a5, 0x9b0a5cdd71d36a73, 0x22598c5adb8a49e3, 0xbe240590cf3abae3,
+		0x9c474364766386e4, 0x675f099732c21ff2, 0x432308deff79f4cc, 0x18106f6cbcbb93d5,
+		0x5f87dd27193d4bf5, 0xd540713e20f70062, 0xa8e03c5477d99848, 0xc01f257b1ad88046,
+		0x67522ec1327b3994, 0x4c05d92051d406fa, 0xa03daf3fcd37a5cc, 0x821445c6408c9722,
+		0xf7bbbffc2db460bd, 0x5b42694c4af4d5ca, 0x408899b212aec78e, 0x8cf109d6952df65e,
+		0xb7e8d62389e997cd, 0xf4d82497338d8c89, 0x7f53cea4f43609b9, 0xa0ecb8e0fa98f352,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+}
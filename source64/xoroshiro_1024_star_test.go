@@ -0,0 +1,47 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestXoRoShiRo1024Star(t *testing.T) {
+	/*
+	 * Data from running the executable compiled from the author's C code:
+	 * http://xoshiro.di.unimi.it/xoroshiro1024star.c
+	 */
+	rng, err := source64.NewXoRoShiRo1024StarFromStream([]uint64{
+		0x012de1babb3c4104, 0xa5a818b8fc5aa503, 0xb124ea2b701f4993, 0x18e0374933d8c782,
+		0x2af8df668d68ad55, 0x76e56f59daa06243, 0xf58c016f0f01e30f, 0x8eeafa41683dbbf4,
+		0x7bf121347c06677f, 0x4fd0c88d25db5ccb, 0x99af3be9ebe0a272, 0x94f2b33b74d0bdcb,
+		0x24b5d9d7a00a3140, 0x79d983d781a34a3c, 0x582e4a84d595f5ec, 0x7316fe8b0f606d20,
+	})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0x25420431d285b339, 0x9d50004eab73a9e9, 0xc62fb621034dc6a6, 0x8e4f7ec7784c094f,
+		0xfb233a178dddbef9, 0x538a598a1a751e1d, 0xb2262ac30427231c, 0xe782bbf13a51326d,
+		0x503706f497e83711, 0x8b44a684d34f4676, 0x10228730591a6a11, 0x4d21c526cd1ca7c0,
+		0x4497a82cf06b9274, 0x20324535a7779084, 0x20f683e744439960, 0xeca1ed99f04a8802,
+		0xb0710447ff1eab59, 0x725be21e77ae9ced, 0x0c53e906e3c75f2d, 0xee3f9b042ee640cb,
+		0xddeee0b06f807837, 0xe7a56a2dab4ff707, 0xc08a9562b73b0e9e, 0x424e42a62f21f0e0,
+		0x93d9ef07036ace29, 0x3389f1d557fe67c5, 0xb6bcecf856c408d0, 0xed4e342feea1b9c1,
+		0x8444c6a9d792ab55, 0x0b75319a836a81b5, 0x846082b4ea38f5bc, 0xa9e2b47baddca313,
+		0x3f1a966bb7668740, 0xf556b1a28a741e1d, 0xfc99602a418d56b3, 0x50e51136f1ff265b,
+		0x0b1534b756ba6913, 0x902b3601421c7827, 0x88a33e48fbabe7f0, 0xc3fdf390206a509e,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+}
@@ -57,15 +57,8 @@ func NewXoRoShiRo128Plus(seed int64) *XoRoShiRo128Plus {
 }
 
 func (xoshiro *XoRoShiRo128Plus) Uint64() uint64 {
-
-	s0 := xoshiro.state[0]
-	s1 := xoshiro.state[1]
-	result := s0 + s1
-
-	s1 ^= s0
-	xoshiro.state[0] = rotateLeft(s0, 24) ^ s1 ^ (s1 << 16) // a, b
-	xoshiro.state[1] = rotateLeft(s1, 37)                   // c
-
+	result := xoshiro.state[0] + xoshiro.state[1]
+	xoshiro.next()
 	return result
 }
 
@@ -0,0 +1,61 @@
+package source64
+
+// An all-purpose 64-bit generator with a small state.
+//
+// This is a member of the Xor-Shift-Rotate family of generators. Memory footprint is 128 bits
+// and the period is 2^128-1.
+//
+// http://xoshiro.di.unimi.it/xoroshiro128plusplus.c
+// http://xoshiro.di.unimi.it/
+type XoRoShiRo128PlusPlus struct {
+	baseXoRoShiRo128
+}
+
+func NewXoRoShiRo128PlusPlusFromStream(seed []uint64) (*XoRoShiRo128PlusPlus, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(XoRoShiRo128PlusPlus)
+	ans.spi = ans
+	if len(seed) < xoroshiro128_r {
+		tmp := make([]uint64, xoroshiro128_r)
+		fillState(tmp, seed)
+		ans.setSeed(tmp)
+	} else {
+		ans.setSeed(seed)
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewXoRoShiRo128PlusPlus(seed int64) *XoRoShiRo128PlusPlus {
+	ans := new(XoRoShiRo128PlusPlus)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+// The jump size is the equivalent of 2^64 calls to Uint64().
+// It can provide up to 2^64 non-overlapping subsequences.
+func (xoshiro *XoRoShiRo128PlusPlus) Jump() {
+	xoshiro.jump(xoroshiro128pp_pw)
+}
+
+func (xoshiro *XoRoShiRo128PlusPlus) Uint64() uint64 {
+	s0 := xoshiro.state[0]
+	s1 := xoshiro.state[1]
+	result := rotateLeft(s0+s1, 17) + s0
+
+	s1 ^= s0
+	xoshiro.state[0] = rotateLeft(s0, 49) ^ s1 ^ (s1 << 21)
+	xoshiro.state[1] = rotateLeft(s1, 28)
+
+	return result
+}
+
+func (xoshiro *XoRoShiRo128PlusPlus) String() string {
+	return "XoRoShiRo128PlusPlus"
+}
@@ -0,0 +1,42 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestXoRoShiRo128PlusPlus(t *testing.T) {
+	/*
+	 * Data from running the executable compiled from the author's C code:
+	 * http://xoshiro.di.unimi.it/xoroshiro128plusplus.c
+	 */
+	rng, err := source64.NewXoRoShiRo128PlusPlusFromStream([]uint64{0x012de1babb3c4104, 0xa5a818b8fc5aa503})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0xf61550e8874b8eaf, 0x125015fce911e8f6, 0xff0e6030e39af1a4, 0xd5738fc2a502673b,
+		0xef48cdcbefd84325, 0xb60462c014133da1, 0xa62c6d8b9f87cd81, 0x52fd609a347198eb,
+		0x3c717475e803bf09, 0x1b6e66b21504a677, 0x528f64243db486f4, 0x3676015c33fbf0fa,
+		0x3e05f2ea0216a127, 0x373343bb4159fa59, 0xc375c54ebe2f9097, 0x52d85b22744e0574,
+		0x055dd7e34e687524, 0xb749afc4bc4ed98a, 0x31b972f93d117746, 0xc0e13329779abc15,
+		0xee52ec4b4ddc0091, 0xc756c7dd1d6796d6, 0x3ce47f42e211c63e, 0xa635aa7ce5d06101,
+		0xe8054178cbb492c1, 0x3cc3ad122e7da816, 0x0cbad73cdacab8fd, 0x20aa1cbc64638b31,
+		0x3bce572cfe3bc776, 0xcc81e41637090cd8, 0x69cc93e599f51181, 0x2d5c9a4e509f984d,
+		0xf4f3bf08ff627f92, 0x3430e0a0e8670235, 0x75a856b68968f466, 0xdee1dbbb374913d7,
+		0x9736e33202fbe05b, 0x4bea0cc1151902a4, 0x9fe7fd9d8de47d13, 0xf011332584a1c7ab,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+}
@@ -48,14 +48,8 @@ func NewXoRoShiRo128StarStar(seed int64) *XoRoShiRo128StarStar {
 }
 
 func (xoshiro *XoRoShiRo128StarStar) Uint64() uint64 {
-	s0 := xoshiro.state[0]
-	s1 := xoshiro.state[1]
-	result := rotateLeft(s0*5, 7) * 9
-
-	s1 ^= s0
-	xoshiro.state[0] = rotateLeft(s0, 24) ^ s1 ^ (s1 << 16)
-	xoshiro.state[1] = rotateLeft(s1, 37)
-
+	result := rotateLeft(xoshiro.state[0]*5, 7) * 9
+	xoshiro.next()
 	return result
 }
 
@@ -48,20 +48,8 @@ func NewXoShiRo256Plus(seed int64) *XoShiRo256Plus {
 }
 
 func (xoshiro *XoShiRo256Plus) Uint64() uint64 {
-
 	result := xoshiro.state[0] + xoshiro.state[3]
-
-	t := xoshiro.state[1] << 17
-
-	xoshiro.state[2] ^= xoshiro.state[0]
-	xoshiro.state[3] ^= xoshiro.state[1]
-	xoshiro.state[1] ^= xoshiro.state[2]
-	xoshiro.state[0] ^= xoshiro.state[3]
-
-	xoshiro.state[2] ^= t
-
-	xoshiro.state[3] = rotateLeft(xoshiro.state[3], 45)
-
+	xoshiro.next()
 	return result
 }
 
@@ -0,0 +1,49 @@
+package source64
+
+// An all-purpose 64-bit generator, recommended by the authors over XoShiRo256StarStar.
+//
+// This is a member of the Xor-Shift-Rotate family of generators. Memory footprint is 256 bits
+// and the period is 2^256-1.
+//
+// http://xoshiro.di.unimi.it/xoshiro256plusplus.c
+// http://xoshiro.di.unimi.it/
+type XoShiRo256PlusPlus struct {
+	baseXoShiRo256
+}
+
+func NewXoShiRo256PlusPlusFromStream(seed []uint64) (*XoShiRo256PlusPlus, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(XoShiRo256PlusPlus)
+	ans.spi = ans
+	if len(seed) < xoshiro256_r {
+		tmp := make([]uint64, xoshiro256_r)
+		fillState(tmp, seed)
+		ans.setSeed(tmp)
+	} else {
+		ans.setSeed(seed)
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewXoShiRo256PlusPlus(seed int64) *XoShiRo256PlusPlus {
+	ans := new(XoShiRo256PlusPlus)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (xoshiro *XoShiRo256PlusPlus) Uint64() uint64 {
+	result := rotateLeft(xoshiro.state[0]+xoshiro.state[3], 23) + xoshiro.state[0]
+	xoshiro.next()
+	return result
+}
+
+func (xoshiro *XoShiRo256PlusPlus) String() string {
+	return "XoShiRo256PlusPlus"
+}
@@ -0,0 +1,42 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestXoShiRo256PlusPlus(t *testing.T) {
+	/*
+	 * Data from running the executable compiled from the author's C code:
+	 * http://xoshiro.di.unimi.it/xoshiro256plusplus.c
+	 */
+	rng, err := source64.NewXoShiRo256PlusPlusFromStream([]uint64{0x012de1babb3c4104, 0xa5a818b8fc5aa503, 0xb124ea2b701f4993, 0x18e0374933d8c782})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0x83256c3efe494810, 0xb6a32c7a2f427e87, 0xea4a4faa5f25c89c, 0xbc7eccdda31316cc,
+		0x13fd0f7150d989c6, 0x547138cbae221c4b, 0x9a2ed08e202ccdd4, 0x71c76beffd5ffaf7,
+		0x4a82a53f9bf0e159, 0x82b8fee551e226f6, 0xc8a7cb2002fbabd2, 0xe9fd4b8e8420b6ca,
+		0xc4fee10ff73a4513, 0xbeee1386595fd5bb, 0x1ca9ea9f7af81173, 0x1182e0f6515e7a82,
+		0x92f033c288c73349, 0xf929b1c910f5d6fd, 0xd74f135a22456c58, 0x5db7c5f1bab2ba95,
+		0x35bd2e90555e90ff, 0x82f57164f0a873e8, 0xfe8c06ad1f4322a3, 0xb5830910972042f4,
+		0x01b098fccc86e9a1, 0x0a401cbff79d2968, 0xaee758e14fc8b6c4, 0x9b69b1669c551c7b,
+		0xc424e07de89d8003, 0x2f54c2bc2413685c, 0x18ed020132f7fd78, 0x1296df883b21ddb7,
+		0x08ce35eb04245592, 0x5b379c8c2a13dd1f, 0xd5d72bff230d0038, 0xe8fa9e75a5b11653,
+		0x01cda02ee361fc5d, 0x458c1ba437db0e66, 0x653d400afec2f1a5, 0xce41edefbfc16d19,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+}
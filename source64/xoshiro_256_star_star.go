@@ -49,18 +49,7 @@ func NewXoShiRo256StarStar(seed int64) *XoShiRo256StarStar {
 
 func (xoshiro *XoShiRo256StarStar) Uint64() uint64 {
 	result := rotateLeft(xoshiro.state[1]*5, 7) * 9
-
-	t := xoshiro.state[1] << 17
-
-	xoshiro.state[2] ^= xoshiro.state[0]
-	xoshiro.state[3] ^= xoshiro.state[1]
-	xoshiro.state[1] ^= xoshiro.state[2]
-	xoshiro.state[0] ^= xoshiro.state[3]
-
-	xoshiro.state[2] ^= t
-
-	xoshiro.state[3] = rotateLeft(xoshiro.state[3], 45)
-
+	xoshiro.next()
 	return result
 }
 
@@ -0,0 +1,62 @@
+package source64
+
+// An all-purpose 64-bit generator with a larger state than XoShiRo256PlusPlus.
+//
+// This is a member of the Xor-Shift-Rotate family of generators. Memory footprint is 512 bits
+// and the period is 2^512-1.
+//
+// http://xoshiro.di.unimi.it/xoshiro512plusplus.c
+// http://xoshiro.di.unimi.it/
+type XoShiRo512PlusPlus struct {
+	baseXoShiRo512
+}
+
+func NewXoShiRo512PlusPlusFromStream(seed []uint64) (*XoShiRo512PlusPlus, error) {
+	err := checkEmptySeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := new(XoShiRo512PlusPlus)
+	ans.spi = ans
+	if len(seed) < xoshiro512_r {
+		tmp := make([]uint64, xoshiro512_r)
+		fillState(tmp, seed)
+		ans.setSeed(tmp)
+	} else {
+		ans.setSeed(seed)
+	}
+
+	return ans, nil
+}
+
+// this builds the seed slice from a split_mx64 generator using the seed provided
+func NewXoShiRo512PlusPlus(seed int64) *XoShiRo512PlusPlus {
+	ans := new(XoShiRo512PlusPlus)
+	ans.spi = ans
+	ans.Seed(seed)
+	return ans
+}
+
+func (xoshiro *XoShiRo512PlusPlus) Uint64() uint64 {
+	result := rotateLeft(xoshiro.state[0]+xoshiro.state[2], 17) + xoshiro.state[2]
+	t := xoshiro.state[1] << 11
+	xoshiro.state[2] ^= xoshiro.state[0]
+	xoshiro.state[5] ^= xoshiro.state[1]
+	xoshiro.state[1] ^= xoshiro.state[2]
+	xoshiro.state[7] ^= xoshiro.state[3]
+	xoshiro.state[3] ^= xoshiro.state[4]
+	xoshiro.state[4] ^= xoshiro.state[5]
+	xoshiro.state[0] ^= xoshiro.state[6]
+	xoshiro.state[6] ^= xoshiro.state[7]
+
+	xoshiro.state[6] ^= t
+
+	xoshiro.state[7] = rotateLeft(xoshiro.state[7], 21)
+
+	return result
+}
+
+func (xoshiro *XoShiRo512PlusPlus) String() string {
+	return "XoShiRo512PlusPlus"
+}
@@ -0,0 +1,45 @@
+package source64_test
+
+import (
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+	"testing"
+)
+
+func TestXoShiRo512PlusPlus(t *testing.T) {
+	/*
+	 * Data from running the executable compiled from the author's C code:
+	 * http://xoshiro.di.unimi.it/xoshiro512plusplus.c
+	 */
+	rng, err := source64.NewXoShiRo512PlusPlusFromStream([]uint64{
+		0x012de1babb3c4104, 0xa5a818b8fc5aa503, 0xb124ea2b701f4993, 0x18e0374933d8c782,
+		0x2af8df668d68ad55, 0x76e56f59daa06243, 0xf58c016f0f01e30f, 0x8eeafa41683dbbf4,
+	})
+
+	if err != nil {
+		t.Errorf("Error occured.")
+	}
+
+	r := grand.New(rng)
+
+	expected := []uint64{
+		0x48f140e2854eae38, 0x88d80a53206851ec, 0xf1d255641f3ae3b3, 0x0f4f285abdd594d8,
+		0x143218246037a643, 0xee38ae788815d8e2, 0xb034afe60cf3bf1e, 0x6ebe40961af2ac91,
+		0x5dafb9e849412600, 0xbf27348ef757e243, 0x469345ef2d21ee91, 0x4f2f7b8e0ab1c23c,
+		0xfb6f8d5eeaba7c82, 0x0d95bd0852a4ae70, 0xec95e0448516b491, 0xa6c62460124a0036,
+		0xc206ef6cfb672cd8, 0xa8339b4fdf8111a5, 0xa267bc4646c60968, 0x149f8c339958964f,
+		0xea140efc2121e5fa, 0xbb274991873c8148, 0xee6c77038d610dba, 0xebaab9379277d787,
+		0x3966eb686a44bb24, 0x54bb61aa4003b069, 0xcde854e91cc2cb30, 0x1f8f8f30b896c2e5,
+		0x50b15f5e42bc2517, 0x2f6695e6dc48d57a, 0x35a4c31375a7b365, 0x9927e1adf43ee3c2,
+		0xe99ffb0a8dac464c, 0x41816340c01c96d6, 0x8f5ebe34bcadcc8c, 0x3099b1ac7bba1f73,
+		0x74ad51e9c17ca276, 0xc8a871271ab601ba, 0x659aa958c902a843, 0x741516f44b750698,
+	}
+
+	for i := 0; i < len(expected); i++ {
+		rg := r.Uint64()
+		if expected[i] != rg {
+			t.Errorf("Mismatch. want: %v, got: %v", expected[i], rg)
+		}
+	}
+
+}
@@ -0,0 +1,43 @@
+package grand
+
+import (
+	"math"
+	"time"
+)
+
+// Duration returns, as a time.Duration, a pseudo-random duration in [min,max), with nanosecond resolution.
+// It panics if min >= max.
+func (r *Rand) Duration(min, max time.Duration) time.Duration {
+	if min >= max {
+		panic("invalid argument to Duration")
+	}
+
+	// the width max-min is computed modulo 2^64, and so is the sum below.
+	return min + time.Duration(r.Uint64n(uint64(max)-uint64(min)))
+}
+
+// Time returns a pseudo-random time in [min,max), with nanosecond resolution, in the location of min.
+// Any range of times can be drawn from, including those wider than the ~292 years of a time.Duration.
+// It panics if min is not before max.
+func (r *Rand) Time(min, max time.Time) time.Time {
+	if !min.Before(max) {
+		panic("invalid argument to Time")
+	}
+
+	if d := max.Sub(min); d < math.MaxInt64 {
+		return min.Add(r.Duration(0, d))
+	}
+
+	// the offsets of s seconds and ns nanoseconds, for s in [0, seconds] and ns in [0, 1e9), cover
+	// [min,max) once and at most one more second, whose times are drawn again.
+	lo := min.Unix()
+	seconds := uint64(max.Unix()) - uint64(lo)
+	for {
+		s := r.Uint64n(seconds + 1)
+		ns := int64(r.Uint32n(1e9))
+		t := time.Unix(lo+int64(s), int64(min.Nanosecond())+ns)
+		if t.Before(max) {
+			return t.In(min.Location())
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package grand_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/grandtest"
+	"github.com/jtejido/grand/source64"
+)
+
+func TestDuration(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	min, max := -time.Second, 2*time.Second
+	grandtest.CheckUniform(t, func() uint64 { return uint64(r.Duration(min, max) - min) }, uint64(max-min), 30, samples)
+
+	// the full range of time.Duration.
+	for i := 0; i < 1000; i++ {
+		if d := r.Duration(math.MinInt64, math.MaxInt64); d == math.MaxInt64 {
+			t.Fatalf("Duration out of range: %v", d)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Duration(1, 1) should panic")
+		}
+	}()
+	r.Duration(1, 1)
+}
+
+func TestTime(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	loc := time.FixedZone("UTC+2", 2*3600)
+	min := time.Date(2020, 1, 1, 0, 0, 0, 500, loc)
+	max := min.Add(time.Hour)
+	grandtest.CheckUniform(t, func() uint64 {
+		tm := r.Time(min, max)
+		if tm.Location() != loc {
+			t.Fatalf("Mismatch. want: %v, got: %v", loc, tm.Location())
+		}
+		return uint64(tm.Sub(min))
+	}, uint64(time.Hour), 60, samples)
+
+	// a single nanosecond.
+	if got := r.Time(min, min.Add(1)); !got.Equal(min) {
+		t.Errorf("Mismatch. want: %v, got: %v", min, got)
+	}
+
+	// years 1 to 9999, wider than a time.Duration: the years are uniform (up to the leap days).
+	lo := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	hi := time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)
+	grandtest.CheckUniform(t, func() uint64 {
+		tm := r.Time(lo, hi)
+		if tm.Before(lo) || !tm.Before(hi) {
+			t.Fatalf("Time out of range: %v", tm)
+		}
+		return uint64(tm.Year()-1) / 100
+	}, 100, 100, samples)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Time(max, min) should panic")
+		}
+	}()
+	r.Time(max, min)
+}
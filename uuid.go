@@ -0,0 +1,63 @@
+package grand
+
+import (
+	"encoding/hex"
+	"time"
+)
+
+// UUID is a 128-bit universally unique identifier, as defined by RFC 9562.
+type UUID [16]byte
+
+// String returns the UUID in its canonical form, xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx (lowercase).
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// Version returns the version of the UUID, the high 4 bits of its byte 6.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Sets the version and the variant (10) of u.
+func (u *UUID) setVersion(v byte) {
+	u[6] = u[6]&0x0f | v<<4
+	u[8] = u[8]&0x3f | 0x80
+}
+
+// UUIDv4 returns a version 4 (random) UUID: 122 random bits.
+func (r *Rand) UUIDv4() UUID {
+	var u UUID
+	r.Read(u[:])
+	u.setVersion(4)
+	return u
+}
+
+// UUIDv7 returns a version 7 UUID: the Unix time in milliseconds given by clock (48 bits, big-endian),
+// followed by 74 random bits. A nil clock is time.Now; a fixed clock makes the UUIDs deterministic.
+// The times before 1970 and after year 10889 wrap around.
+func (r *Rand) UUIDv7(clock func() time.Time) UUID {
+	if clock == nil {
+		clock = time.Now
+	}
+
+	var u UUID
+	r.Read(u[6:])
+	ms := uint64(clock().UnixMilli())
+	for i := 5; i >= 0; i-- {
+		u[i] = byte(ms)
+		ms >>= 8
+	}
+
+	u.setVersion(7)
+	return u
+}
@@ -0,0 +1,64 @@
+package grand_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jtejido/grand"
+	"github.com/jtejido/grand/source64"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-([47])[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestUUIDv4(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	seen := make(map[grand.UUID]bool)
+	for i := 0; i < 1000; i++ {
+		u := r.UUIDv4()
+		if m := uuidPattern.FindStringSubmatch(u.String()); m == nil || m[1] != "4" || u.Version() != 4 {
+			t.Fatalf("invalid UUIDv4: %v", u)
+		}
+		if seen[u] {
+			t.Fatalf("duplicate UUID: %v", u)
+		}
+		seen[u] = true
+	}
+
+	u := grand.UUID{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0x4e, 0xf0, 0x81, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+	if want := "12345678-9abc-4ef0-8123-456789abcdef"; u.String() != want {
+		t.Errorf("Mismatch. want: %v, got: %v", want, u.String())
+	}
+
+	// the same seed gives the same UUIDs.
+	if a, b := grand.New(source64.NewSplitMix64(7)).UUIDv4(), grand.New(source64.NewSplitMix64(7)).UUIDv4(); a != b {
+		t.Errorf("Mismatch. want: %v, got: %v", a, b)
+	}
+}
+
+func TestUUIDv7(t *testing.T) {
+	r := grand.New(source64.NewSplitMix64(1))
+	// 2022-02-22T19:22:22Z, the example of RFC 9562: 0x017f22e279b0 ms.
+	clock := func() time.Time { return time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC) }
+	u := r.UUIDv7(clock)
+	if m := uuidPattern.FindStringSubmatch(u.String()); m == nil || m[1] != "7" || u.Version() != 7 {
+		t.Fatalf("invalid UUIDv7: %v", u)
+	}
+	if got := u.String()[:13]; got != "017f22e2-79b0" {
+		t.Errorf("Mismatch. want: %v, got: %v", "017f22e2-79b0", got)
+	}
+
+	if a, b := grand.New(source64.NewSplitMix64(7)).UUIDv7(clock), grand.New(source64.NewSplitMix64(7)).UUIDv7(clock); a != b {
+		t.Errorf("Mismatch. want: %v, got: %v", a, b)
+	}
+
+	before := time.Now().UnixMilli()
+	u = r.UUIDv7(nil)
+	ms := int64(0)
+	for _, b := range u[:6] {
+		ms = ms<<8 | int64(b)
+	}
+	if ms < before || ms > time.Now().UnixMilli() {
+		t.Errorf("the nil clock should be time.Now, got: %v ms", ms)
+	}
+}